@@ -15,9 +15,13 @@ import (
 )
 
 var (
-	inputfile = flag.String("i", "../../assets/dervaze-rootset.protobuf", "protobuffer file to load roots")
-	host      = flag.String("h", "0.0.0.0", "IP address or hostname to listen to")
-	port      = flag.Int("p", 9876, "port to listen to")
+	inputfile   = flag.String("i", "../../assets/dervaze-rootset.protobuf", "protobuffer file to load roots")
+	suffixfile  = flag.String("x", "../../assets/dervaze-suffixset.protobuf", "protobuffer file to load suffixes for stemming")
+	modelfile   = flag.String("m", "../../assets/dervaze-ngram.protobuf", "protobuffer file to load the n-gram model for ranking translations")
+	variantfile = flag.String("v", "", "CSV file to load orthographic variants, the embedded table is used if empty")
+	visencfile  = flag.String("t", "", "CSV file to load the visenc table, the embedded table is used if empty")
+	host        = flag.String("h", "0.0.0.0", "IP address or hostname to listen to")
+	port        = flag.Int("p", 9876, "port to listen to")
 )
 
 // grpcHandlerFunc returns an http.Handler that delegates to grpcServer on incoming gRPC
@@ -80,6 +84,24 @@ func commonServer(host string, port int) {
 	router.HandleFunc("/v1/json/search/any/{word}", dervaze.JSONSearchAuto)
 	router.HandleFunc("/v1/json/search/ot/{word}", dervaze.JSONSearchOt)
 	router.HandleFunc("/v1/json/search/tr/{word}", dervaze.JSONSearchTr)
+	router.HandleFunc("/v1/json/suffix/tr/{word}", dervaze.JSONSuffixTr)
+	router.HandleFunc("/v1/json/suffix/ot/{word}", dervaze.JSONSuffixOt)
+	router.HandleFunc("/v1/json/variant/ot/{word}", dervaze.JSONVariantOt)
+	router.HandleFunc("/v1/json/pattern/{pattern}", dervaze.JSONPattern)
+	router.HandleFunc("/v1/json/query/{query}", dervaze.JSONQuery)
+	router.HandleFunc("/v1/json/complete/{prefix}", dervaze.JSONComplete)
+	router.Handle("/v1/ws/complete", dervaze.WebSocketComplete)
+	router.HandleFunc("/v1/json/phonetic/{word}", dervaze.JSONPhonetic)
+	router.HandleFunc("/v1/json/spell/{text}", dervaze.JSONSpell)
+	router.HandleFunc("/v1/json/spell", dervaze.JSONSpell).Methods("POST")
+	router.HandleFunc("/v1/json/ocr/{text}", dervaze.JSONOCR)
+	router.HandleFunc("/v1/json/ocr", dervaze.JSONOCR).Methods("POST")
+	router.HandleFunc("/v1/json/family/{word}", dervaze.JSONFamily)
+	router.HandleFunc("/v1/json/wazn/generate/{root}", dervaze.JSONWaznGenerate)
+	router.HandleFunc("/v1/json/wazn/{word}", dervaze.JSONWazn)
+	router.HandleFunc("/v1/json/rhyme/{word}", dervaze.JSONRhyme)
+	router.HandleFunc("/v1/json/aruz/{line}", dervaze.JSONAruz)
+	router.HandleFunc("/v1/json/phonology/{word}", dervaze.JSONPhonology)
 	router.HandleFunc("/v1/json/exact/abjad/{number}", dervaze.JSONExactAbjad)
 	router.HandleFunc("/v1/json/calc/abjad/{word}", dervaze.JSONCalcAbjad)
 	router.HandleFunc("/v1/json/v2u/{word}", dervaze.JSONV2U)
@@ -122,6 +144,10 @@ func main() {
 		fmt.Printf("%s=%s [%s] \n", f.Name, f.Value.String(), f.Usage)
 	})
 
+	dervaze.InitVisencTable(*visencfile)
+	dervaze.InitVariants(*variantfile)
 	dervaze.InitSearch(*inputfile)
+	dervaze.InitLemmatizer(*suffixfile)
+	dervaze.InitLanguageModel(*modelfile)
 	commonServer(*host, *port)
 }
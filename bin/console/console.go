@@ -3,6 +3,7 @@ package main
 import (
 	dervaze "dervaze/lang"
 	"flag"
+	"fmt"
 	"io"
 	"log"
 	"strconv"
@@ -47,6 +48,42 @@ var completer = readline.NewPrefixCompleter(
 	// readline.PcItem("sleep"),
 )
 
+// profile is the script used to read and write Unicode, set with the script command
+var profile = dervaze.GetScriptProfile(dervaze.Script_OTTOMAN_TURKISH)
+
+// phonetic is set with the ime command to write every line typed phonetically in Ottoman script
+var phonetic = false
+
+// printPhonetic prints the Ottoman spellings of each word in line and the line written with the first ones
+func printPhonetic(line string) {
+	written := make([]string, 0)
+	for _, word := range strings.Fields(line) {
+		candidates := dervaze.ConvertPhonetic(&dervaze.PhoneticRequest{Latin: word, ResultLimit: 5, Script: profile.Script}).Candidates
+		spellings := make([]string, len(candidates))
+		for i, c := range candidates {
+			mark := ""
+			if c.Source == dervaze.CandidateSource_GUESS {
+				mark = "?"
+			}
+			spellings[i] = fmt.Sprintf("%s%s (%s)", c.Ottoman.Unicode, mark, c.Ottoman.Visenc)
+		}
+		println(word, "|", strings.Join(spellings, " | "))
+		if len(candidates) > 0 {
+			written = append(written, candidates[0].Ottoman.Unicode)
+		}
+	}
+	println(strings.Join(written, " "))
+}
+
+// printWaznForm prints a root written in a pattern and the dictionary words spelled as it
+func printWaznForm(f *dervaze.WaznForm) {
+	words := make([]string, len(f.Roots))
+	for i, r := range f.Roots {
+		words[i] = r.TurkishLatin
+	}
+	println(f.Pattern, f.PatternOttoman, "|", f.ArabicRoot, "|", f.TurkishLatin, "|", f.Ottoman.Unicode, "|", strings.Join(words, ", "))
+}
+
 func filterInput(r rune) (rune, bool) {
 	switch r {
 	// block CtrlZ feature
@@ -89,22 +126,158 @@ func console() {
 
 		line = strings.TrimSpace(line)
 		switch {
+		case line == "ime":
+			phonetic = !phonetic
+			println("ime", phonetic)
+		case phonetic:
+			printPhonetic(line)
+		case strings.HasPrefix(line, "ime "):
+			printPhonetic(line[4:])
+		case strings.HasPrefix(line, "script "):
+			script, err := dervaze.ParseScript(line[7:])
+			if err != nil {
+				println(err.Error())
+			} else {
+				profile = dervaze.GetScriptProfile(script)
+			}
 		case strings.HasPrefix(line, "v2o "):
-			println(dervaze.VisencToUnicode(line[4:]))
+			println(profile.VisencToUnicode(line[4:]))
 		case strings.HasPrefix(line, "o2v "):
-			println(dervaze.UnicodeToVisenc(line[4:]))
+			println(profile.UnicodeToVisenc(line[4:]))
 		case strings.HasPrefix(line, "t "):
 			println(dervaze.PrintRoots(dervaze.FuzzySearchTurkishLatin(line[2:], CONSOLEMAXRESULTLEN)))
 		case strings.HasPrefix(line, "v "):
 			println(dervaze.PrintRoots(dervaze.FuzzySearchVisenc(line[2:], CONSOLEMAXRESULTLEN)))
 		case strings.HasPrefix(line, "u "):
-			println(dervaze.PrintRoots(dervaze.FuzzySearchUnicode(line[2:], CONSOLEMAXRESULTLEN)))
+			println(dervaze.PrintRoots(profile.LocalizeRoots(dervaze.FuzzySearchUnicode(profile.OttomanUnicode(line[2:]), CONSOLEMAXRESULTLEN))))
 		case strings.HasPrefix(line, "pt "):
 			println(dervaze.PrintRoots(dervaze.PrefixSearchTurkishLatin(line[2:], CONSOLEMAXRESULTLEN)))
 		case strings.HasPrefix(line, "pv "):
 			println(dervaze.PrintRoots(dervaze.PrefixSearchVisenc(line[2:], CONSOLEMAXRESULTLEN)))
 		case strings.HasPrefix(line, "pu "):
-			println(dervaze.PrintRoots(dervaze.PrefixSearchUnicode(line[2:], CONSOLEMAXRESULTLEN)))
+			println(dervaze.PrintRoots(profile.LocalizeRoots(dervaze.PrefixSearchUnicode(profile.OttomanUnicode(line[3:]), CONSOLEMAXRESULTLEN))))
+		case strings.HasPrefix(line, "st "):
+			println(dervaze.PrintRoots(dervaze.SuffixSearchTurkishLatin(line[3:], CONSOLEMAXRESULTLEN)))
+		case strings.HasPrefix(line, "sv "):
+			println(dervaze.PrintRoots(dervaze.SuffixSearchVisenc(line[3:], CONSOLEMAXRESULTLEN)))
+		case strings.HasPrefix(line, "su "):
+			println(dervaze.PrintRoots(profile.LocalizeRoots(dervaze.SuffixSearchUnicode(profile.OttomanUnicode(line[3:]), CONSOLEMAXRESULTLEN))))
+		case strings.HasPrefix(line, "r "):
+			for _, rm := range dervaze.FindRhymes(line[2:], dervaze.SearchField_AUTO, nil, 0, 1, CONSOLEMAXRESULTLEN) {
+				println(rm.MatchLength, "-", rm.Root.TurkishLatin, "|", rm.Root.Ottoman.Unicode, "|", rm.Root.Ottoman.Visenc)
+			}
+		case strings.HasPrefix(line, "l "):
+			for _, sr := range dervaze.LemmatizeAuto(line[2:], CONSOLEMAXRESULTLEN) {
+				suffixes := make([]string, len(sr.Suffixes))
+				for i, s := range sr.Suffixes {
+					suffixes[i] = s.TurkishLatin
+				}
+				if sr.Relation != nil {
+					println(sr.Root.TurkishLatin, "|", sr.Root.Ottoman.Unicode, "|", strings.Join(suffixes, "+"), "|", sr.Relation.TurkishLatin, "is its plural")
+				} else {
+					println(sr.Root.TurkishLatin, "|", sr.Root.Ottoman.Unicode, "|", strings.Join(suffixes, "+"))
+				}
+			}
+		case strings.HasPrefix(line, "all "):
+			for _, sr := range dervaze.SearchAll(profile.OttomanUnicode(line[4:]), CONSOLEMAXRESULTLEN) {
+				root := profile.Localize(sr.Root)
+				println(sr.Field.String(), "|", root.TurkishLatin, "|", root.Ottoman.Unicode, "|", root.Ottoman.Visenc)
+			}
+		case strings.HasPrefix(line, "d "):
+			for _, fs := range dervaze.ScoreFields(line[2:]) {
+				println(fs.Field.String(), fmt.Sprintf("%.2f", fs.Score))
+			}
+		case strings.HasPrefix(line, "pa "):
+			lp, err := profile.CompilePattern(line[3:])
+			if err != nil {
+				println(err.Error())
+			} else {
+				println(dervaze.PrintRoots(profile.LocalizeRoots(dervaze.PatternSearch(lp, CONSOLEMAXRESULTLEN))))
+			}
+		case strings.HasPrefix(line, "q "):
+			q, err := profile.ParseQuery(line[2:])
+			if err != nil {
+				println(err.Error())
+			} else {
+				println(q.String())
+				println(dervaze.PrintRoots(profile.LocalizeRoots(dervaze.QuerySearch(q, CONSOLEMAXRESULTLEN))))
+			}
+		case strings.HasPrefix(line, "c "):
+			println(dervaze.PrintRoots(profile.LocalizeRoots(dervaze.CompleteAuto(profile.OttomanUnicode(line[2:]), CONSOLEMAXRESULTLEN))))
+		case strings.HasPrefix(line, "sp "):
+			out := profile.CheckSpelling(line[3:], dervaze.MAXSUGGESTIONS)
+			for _, m := range out.Misspellings {
+				suggestions := make([]string, len(m.Suggestions))
+				for i, s := range m.Suggestions {
+					suggestions[i] = fmt.Sprintf("%s (%.1f)", s.Ottoman.Unicode, s.Distance)
+				}
+				println(m.Start, "-", m.Word, "|", strings.Join(suggestions, " | "))
+			}
+			println(len(out.Misspellings), "of", out.WordCount, "words misspelled")
+		case strings.HasPrefix(line, "ocr "):
+			out := profile.CorrectOCRText(line[4:], dervaze.MAXOCRCANDIDATES)
+			for _, c := range out.Corrections {
+				candidates := make([]string, len(c.Candidates))
+				for i, s := range c.Candidates {
+					candidates[i] = fmt.Sprintf("%s (%.1f)", s.Ottoman.Unicode, s.Distance)
+				}
+				mark := "?"
+				if c.Known {
+					mark = ""
+				}
+				println(c.Token+mark, "|", strings.Join(candidates, " | "))
+			}
+			println(out.Text)
+		case strings.HasPrefix(line, "va "):
+			for _, sr := range dervaze.VariantSearchUnicode(line[3:], CONSOLEMAXRESULTLEN) {
+				println(sr.Root.TurkishLatin, "|", sr.Root.Ottoman.Unicode, "|", sr.Root.Ottoman.Visenc, "|", strings.Join(sr.Variants, ","))
+			}
+		case strings.HasPrefix(line, "wa "):
+			for _, f := range profile.AnalyzeWazn(line[3:]).Forms {
+				printWaznForm(f)
+			}
+		case strings.HasPrefix(line, "wg "):
+			// the root is written without spaces, like k-t-b, and the pattern may follow it
+			args := append(strings.Fields(line[3:]), "")
+			out, err := profile.GenerateWazn(args[0], args[1])
+			if err != nil {
+				println(err.Error())
+			} else {
+				for _, f := range out.Forms {
+					printWaznForm(f)
+				}
+			}
+		case strings.HasPrefix(line, "fa "):
+			word := line[3:]
+			if dervaze.ContainsArabicChars(word) {
+				word = profile.OttomanUnicode(word)
+			}
+			for _, root := range profile.LocalizeRoots(dervaze.FamilySearch(word, CONSOLEMAXRESULTLEN)) {
+				println(root.ArabicRoot, "|", root.TurkishLatin, "|", root.Ottoman.Unicode, "|", root.Ottoman.Visenc)
+			}
+		case strings.HasPrefix(line, "tr "):
+			for _, sentence := range dervaze.TranslateOttoman(line[3:]) {
+				for _, tv := range sentence.Words {
+					readings := make([]string, len(tv.Varieties))
+					for i, tw := range tv.Varieties {
+						readings[i] = fmt.Sprintf("%s (%.3f)", dervaze.TurkishLatinReading(tw), tw.Probability)
+					}
+					println(strings.Join(readings, " | "))
+				}
+			}
+		case strings.HasPrefix(line, "aruz "):
+			scan := dervaze.ScanVerse(line[5:])
+			for i, s := range scan.Syllables {
+				mark := ""
+				if s.Violation {
+					mark = "!"
+				}
+				println(i, "-", s.Text, "|", s.Weight, "|", s.Reading, strings.Join(s.Licenses, ","), mark)
+			}
+			if scan.Meter != nil {
+				println(scan.Meter.Name, "|", scan.Meter.Feet, "|", scan.Meter.Pattern)
+			}
+			println(scan.Scansion)
 		case strings.HasPrefix(line, "a "):
 			n, err := strconv.Atoi(line[2:])
 			if err != nil {
@@ -122,10 +295,22 @@ func console() {
 func main() {
 
 	var inputfile string
+	var suffixfile string
+	var modelfile string
+	var variantfile string
+	var visencfile string
 	flag.StringVar(&inputfile, "i", "assets/dervaze-rootset.protobuf", "protobuffer file to load roots")
+	flag.StringVar(&suffixfile, "x", "assets/dervaze-suffixset.protobuf", "protobuffer file to load suffixes for stemming")
+	flag.StringVar(&modelfile, "m", "assets/dervaze-ngram.protobuf", "protobuffer file to load the n-gram model for ranking translations")
+	flag.StringVar(&variantfile, "v", "", "CSV file to load orthographic variants, the embedded table is used if empty")
+	flag.StringVar(&visencfile, "t", "", "CSV file to load the visenc table, the embedded table is used if empty")
 
 	flag.Parse()
+	dervaze.InitVisencTable(visencfile)
+	dervaze.InitVariants(variantfile)
 	dervaze.InitSearch(inputfile)
+	dervaze.InitLemmatizer(suffixfile)
+	dervaze.InitLanguageModel(modelfile)
 	console()
 
 }
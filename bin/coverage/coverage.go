@@ -0,0 +1,86 @@
+package main
+
+import (
+	dervaze "dervaze/lang"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/runenames"
+)
+
+// coverage lists the Arabic script and format characters in asset files that are not read as visenc
+func main() {
+
+	var assetdir string
+	var scriptName string
+	var visencfile string
+
+	flag.StringVar(&assetdir, "d", "../../assets/", "Directory of the asset files to check, searched recursively")
+	flag.StringVar(&scriptName, "s", "ottoman", "Script whose visenc letters are checked: ottoman, persian, arabic or urdu")
+	flag.StringVar(&visencfile, "t", "", "Visenc table CSV file, the embedded table is used if empty")
+
+	flag.Parse()
+
+	dervaze.InitVisencTable(visencfile)
+
+	script, err := dervaze.ParseScript(scriptName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	profile := dervaze.GetScriptProfile(script)
+
+	counts := make(map[rune]int)
+	files := make(map[rune][]string)
+
+	err = filepath.Walk(assetdir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(path, ".protobuf") {
+			return nil
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		// binary files are not text in any script
+		if !utf8.Valid(content) {
+			return nil
+		}
+
+		fileCounts := make(map[rune]int)
+		profile.UnmappedCodePoints(string(content), fileCounts)
+		for r, c := range fileCounts {
+			counts[r] += c
+			files[r] = append(files[r], path)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	unmapped := make([]rune, 0, len(counts))
+	for r := range counts {
+		unmapped = append(unmapped, r)
+	}
+	sort.Slice(unmapped, func(i, j int) bool {
+		if counts[unmapped[i]] != counts[unmapped[j]] {
+			return counts[unmapped[i]] > counts[unmapped[j]]
+		}
+		return unmapped[i] < unmapped[j]
+	})
+
+	for _, r := range unmapped {
+		fmt.Printf("%U\t%d\t%s\t%s\n", r, counts[r], runenames.Name(r), strings.Join(files[r], " "))
+	}
+
+	log.Printf("%d unmapped code points in %s with visenc table %s", len(unmapped), assetdir, dervaze.GetVisencTable().ID())
+}
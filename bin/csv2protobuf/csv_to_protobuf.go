@@ -96,6 +96,62 @@ func loadWordFiles(rootdatadir string) *dervaze.RootSet {
 
 }
 
+// sourceKey compares roots with dictionary entries by their Latin spelling and visenc letters
+func sourceKey(latin string, visenc string) string {
+	return latin + "," + dervaze.RemoveJoiners(dervaze.SearchKey(visenc))
+}
+
+// addSources adds the names of the dictionaries listing a root to its sources.
+// Dictionaries are files like redhouse-merged.csv with Latin and visenc columns, their name is redhouse.
+func addSources(rootset *dervaze.RootSet, sourcedir string) {
+	files, err := filepath.Glob(filepath.Join(sourcedir, "*-merged.csv"))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	sources := make(map[string][]string)
+	for _, fn := range files {
+		name := strings.TrimSuffix(filepath.Base(fn), "-merged.csv")
+		data, err := ioutil.ReadFile(fn)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		csvr := csv.NewReader(strings.NewReader(string(data)))
+		csvr.FieldsPerRecord = -1
+		records, err := csvr.ReadAll()
+		if err != nil {
+			log.Printf("%s: %s", fn, err)
+			continue
+		}
+		for _, record := range records {
+			if len(record) < 2 {
+				continue
+			}
+			k := sourceKey(record[0], record[1])
+			if names := sources[k]; len(names) == 0 || names[len(names)-1] != name {
+				sources[k] = append(names, name)
+			}
+		}
+	}
+
+	tagged := 0
+	for _, r := range rootset.Roots {
+		if names, exists := sources[sourceKey(r.TurkishLatin, r.Ottoman.Visenc)]; exists {
+			r.Sources = names
+			tagged++
+		}
+	}
+	log.Printf("%d of %d roots are found in %d dictionaries", tagged, len(rootset.Roots), len(files))
+}
+
+// addArabicRoots stores the Arabic roots of roots, so they aren't analyzed when root sets are loaded
+func addArabicRoots(rootset *dervaze.RootSet) {
+	found := dervaze.AddArabicRoots(rootset)
+	log.Printf("%d of %d roots have Arabic roots", found, len(rootset.Roots))
+}
+
 func generateSuffixData(rootset *dervaze.RootSet) (*dervaze.RootSet, *dervaze.SuffixSet) {
 
 	// generate map of roots
@@ -140,9 +196,14 @@ func generateSuffixData(rootset *dervaze.RootSet) (*dervaze.RootSet, *dervaze.Su
 		// for all visenc candidates, check if any of them has the same prefix with our current visenc word
 
 		for _, tro := range trRootOrig {
-			if strings.HasPrefix(ve, tro.Ottoman.Visenc) {
-				log.Printf("Found orig root for %s - %s => %s - %s", tr, ve, tro.TurkishLatin, tro.Ottoman.Visenc)
-				veSuffix := strings.TrimPrefix(ve, tro.Ottoman.Visenc)
+			// roots with alternations are spelled with their stem before vowel-initial suffixes
+			roVisenc := tro.Ottoman.Visenc
+			if tro.EffectiveVisenc != "" && strings.HasPrefix(ve, tro.EffectiveVisenc) {
+				roVisenc = tro.EffectiveVisenc
+			}
+			if strings.HasPrefix(ve, roVisenc) {
+				log.Printf("Found orig root for %s - %s => %s - %s", tr, ve, tro.TurkishLatin, roVisenc)
+				veSuffix := strings.TrimPrefix(ve, roVisenc)
 				log.Printf("Suffix Correspondence: %s - %s", trSuffix, veSuffix)
 
 				suffixPOS := s.PartOfSpeech
@@ -214,7 +275,7 @@ func generateSuffixData(rootset *dervaze.RootSet) (*dervaze.RootSet, *dervaze.Su
 	log.Printf("Roots now has %d elements", len(onlyRoots))
 
 	suffixSet := dervaze.SuffixSet{Suffixes: suffixes}
-	newRootSet := dervaze.RootSet{Roots: onlyRoots}
+	newRootSet := dervaze.RootSet{Roots: onlyRoots, VisencVersion: dervaze.GetVisencTable().ID()}
 
 	return &newRootSet, &suffixSet
 }
@@ -225,16 +286,56 @@ func main() {
 	var rootsetfile string
 	var suffixsetfile string
 	var format string
+	var rulesfile string
+	var alternationsfile string
+	var visencfile string
+	var sourcedir string
+	var relationsfile string
 	t := time.Now().Format("2006-01-02-03-04-05")
 	flag.StringVar(&inputdir, "i", "../../assets/rootdata/", "Input dir where n/ v/ p/ directories reside")
 	flag.StringVar(&rootsetfile, "r", fmt.Sprintf("../../assets/dervaze-rootset-%s.protobuf", t), "Output file to store the rootset file")
 	flag.StringVar(&suffixsetfile, "s", fmt.Sprintf("../../assets/dervaze-suffixset-%s.protobuf", t), "Output file to store the suffixset file")
 	flag.StringVar(&format, "f", "protobuf", "Output file to store the suffixset file")
+	flag.StringVar(&rulesfile, "rules", "", "Phonology rules file to use instead of the built-in rules")
+	flag.StringVar(&alternationsfile, "alternations", "", "Alternation exceptions file to use instead of the built-in list")
+
+	flag.StringVar(&visencfile, "visenc", "", "Visenc table file to use instead of the built-in table")
+	flag.StringVar(&sourcedir, "sources", "../../assets/csv/", "Dir of dictionary files named like redhouse-merged.csv to record the sources of roots")
+	flag.StringVar(&relationsfile, "relations", "", "Reviewed relations file written by infer_relations to link plurals to their singulars")
 
 	flag.Parse()
 
+	if visencfile != "" {
+		if err := dervaze.LoadVisencTable(visencfile); err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("Visenc table: %s", dervaze.GetVisencTable().ID())
+
+	if rulesfile != "" {
+		if err := dervaze.LoadPhonologyRules(rulesfile); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if alternationsfile != "" {
+		if err := dervaze.LoadAlternations(alternationsfile); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	rootset := loadWordFiles(inputdir)
 	newrootset, suffixset := generateSuffixData(rootset)
+	addSources(newrootset, sourcedir)
+	addArabicRoots(newrootset)
+	if relationsfile != "" {
+		links, err := dervaze.LoadRelations(relationsfile, newrootset.Roots)
+		if err != nil {
+			log.Fatal(err)
+		}
+		dervaze.ApplyRelations(links)
+		log.Printf("%d relations are added from %s", len(links), relationsfile)
+	}
 	if format == "protobuf" {
 		dervaze.SaveRootSetProtobuf(rootsetfile, newrootset)
 		dervaze.SaveSuffixSetProtobuf(suffixsetfile, suffixset)
@@ -11,9 +11,13 @@ import (
 )
 
 var (
-	inputfile = flag.String("i", "../../assets/dervaze-rootset.protobuf", "protobuffer file to load roots")
-	host      = flag.String("h", "0.0.0.0", "IP address or hostname to listen to")
-	port      = flag.Int("p", 9876, "port to listen to")
+	inputfile   = flag.String("i", "../../assets/dervaze-rootset.protobuf", "protobuffer file to load roots")
+	suffixfile  = flag.String("x", "../../assets/dervaze-suffixset.protobuf", "protobuffer file to load suffixes for stemming")
+	modelfile   = flag.String("m", "../../assets/dervaze-ngram.protobuf", "protobuffer file to load the n-gram model for ranking translations")
+	variantfile = flag.String("v", "", "CSV file to load orthographic variants, the embedded table is used if empty")
+	visencfile  = flag.String("t", "", "CSV file to load the visenc table, the embedded table is used if empty")
+	host        = flag.String("h", "0.0.0.0", "IP address or hostname to listen to")
+	port        = flag.Int("p", 9876, "port to listen to")
 )
 
 func server(host string, port int) {
@@ -46,6 +50,10 @@ func main() {
 		fmt.Printf("%s=%s [%s] \n", f.Name, f.Value.String(), f.Usage)
 	})
 
+	dervaze.InitVisencTable(*visencfile)
+	dervaze.InitVariants(*variantfile)
 	dervaze.InitSearch(*inputfile)
+	dervaze.InitLemmatizer(*suffixfile)
+	dervaze.InitLanguageModel(*modelfile)
 	server(*host, *port)
 }
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bufio"
+	dervaze "dervaze/lang"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// OTTOMANDICTIONARY is the name of the Ottoman script dictionary files
+const OTTOMANDICTIONARY = "ota"
+
+// LATINDICTIONARY is the name of the Turkish Latin transcription dictionary files
+const LATINDICTIONARY = "ota-Latn"
+
+// checkWords checks the words in filename, one per line, with the dictionaries in dir and prints the rejected words
+func checkWords(filename string, dir string) {
+	ottoman, err := dervaze.LoadHunspell(filepath.Join(dir, OTTOMANDICTIONARY))
+	if err != nil {
+		log.Fatal(err)
+	}
+	latin, err := dervaze.LoadHunspell(filepath.Join(dir, LATINDICTIONARY))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	total, accepted := 0, 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" {
+			continue
+		}
+		total++
+		checker := latin
+		if dervaze.ContainsArabicChars(word) {
+			checker = ottoman
+		}
+		if checker.Check(word) {
+			accepted++
+		} else {
+			fmt.Println(word)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("%d of %d words accepted", accepted, total)
+}
+
+// hunspell writes Hunspell dictionaries of the roots and suffixes for Ottoman script and Turkish Latin transcription,
+// or checks a word list with them
+func main() {
+
+	var inputfile string
+	var suffixfile string
+	var outputdir string
+	var wordfile string
+
+	flag.StringVar(&inputfile, "i", "../../assets/dervaze-rootset.protobuf", "protobuffer file to load roots")
+	flag.StringVar(&suffixfile, "x", "../../assets/dervaze-suffixset.protobuf", "protobuffer file to load suffixes for the affix files")
+	flag.StringVar(&outputdir, "o", ".", "Directory of ota.aff, ota.dic, ota-Latn.aff and ota-Latn.dic")
+	flag.StringVar(&wordfile, "w", "", "Word list to check with the dictionaries in the output directory instead of writing them")
+
+	flag.Parse()
+
+	if wordfile != "" {
+		checkWords(wordfile, outputdir)
+		return
+	}
+
+	dervaze.InitSearch(inputfile)
+	dervaze.InitLemmatizer(suffixfile)
+	roots := dervaze.GetRootSet().Roots
+	suffixes := dervaze.GetSuffixSet().Suffixes
+
+	for name, latin := range map[string]bool{OTTOMANDICTIONARY: false, LATINDICTIONARY: true} {
+		filename := filepath.Join(outputdir, name)
+		if err := dervaze.ExportHunspell(roots, suffixes, latin).Save(filename); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Wrote %s.aff and %s.dic", filename, filename)
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	dervaze "dervaze/lang"
+	"flag"
+	"log"
+	"os"
+)
+
+// infer_relations proposes links from plurals to their singulars in a root set, by the Arabic broken plural patterns
+// and the Persian plural endings. Links are written to a CSV file to be reviewed and added to the root set by
+// csv2protobuf -relations.
+func main() {
+
+	var rootsetfile string
+	var outputfile string
+	var patternsfile string
+	var pluralsfile string
+
+	flag.StringVar(&rootsetfile, "i", "../../assets/dervaze-rootset.protobuf", "Root set protobuf file to infer relations from")
+	flag.StringVar(&outputfile, "o", "../../assets/dervaze-relations.csv", "Output CSV file to store the proposed relations")
+	flag.StringVar(&patternsfile, "a", "", "Arabic pattern table CSV file, the embedded table is used if empty")
+	flag.StringVar(&pluralsfile, "p", "", "Arabic plural table CSV file, the embedded table is used if empty")
+
+	flag.Parse()
+
+	dervaze.InitArabicPatterns(patternsfile)
+	dervaze.InitArabicPlurals(pluralsfile)
+
+	rootset := dervaze.LoadRootSetProtobuf(rootsetfile)
+	links := dervaze.InferRelations(rootset.Roots)
+
+	out, err := os.Create(outputfile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+
+	if err := dervaze.WriteRelationsCSV(out, links); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Proposed %d relations of %d roots to %s", len(links), len(rootset.Roots), outputfile)
+}
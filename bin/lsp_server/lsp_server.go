@@ -0,0 +1,38 @@
+package main
+
+import (
+	dervaze "dervaze/lang"
+	"flag"
+	"log"
+	"os"
+)
+
+// lsp_server is a Language Server Protocol server on stdin and stdout for editors working on documents mixing Ottoman
+// script and Latin transcription. Logs are written to stderr.
+func main() {
+
+	var inputfile string
+	var suffixfile string
+	var modelfile string
+	var scriptName string
+
+	flag.StringVar(&inputfile, "i", "../../assets/dervaze-rootset.protobuf", "protobuffer file to load roots")
+	flag.StringVar(&suffixfile, "x", "../../assets/dervaze-suffixset.protobuf", "protobuffer file to load suffixes to check and read inflected words")
+	flag.StringVar(&modelfile, "m", "../../assets/dervaze-ngram.protobuf", "protobuffer file to load the n-gram model for ranking readings")
+	flag.StringVar(&scriptName, "s", "ottoman", "Script of the documents: ottoman, persian, arabic or urdu")
+
+	flag.Parse()
+
+	script, err := dervaze.ParseScript(scriptName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dervaze.InitSearch(inputfile)
+	dervaze.InitLemmatizer(suffixfile)
+	dervaze.InitLanguageModel(modelfile)
+
+	if err := dervaze.NewLSPServer(script).Serve(os.Stdin, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	dervaze "dervaze/lang"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// correctedName returns the file the corrected text of filename is written to
+func correctedName(filename string, outputdir string) string {
+	if outputdir != "" {
+		return filepath.Join(outputdir, filepath.Base(filename))
+	}
+	ext := filepath.Ext(filename)
+	return strings.TrimSuffix(filename, ext) + ".corrected" + ext
+}
+
+// ocr_correct replaces the unknown words of OCR text files with the dictionary words OCR most likely read as them
+func main() {
+
+	var inputfile string
+	var suffixfile string
+	var modelfile string
+	var confusionfile string
+	var outputdir string
+	var scriptName string
+
+	flag.StringVar(&inputfile, "i", "../../assets/dervaze-rootset.protobuf", "protobuffer file to load roots")
+	flag.StringVar(&suffixfile, "x", "../../assets/dervaze-suffixset.protobuf", "protobuffer file to load suffixes to correct inflected words")
+	flag.StringVar(&modelfile, "m", "../../assets/dervaze-ngram.protobuf", "protobuffer file to load the n-gram model for ranking corrections")
+	flag.StringVar(&confusionfile, "c", "", "CSV file to load the OCR confusion matrix trained with train_ocr, the embedded matrix is used if empty")
+	flag.StringVar(&outputdir, "o", "", "Directory to write corrected files, they're written next to the input files with .corrected before the extension if empty")
+	flag.StringVar(&scriptName, "s", "ottoman", "Script of the OCR files: ottoman, persian, arabic or urdu")
+
+	flag.Parse()
+
+	script, err := dervaze.ParseScript(scriptName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	profile := dervaze.GetScriptProfile(script)
+
+	dervaze.InitOCRConfusions(confusionfile)
+	dervaze.InitSearch(inputfile)
+	dervaze.InitLemmatizer(suffixfile)
+	dervaze.InitLanguageModel(modelfile)
+
+	for _, filename := range flag.Args() {
+		content, err := os.ReadFile(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		out := profile.CorrectOCRText(string(content), 1)
+		corrected := 0
+		for _, c := range out.Corrections {
+			if !c.Known && len(c.Candidates) > 0 {
+				corrected++
+			}
+		}
+
+		output := correctedName(filename, outputdir)
+		if err := os.WriteFile(output, []byte(out.Text), 0644); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("%s: corrected %d of %d words to %s", filename, corrected, len(out.Corrections), output)
+	}
+}
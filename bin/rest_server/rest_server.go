@@ -3,8 +3,6 @@ package main
 import (
 	dervaze "dervaze/lang"
 	"os/exec"
-	"strconv"
-	"strings"
 
 	// "encoding/json"
 	"flag"
@@ -17,401 +15,11 @@ import (
 	// "github.com/golang/protobuf/proto"
 
 	"github.com/gorilla/mux"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // MAXRESULTLEN shows the maximum number of elements returned from searches
 const MAXRESULTLEN = 20
 
-func transformRoots(roots []*dervaze.Root, transformer func(*dervaze.Root) *dervaze.Root) *dervaze.RootSet {
-	out := make([]*dervaze.Root, len(roots))
-
-	for i, r := range roots {
-		out[i] = transformer(r)
-	}
-
-	r := dervaze.RootSet{
-		Roots: out,
-	}
-	return &r
-}
-
-func marshalRoots(outputRootSet *dervaze.RootSet) (string, error) {
-	jsonBytes, err := protojson.Marshal(outputRootSet)
-
-	jsonStr := string(jsonBytes)
-	if err == nil {
-		return jsonStr, nil
-	}
-	log.Printf("Marshal Error: %s", err)
-	return "", err
-}
-
-// validSearchCharacters defines all characters accepted for search
-var validSearchCharacters = []rune(dervaze.AllSearchableArabic + dervaze.AllSearchableLatin)
-var validSearchCharMap = make(map[rune]rune, len(validSearchCharacters))
-var validSearchMapInit = false
-
-func cleanUpSearchString(s string) string {
-	if !validSearchMapInit {
-		for _, r := range validSearchCharacters {
-			validSearchCharMap[r] = r
-		}
-	}
-
-	sb := strings.Builder{}
-
-	for _, r := range []rune(s) {
-		if _, exists := validSearchCharMap[r]; exists {
-			sb.WriteRune(r)
-		}
-	}
-
-	return sb.String()
-
-}
-
-// JSONPrefixTr makes a prefix search with the word
-// ## `/v1/json/prefix/tr/{word}
-//
-// Sends a list of Turkish words starting with `word` sorted by length
-//
-func JSONPrefixTr(w http.ResponseWriter, r *http.Request) {
-
-	transformer := func(root *dervaze.Root) *dervaze.Root {
-		r := dervaze.Root{
-			TurkishLatin: root.TurkishLatin,
-		}
-		return &r
-	}
-	vars := mux.Vars(r)
-	log.Printf("JsonPrefixTr Vars: %s", vars)
-	roots := dervaze.FuzzySearchTurkishLatin(vars["word"], MAXRESULTLEN)
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
-
-	if m, err := marshalRoots(outputRootSet); err == nil {
-		w.Header().Add("Access-Control-Allow-Origin", "*")
-		fmt.Fprintln(w, "", m)
-	}
-}
-
-// JSONPrefixOt responds to a prefix search
-// ## `/v1/json/prefix/ot/{word}
-//
-// Sends a list of Ottoman words starting with `word`
-//
-// ```
-// [ "word", "worda", "wordb", "wordabc"]
-// ```
-//
-func JSONPrefixOt(w http.ResponseWriter, r *http.Request) {
-	transformer := func(root *dervaze.Root) *dervaze.Root {
-		r := dervaze.Root{
-			Ottoman: &dervaze.OttomanWord{
-				Unicode: root.Ottoman.Unicode,
-			},
-		}
-		return &r
-	}
-	vars := mux.Vars(r)
-	log.Printf("JsonPrefixTr Vars: %s", vars)
-	roots := dervaze.FuzzySearchUnicode(vars["word"], MAXRESULTLEN)
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
-
-	if m, err := marshalRoots(outputRootSet); err == nil {
-		w.Header().Add("Access-Control-Allow-Origin", "*")
-		fmt.Fprintln(w, "", m)
-	}
-}
-
-// JSONExactTr searches `word` exactly without prefix or regex
-// ## `/v1/json/exact/tr/{word}
-//
-// Returns records with Turkish Latin == `word`
-//
-// ```
-// [ { "ottoman_unicode": "abcd",
-//     "latin": "word",
-//     "abjad": 1234,
-//     "meanings": [
-//         {"source": "dervaze",
-//          "meaning": "meaning 1 of word"}]
-//     },
-//     { "ottoman_unicode": "abcddd",
-//     "latin": "word",
-//     "abjad": 1255,
-//     "meanings": [
-//         {"source": "kanar",
-//          "meaning": "meaning 1 of word"}]
-//     }]
-//     ```
-//
-func JSONExactTr(w http.ResponseWriter, r *http.Request) {
-	transformer := func(root *dervaze.Root) *dervaze.Root {
-		r := dervaze.Root{
-			TurkishLatin: root.TurkishLatin,
-			Abjad:        root.Abjad,
-			Ottoman: &dervaze.OttomanWord{
-				Unicode: root.Ottoman.Unicode,
-			},
-		}
-		return &r
-	}
-	vars := mux.Vars(r)
-	log.Printf("JsonExactTr Vars: %s", vars)
-	roots := dervaze.PrefixSearchTurkishLatinExact(vars["word"])
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
-	if m, err := marshalRoots(outputRootSet); err == nil {
-		w.Header().Add("Access-Control-Allow-Origin", "*")
-		fmt.Fprintln(w, "", m)
-	}
-}
-
-// JSONExactOt searches `word` exactly as written without prefix or regex
-// ## `/v1/json/exact/ot/{word}
-//
-// Returns records with Ottoman == `word`
-//
-// ```
-// [ { "ottoman_unicode": "word",
-//     "latin": "abdc",
-//     "abjad": 1298,
-//     "meanings": [
-//         {"source": "dervaze",
-//          "meaning": "meaning 1 of word"}]
-//     },
-//     { "ottoman_unicode": "word",
-//     "latin": "anbdn",
-//     "abjad": 2191,
-//     "meanings": [
-//         {"source": "kanar",
-//          "meaning": "meaning 1 of word"}]
-//     }]
-//     ```
-//
-func JSONExactOt(w http.ResponseWriter, r *http.Request) {
-	transformer := func(root *dervaze.Root) *dervaze.Root {
-		r := dervaze.Root{
-			TurkishLatin: root.TurkishLatin,
-			Abjad:        root.Abjad,
-			Ottoman: &dervaze.OttomanWord{
-				Unicode: root.Ottoman.Unicode,
-			},
-		}
-		return &r
-	}
-	vars := mux.Vars(r)
-	log.Printf("JsonExactTr Vars: %s", vars)
-	roots := dervaze.PrefixSearchUnicodeExact(vars["word"])
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
-	if m, err := marshalRoots(outputRootSet); err == nil {
-		w.Header().Add("Access-Control-Allow-Origin", "*")
-		fmt.Fprintln(w, "", m)
-	}
-}
-
-// JSONSearchTr makes a regex search by interleaving .? between runes of `word`
-// `/v1/json/search/tr/{word}`
-func JSONSearchTr(w http.ResponseWriter, r *http.Request) {
-	transformer := func(root *dervaze.Root) *dervaze.Root {
-		r := dervaze.Root{
-			TurkishLatin: root.TurkishLatin,
-			Abjad:        root.Abjad,
-			Ottoman: &dervaze.OttomanWord{
-				Unicode: root.Ottoman.Unicode,
-			},
-		}
-		return &r
-	}
-	vars := mux.Vars(r)
-	log.Printf("JsonExactTr Vars: %s", vars)
-	roots := dervaze.FuzzySearchTurkishLatin(vars["word"], MAXRESULTLEN)
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
-
-	if m, err := marshalRoots(outputRootSet); err == nil {
-		w.Header().Add("Access-Control-Allow-Origin", "*")
-		fmt.Fprintln(w, "", m)
-	}
-}
-
-// JSONSearchOt makes a regex search by interleaving .? between runes of `word`
-// `/v1/json/search/ot/{word}`
-func JSONSearchOt(w http.ResponseWriter, r *http.Request) {
-	transformer := func(root *dervaze.Root) *dervaze.Root {
-		r := dervaze.Root{
-			TurkishLatin: root.TurkishLatin,
-			Abjad:        root.Abjad,
-			Ottoman: &dervaze.OttomanWord{
-				Unicode: root.Ottoman.Unicode,
-			},
-		}
-		return &r
-	}
-	vars := mux.Vars(r)
-	log.Printf("JsonExactTr Vars: %s", vars)
-	roots := dervaze.FuzzySearchUnicode(vars["word"], MAXRESULTLEN)
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
-	if m, err := marshalRoots(outputRootSet); err == nil {
-		w.Header().Add("Access-Control-Allow-Origin", "*")
-		fmt.Fprintln(w, "", m)
-	}
-}
-
-// JSONSearchAuto makes a regex search by interleaving .? between runes of `word`
-// if `word` contains Arabic letters, it makes Arabic search
-// if `word` contains digits only it makes an abjad search
-// if `word` contains characters and digits mixed, it makes a visenc search
-// otherwise it searches as a Turkish latin word
-// `/v1/json/search/ot/{word}`
-func JSONSearchAuto(w http.ResponseWriter, r *http.Request) {
-	transformer := func(root *dervaze.Root) *dervaze.Root {
-		r := dervaze.Root{
-			TurkishLatin: root.TurkishLatin,
-			Abjad:        root.Abjad,
-			Ottoman: &dervaze.OttomanWord{
-				Unicode: root.Ottoman.Unicode,
-			},
-		}
-		return &r
-	}
-	vars := mux.Vars(r)
-	log.Printf("JsonExactTr Vars: %s", vars)
-	roots := dervaze.FuzzySearchAuto(vars["word"], MAXRESULTLEN)
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
-	if m, err := marshalRoots(outputRootSet); err == nil {
-		w.Header().Add("Access-Control-Allow-Origin", "*")
-		fmt.Fprintln(w, "", m)
-	}
-}
-
-// JSONExactAbjad searches words with `number` as their abjad counterpart
-// ## `/v1/json/exact/abjad/{number}
-//
-// Returns records with abjad == `number`
-//
-// ```
-// [ { "ottoman_unicode": "word",
-//     "latin": "abdc",
-//     "abjad": number,
-//     "meanings": [
-//         {"source": "dervaze",
-//          "meaning": "meaning 1 of word"}]
-//     },
-//     { "ottoman_unicode": "drwo",
-//     "latin": "anbdn",
-//     "abjad": number,
-//     "meanings": [
-//         {"source": "kanar",
-//          "meaning": "meaning 1 of word"}]
-//     }]
-//     ```
-//
-//
-func JSONExactAbjad(w http.ResponseWriter, r *http.Request) {
-
-	transformer := func(root *dervaze.Root) *dervaze.Root {
-		r := dervaze.Root{
-			TurkishLatin: root.TurkishLatin,
-			Abjad:        root.Abjad,
-			Ottoman: &dervaze.OttomanWord{
-				Unicode: root.Ottoman.Unicode,
-			},
-		}
-		return &r
-	}
-	vars := mux.Vars(r)
-	log.Printf("JsonExactTr Vars: %s", vars)
-	val, err := strconv.Atoi(vars["word"])
-	var roots []*dervaze.Root
-	if err == nil {
-		roots = dervaze.IndexSearchAbjad(int32(val), MAXRESULTLEN)
-	} else {
-		log.Printf("Error in SearchAbjad parameter: %s", vars["word"])
-		roots = make([]*dervaze.Root, 0)
-	}
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
-	if m, err := marshalRoots(outputRootSet); err == nil {
-
-		w.Header().Add("Access-Control-Allow-Origin", "*")
-
-		fmt.Fprintln(w, "", m)
-	}
-}
-
-// JSONCalcAbjad calculates the abjad of a word
-// ## `/v1/json/calc/abjad/{word}
-//
-// Calculates abjad for the `word` given in unicode
-//
-// ```
-// { "ottoman_unicode": "word",
-// "abjad": 1234 }
-// ```
-//
-func JSONCalcAbjad(w http.ResponseWriter, r *http.Request) {
-	w.Header().Add("Access-Control-Allow-Origin", "*")
-	vars := mux.Vars(r)
-	log.Printf("JsonPrefixTr Vars: %s", vars)
-	abjad := dervaze.UnicodeToAbjad(vars["word"])
-	str := fmt.Sprintf("{ \"ottoman_unicode\": \"%s\", \"abjad\": %d }", vars["word"], abjad)
-	fmt.Fprintln(w, "", str)
-
-}
-
-// JSONV2U converts a visenc string to unicode
-// ## `/v1/json/v2u/{word}
-//
-// Converts `word` from visenc to unicode
-//
-// ```
-// {"ottoman_visenc": <word>,
-// "ottoman_unicode": <unicode>}
-// ```
-//
-func JSONV2U(w http.ResponseWriter, r *http.Request) {
-	w.Header().Add("Access-Control-Allow-Origin", "*")
-	vars := mux.Vars(r)
-	log.Printf("JsonV2U Vars: %s", vars)
-	unicode := dervaze.VisencToUnicode(vars["word"])
-	str := fmt.Sprintf("{ \"ottoman_unicode\": \"%s\", \"ottoman_unicode\": \"%s\" }", unicode, vars["word"])
-	fmt.Fprintln(w, "", str)
-}
-
-// JSONU2V converts a unicode string to visenc
-// ## `/v1/json/u2v/{word}
-//
-// Converts `word` from unicode to visenc
-//
-// ```
-// {"ottoman_visenc": <visenc>,
-// "ottoman_unicode": <word>}
-// ```
-//
-func JSONU2V(w http.ResponseWriter, r *http.Request) {
-	w.Header().Add("Access-Control-Allow-Origin", "*")
-	vars := mux.Vars(r)
-	log.Printf("JsonU2V Vars: %s", vars)
-	visenc := dervaze.UnicodeToVisenc(vars["word"])
-	str := fmt.Sprintf("{ \"ottoman_unicode\": \"%s\", \"ottoman_unicode\": \"%s\" }", vars["word"], visenc)
-	fmt.Fprintln(w, "", str)
-}
-
 // JSONVersion sends git version information
 func JSONVersion(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Access-Control-Allow-Origin", "*")
@@ -426,17 +34,35 @@ func server(host string, port int) {
 
 	router := mux.NewRouter().StrictSlash(true)
 
-	router.HandleFunc("/v1/json/prefix/tr/{word}", JSONPrefixTr)
-	router.HandleFunc("/v1/json/prefix/ot/{word}", JSONPrefixOt)
-	router.HandleFunc("/v1/json/exact/tr/{word}", JSONExactTr)
-	router.HandleFunc("/v1/json/exact/ot/{word}", JSONExactOt)
-	router.HandleFunc("/v1/json/search/any/{word}", JSONSearchAuto)
-	router.HandleFunc("/v1/json/search/ot/{word}", JSONSearchOt)
-	router.HandleFunc("/v1/json/search/tr/{word}", JSONSearchTr)
-	router.HandleFunc("/v1/json/exact/abjad/{number}", JSONExactAbjad)
-	router.HandleFunc("/v1/json/calc/abjad/{word}", JSONCalcAbjad)
-	router.HandleFunc("/v1/json/v2u/{word}", JSONV2U)
-	router.HandleFunc("/v1/json/u2v/{word}", JSONU2V)
+	router.HandleFunc("/v1/json/prefix/tr/{word}", dervaze.JSONPrefixTr)
+	router.HandleFunc("/v1/json/prefix/ot/{word}", dervaze.JSONPrefixOt)
+	router.HandleFunc("/v1/json/exact/tr/{word}", dervaze.JSONExactTr)
+	router.HandleFunc("/v1/json/exact/ot/{word}", dervaze.JSONExactOt)
+	router.HandleFunc("/v1/json/search/any/{word}", dervaze.JSONSearchAuto)
+	router.HandleFunc("/v1/json/search/ot/{word}", dervaze.JSONSearchOt)
+	router.HandleFunc("/v1/json/search/tr/{word}", dervaze.JSONSearchTr)
+	router.HandleFunc("/v1/json/suffix/tr/{word}", dervaze.JSONSuffixTr)
+	router.HandleFunc("/v1/json/suffix/ot/{word}", dervaze.JSONSuffixOt)
+	router.HandleFunc("/v1/json/variant/ot/{word}", dervaze.JSONVariantOt)
+	router.HandleFunc("/v1/json/pattern/{pattern}", dervaze.JSONPattern)
+	router.HandleFunc("/v1/json/query/{query}", dervaze.JSONQuery)
+	router.HandleFunc("/v1/json/complete/{prefix}", dervaze.JSONComplete)
+	router.Handle("/v1/ws/complete", dervaze.WebSocketComplete)
+	router.HandleFunc("/v1/json/phonetic/{word}", dervaze.JSONPhonetic)
+	router.HandleFunc("/v1/json/spell/{text}", dervaze.JSONSpell)
+	router.HandleFunc("/v1/json/spell", dervaze.JSONSpell).Methods("POST")
+	router.HandleFunc("/v1/json/ocr/{text}", dervaze.JSONOCR)
+	router.HandleFunc("/v1/json/ocr", dervaze.JSONOCR).Methods("POST")
+	router.HandleFunc("/v1/json/family/{word}", dervaze.JSONFamily)
+	router.HandleFunc("/v1/json/wazn/generate/{root}", dervaze.JSONWaznGenerate)
+	router.HandleFunc("/v1/json/wazn/{word}", dervaze.JSONWazn)
+	router.HandleFunc("/v1/json/rhyme/{word}", dervaze.JSONRhyme)
+	router.HandleFunc("/v1/json/aruz/{line}", dervaze.JSONAruz)
+	router.HandleFunc("/v1/json/phonology/{word}", dervaze.JSONPhonology)
+	router.HandleFunc("/v1/json/exact/abjad/{number}", dervaze.JSONExactAbjad)
+	router.HandleFunc("/v1/json/calc/abjad/{word}", dervaze.JSONCalcAbjad)
+	router.HandleFunc("/v1/json/v2u/{word}", dervaze.JSONV2U)
+	router.HandleFunc("/v1/json/u2v/{word}", dervaze.JSONU2V)
 	router.HandleFunc("/v1/version/", JSONVersion)
 	srv := &http.Server{
 		Handler:      router,
@@ -452,10 +78,18 @@ func server(host string, port int) {
 func main() {
 
 	var inputfile string
+	var suffixfile string
+	var modelfile string
+	var variantfile string
+	var visencfile string
 	var port int
 	var host string
 
 	flag.StringVar(&inputfile, "i", "../../assets/dervaze-rootset.protobuf", "protobuffer file to load roots")
+	flag.StringVar(&suffixfile, "x", "../../assets/dervaze-suffixset.protobuf", "protobuffer file to load suffixes for stemming")
+	flag.StringVar(&modelfile, "m", "../../assets/dervaze-ngram.protobuf", "protobuffer file to load the n-gram model for ranking translations")
+	flag.StringVar(&variantfile, "v", "", "CSV file to load orthographic variants, the embedded table is used if empty")
+	flag.StringVar(&visencfile, "t", "", "CSV file to load the visenc table, the embedded table is used if empty")
 	flag.StringVar(&host, "h", "127.0.0.1", "IP address or hostname to listen to")
 	flag.IntVar(&port, "p", 9876, "port to listen to")
 
@@ -466,6 +100,10 @@ func main() {
 		fmt.Printf("%s=%s [%s] \n", f.Name, f.Value.String(), f.Usage)
 	})
 
+	dervaze.InitVisencTable(visencfile)
+	dervaze.InitVariants(variantfile)
 	dervaze.InitSearch(inputfile)
+	dervaze.InitLemmatizer(suffixfile)
+	dervaze.InitLanguageModel(modelfile)
 	server(host, port)
 }
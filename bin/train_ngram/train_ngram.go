@@ -0,0 +1,31 @@
+package main
+
+import (
+	dervaze "dervaze/lang"
+	"flag"
+	"log"
+)
+
+func main() {
+
+	var corpusdir string
+	var modelfile string
+	var wordOrder int
+	var charOrder int
+
+	flag.StringVar(&corpusdir, "c", "../../assets/corpus/", "Corpus dir with plain text .txt files in Turkish Latin")
+	flag.StringVar(&modelfile, "o", "../../assets/dervaze-ngram.protobuf", "Output file to store the n-gram model")
+	flag.IntVar(&wordOrder, "n", 2, "Order of word n-grams")
+	flag.IntVar(&charOrder, "k", 4, "Order of character n-grams")
+
+	flag.Parse()
+
+	model, err := dervaze.TrainNGramModel(corpusdir, wordOrder, charOrder)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Counted %d words, %d word n-grams and %d character n-grams", model.TotalWords, len(model.WordCounts), len(model.CharCounts))
+
+	dervaze.SaveNGramModelProtobuf(modelfile, model)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	dervaze "dervaze/lang"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// train_ocr estimates the OCR confusion matrix from ground truth files and the OCR output of the same pages.
+// Files with the same name in the ground truth and OCR directories are paired.
+func main() {
+
+	var truthdir string
+	var ocrdir string
+	var outputfile string
+	var visencfile string
+
+	flag.StringVar(&truthdir, "g", "../../assets/ocr/truth/", "Directory of ground truth text files")
+	flag.StringVar(&ocrdir, "r", "../../assets/ocr/ocr/", "Directory of OCR text files with the same names as ground truth files")
+	flag.StringVar(&outputfile, "o", "../../assets/dervaze-ocr-confusions.csv", "Output CSV file to store the confusion matrix")
+	flag.StringVar(&visencfile, "t", "", "Visenc table CSV file, the embedded table is used if empty")
+
+	flag.Parse()
+
+	dervaze.InitVisencTable(visencfile)
+
+	// entries are sorted by name
+	entries, err := os.ReadDir(truthdir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	truthfiles := make([]string, 0)
+	ocrfiles := make([]string, 0)
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		ocrfile := filepath.Join(ocrdir, e.Name())
+		if _, err := os.Stat(ocrfile); err != nil {
+			log.Printf("%s has no OCR file: %s", e.Name(), err)
+			continue
+		}
+		truthfiles = append(truthfiles, filepath.Join(truthdir, e.Name()))
+		ocrfiles = append(ocrfiles, ocrfile)
+	}
+
+	matrix, err := dervaze.TrainOCRConfusions(truthfiles, ocrfiles)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	out, err := os.Create(outputfile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+
+	if err := matrix.WriteCSV(out); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Trained the OCR confusion matrix from %d file pairs to %s", len(truthfiles), outputfile)
+}
@@ -14,21 +14,22 @@ func timeTrack(start time.Time, name string) {
 }
 
 func generateListOfStrings() *[]string {
-	searchMap := dervaze.GetTurkishLatinIndex()
-	searchList := make([]string, 0)
+	searchIndex := dervaze.GetTurkishLatinIndex()
+	searchList := make([]string, 0, searchIndex.Len())
 
-	for _, d := range *searchMap {
-		searchList = append(searchList, d...)
+	for i := 0; i < searchIndex.Len(); i++ {
+		searchList = append(searchList, searchIndex.Key(i))
 	}
 	return &searchList
 }
 
 func generateSingleString() *string {
-	searchMap := dervaze.GetTurkishLatinIndex()
+	searchIndex := dervaze.GetTurkishLatinIndex()
 
 	var sb strings.Builder
-	for _, d := range *searchMap {
-		sb.WriteString(strings.Join(d, "\n"))
+	for i := 0; i < searchIndex.Len(); i++ {
+		sb.WriteString(searchIndex.Key(i))
+		sb.WriteString("\n")
 	}
 
 	out := sb.String()
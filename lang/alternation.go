@@ -0,0 +1,154 @@
+package lang
+
+import (
+	_ "embed" // default alternation exceptions are embedded
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+//go:embed data/alternations.csv
+var defaultAlternationsData string
+
+// AlternationException records the stem of a root that cannot be derived by rules
+type AlternationException struct {
+	TurkishLatin     string
+	Visenc           string
+	Alternation      Alternation
+	StemTurkishLatin string
+	StemVisenc       string
+}
+
+var alternationNames = map[string]Alternation{
+	"hard_final":          Alternation_HARD_FINAL,
+	"vowel_elision":       Alternation_VOWEL_ELISION,
+	"gemination":          Alternation_GEMINATION,
+	"consonant_softening": Alternation_CONSONANT_SOFTENING,
+}
+
+var alternationExceptions = MustParseAlternations(strings.NewReader(defaultAlternationsData))
+
+// ParseAlternations reads alternation exceptions in CSV format and returns them keyed by Turkish Latin spelling
+func ParseAlternations(reader io.Reader) (map[string][]*AlternationException, error) {
+	csvr := csv.NewReader(reader)
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 5
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions := make(map[string][]*AlternationException)
+
+	for i, record := range records {
+		if i == 0 && record[0] == "latin" {
+			continue
+		}
+
+		alternation, exists := alternationNames[strings.TrimSpace(record[2])]
+		if !exists {
+			return nil, fmt.Errorf("Exception for %s: unknown alternation %s", record[0], record[2])
+		}
+
+		if record[3] == "" {
+			return nil, fmt.Errorf("Exception for %s: empty stem", record[0])
+		}
+
+		e := AlternationException{
+			TurkishLatin:     record[0],
+			Visenc:           record[1],
+			Alternation:      alternation,
+			StemTurkishLatin: record[3],
+			StemVisenc:       record[4],
+		}
+		exceptions[e.TurkishLatin] = append(exceptions[e.TurkishLatin], &e)
+	}
+
+	return exceptions, nil
+}
+
+// MustParseAlternations is like ParseAlternations but panics if the exceptions cannot be parsed
+func MustParseAlternations(reader io.Reader) map[string][]*AlternationException {
+	exceptions, err := ParseAlternations(reader)
+	if err != nil {
+		panic(err)
+	}
+	return exceptions
+}
+
+// LoadAlternations reads an exceptions file and makes it the exception list used by the package
+func LoadAlternations(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	exceptions, err := ParseAlternations(file)
+	if err != nil {
+		return err
+	}
+
+	alternationExceptions = exceptions
+	return nil
+}
+
+// FindAlternationException returns the exception for a root spelling, or nil
+func FindAlternationException(latin string, visenc string) *AlternationException {
+	for _, e := range alternationExceptions[latin] {
+		if e.Visenc == "" || e.Visenc == visenc {
+			return e
+		}
+	}
+	return nil
+}
+
+// UpdateAlternation fills EffectiveTurkishLatin, EffectiveVisenc and Alternation of a root with its stem before vowel-initial suffixes.
+// Exceptions are checked first, then gemination and softening rules.
+func UpdateAlternation(r *Root) {
+
+	if e := FindAlternationException(r.TurkishLatin, r.Ottoman.Visenc); e != nil {
+		r.Alternation = e.Alternation
+		r.EffectiveTurkishLatin = e.StemTurkishLatin
+		if e.StemVisenc != "" {
+			r.EffectiveVisenc = e.StemVisenc
+		}
+		r.HasConsonantSoftening = e.Alternation == Alternation_CONSONANT_SOFTENING
+		r.PhonologyRules = append(r.PhonologyRules, "exception:"+r.TurkishLatin)
+		return
+	}
+
+	if rule := phonologyRules.FirstMatch(RuleGemination, r.TurkishLatin, r.Ottoman.Visenc); rule != nil && rule.Latin != nil {
+		r.EffectiveTurkishLatin = rule.Latin.ReplaceAllString(r.TurkishLatin, rule.LatinResult)
+		if rule.Visenc != nil && rule.VisencResult != "" {
+			r.EffectiveVisenc = rule.Visenc.ReplaceAllString(r.Ottoman.Visenc, rule.VisencResult)
+		}
+		r.Alternation = Alternation_GEMINATION
+		r.PhonologyRules = append(r.PhonologyRules, rule.Name)
+		return
+	}
+
+	UpdateEffectiveSoftening(r)
+	if r.HasConsonantSoftening {
+		r.Alternation = Alternation_CONSONANT_SOFTENING
+	}
+}
+
+// VowelStem returns the Turkish Latin and visenc stems of a root used before a suffix starting with a vowel
+func VowelStem(r *Root) (string, string) {
+	if r.EffectiveTurkishLatin == "" {
+		return r.TurkishLatin, r.Ottoman.GetVisenc()
+	}
+	return r.EffectiveTurkishLatin, r.EffectiveVisenc
+}
+
+// StemForSuffix returns the Turkish Latin and visenc stems of a root to which the suffix is attached
+func StemForSuffix(r *Root, s *Suffix) (string, string) {
+	if first := []rune(s.TurkishLatin); len(first) > 0 && EndsWithVowel(string(first[0])) {
+		return VowelStem(r)
+	}
+	return r.TurkishLatin, r.Ottoman.GetVisenc()
+}
@@ -0,0 +1,51 @@
+package lang
+
+import (
+	"fmt"
+	"testing"
+)
+
+// func UpdateAlternation(r *Root) {
+func TestUpdateAlternation(t *testing.T) {
+	type expected struct {
+		stem        string
+		alternation Alternation
+	}
+
+	testDict := map[[2]string]expected{
+		{"hak", "xfo2"}:       {"hakk", Alternation_GEMINATION},
+		{"his", "xso8"}:       {"hiss", Alternation_GEMINATION},
+		{"ağız", "eao1ro1"}:   {"ağz", Alternation_VOWEL_ELISION},
+		{"isim", "esm"}:       {"ism", Alternation_VOWEL_ELISION},
+		{"hukuk", "xfo2wfo2"}: {"hukuk", Alternation_HARD_FINAL},
+		{"renk", "rbo1k"}:     {"reng", Alternation_CONSONANT_SOFTENING},
+		{"salak", "zelefo2"}:  {"salağ", Alternation_CONSONANT_SOFTENING},
+		{"kalem", "fo2lm"}:    {"kalem", Alternation_NO_ALTERNATION},
+	}
+
+	for w, e := range testDict {
+		r := NewRoot(w[0], w[1], PartOfSpeech_NOUN)
+		if r.EffectiveTurkishLatin != e.stem || r.Alternation != e.alternation {
+			t.Log(fmt.Sprintf("%s, %s fails for UpdateAlternation: %s %s", w[0], w[1], r.EffectiveTurkishLatin, r.Alternation))
+			t.Fail()
+		}
+	}
+
+	r := NewRoot("burun", "bu1rwbo1", PartOfSpeech_NOUN)
+	if r.EffectiveVisenc != "bu1rbo1" {
+		t.Log(fmt.Sprintf("burun visenc stem: %s", r.EffectiveVisenc))
+		t.Fail()
+	}
+
+	vowelSuffix := Suffix{TurkishLatin: "ı"}
+	consonantSuffix := Suffix{TurkishLatin: "da"}
+	r = NewRoot("ağız", "eao1ro1", PartOfSpeech_NOUN)
+	if tr, _ := StemForSuffix(r, &vowelSuffix); tr != "ağz" {
+		t.Log(fmt.Sprintf("StemForSuffix ağız + ı: %s", tr))
+		t.Fail()
+	}
+	if tr, _ := StemForSuffix(r, &consonantSuffix); tr != "ağız" {
+		t.Log(fmt.Sprintf("StemForSuffix ağız + da: %s", tr))
+		t.Fail()
+	}
+}
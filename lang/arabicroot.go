@@ -0,0 +1,377 @@
+package lang
+
+import (
+	"crypto/sha256"
+	_ "embed" // default Arabic pattern table is embedded
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+//go:embed data/arabic-patterns.csv
+var defaultArabicPatternsData string
+
+// arabicRadicals are the letters standing for the root letters in patterns, a second ل is the fourth root letter
+const arabicRadicals = "فعل"
+
+// turkishLetters are not written in Arabic words, words with them have no Arabic root
+const turkishLetters = "پچژگڭ"
+
+// arabicRootPrefixes and arabicRootSuffixes are stripped from words before they are matched with patterns
+var arabicRootPrefixes = []string{"", "ال"}
+var arabicRootSuffixes = []string{"", "ات", "یت", "یه", "ه", "ت", "ی"}
+
+// ArabicPattern is an Arabic derivation pattern (wazn) like mef'ûl, written in Ottoman letters as مفعول
+type ArabicPattern struct {
+	Name    string
+	Ottoman string
+	// Latin is the Latin spelling with 1 2 3 4 for the root letters, like me12û3 for mef'ûl
+	Latin   string
+	Example string
+	// letters are the normalized letters of Ottoman, radicals the index of the root letter at each position or -1
+	letters  []rune
+	radicals []int
+	// patternLetters is the number of letters that aren't root letters, rootLetters the number of root letters
+	patternLetters int
+	rootLetters    int
+}
+
+// ArabicPatternTable keeps the patterns in file order. Root sets store the checksum of the table their Arabic roots
+// are found with.
+type ArabicPatternTable struct {
+	Checksum string
+	patterns []*ArabicPattern
+}
+
+var arabicPatternTable = MustParseArabicPatterns(strings.NewReader(defaultArabicPatternsData))
+
+var arabicRootIndex *KeyIndex
+
+// arabicLetterReplacer writes the letters of an Arabic word in the forms compared with patterns
+var arabicLetterReplacer = strings.NewReplacer("أ", "ا", "إ", "ا", "آ", "ا", "ٱ", "ا", "ؤ", "ء", "ئ", "ء", "ي", "ی", "ى", "ی",
+	"ك", "ک", "ة", "ه", "ۀ", "ه")
+
+// arabicLetters returns the letters of an Ottoman word compared with patterns. Marks, joiners and tatweel are removed.
+// It returns nil for words not written like Arabic words, with Turkish letters or other characters.
+func arabicLetters(word string) []rune {
+	word = arabicLetterReplacer.Replace(NormalizeArabic(word))
+	letters := make([]rune, 0, len(word))
+	for _, r := range word {
+		switch {
+		case unicode.IsMark(r) || r == '\u200C' || r == '\u200D':
+		case !unicode.In(r, unicode.Arabic) || !unicode.IsLetter(r) || strings.ContainsRune(turkishLetters, r):
+			return nil
+		default:
+			letters = append(letters, r)
+		}
+	}
+	return letters
+}
+
+// ParseArabicPatterns reads an Arabic pattern table in CSV format. Lines beginning with # are comments.
+func ParseArabicPatterns(reader io.Reader) (*ArabicPatternTable, error) {
+	csvr := csv.NewReader(reader)
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 4
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	pt := ArabicPatternTable{patterns: make([]*ArabicPattern, 0, len(records))}
+	names := make(map[string]bool)
+	hash := sha256.New()
+
+	for i, record := range records {
+		if i == 0 && record[0] == "name" {
+			continue
+		}
+
+		ap := ArabicPattern{Name: strings.TrimSpace(record[0]), Ottoman: strings.TrimSpace(record[1]), Latin: strings.TrimSpace(record[2]), Example: strings.TrimSpace(record[3])}
+		if names[ap.Name] {
+			return nil, fmt.Errorf("Pattern %s is defined more than once", ap.Name)
+		}
+		names[ap.Name] = true
+
+		ap.letters = arabicLetters(ap.Ottoman)
+		if len(ap.letters) == 0 || len(ap.letters) != len([]rune(ap.Ottoman)) {
+			return nil, fmt.Errorf("Pattern %s: %s is not written in Arabic letters without marks", ap.Name, ap.Ottoman)
+		}
+		// root letters are ف ع ل in order, a ل after the third root letter is the fourth one
+		next := 0
+		for _, r := range ap.letters {
+			switch {
+			case next < 3 && r == []rune(arabicRadicals)[next], next == 3 && r == 'ل':
+				ap.radicals = append(ap.radicals, next)
+				next++
+			default:
+				ap.radicals = append(ap.radicals, -1)
+				ap.patternLetters++
+			}
+		}
+		if next < 3 {
+			return nil, fmt.Errorf("Pattern %s: %s should have the root letters %s", ap.Name, ap.Ottoman, arabicRadicals)
+		}
+		ap.rootLetters = next
+		if err := ap.checkLatin(); err != nil {
+			return nil, err
+		}
+
+		fmt.Fprintf(hash, "%s|%s|%s\n", ap.Name, ap.Ottoman, ap.Latin)
+		pt.patterns = append(pt.patterns, &ap)
+	}
+
+	pt.Checksum = hex.EncodeToString(hash.Sum(nil))[:8]
+	return &pt, nil
+}
+
+// checkLatin checks that the Latin spelling of a pattern has each of its root letters and no others
+func (ap *ArabicPattern) checkLatin() error {
+	for i := 1; i <= 4; i++ {
+		has := strings.ContainsRune(ap.Latin, rune('0'+i))
+		if has != (i <= ap.rootLetters) {
+			return fmt.Errorf("Pattern %s: %s should have the root letters 1 to %d", ap.Name, ap.Latin, ap.rootLetters)
+		}
+	}
+	if strings.ContainsAny(ap.Latin, "056789") {
+		return fmt.Errorf("Pattern %s: %s has a root letter other than 1 to %d", ap.Name, ap.Latin, ap.rootLetters)
+	}
+	return nil
+}
+
+// MustParseArabicPatterns is like ParseArabicPatterns but panics if the table cannot be parsed
+func MustParseArabicPatterns(reader io.Reader) *ArabicPatternTable {
+	pt, err := ParseArabicPatterns(reader)
+	if err != nil {
+		panic(err)
+	}
+	return pt
+}
+
+// LoadArabicPatterns reads an Arabic pattern table file and makes it the table used by the package.
+// It should be called before InitSearch as the table is used to group roots by their Arabic roots.
+func LoadArabicPatterns(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	pt, err := ParseArabicPatterns(file)
+	if err != nil {
+		return err
+	}
+
+	arabicPatternTable = pt
+	return nil
+}
+
+// InitArabicPatterns replaces the embedded Arabic pattern table with a file, if filename is not empty.
+// If the file cannot be loaded, the embedded table is used.
+func InitArabicPatterns(filename string) {
+	if filename == "" {
+		return
+	}
+	if err := LoadArabicPatterns(filename); err != nil {
+		log.Printf("Arabic pattern table is not loaded: %s", err)
+	}
+}
+
+// rootPositions returns the positions of the root letters in letters if they follow the pattern, or nil.
+// Root letters are consonants, alef is a root letter only at the beginning, where it's read as hamza. A final ه is
+// read as an ending, like in حادثه or the Persian خانه, and isn't a root letter.
+func (ap *ArabicPattern) rootPositions(letters []rune) []int {
+	if len(letters) != len(ap.letters) {
+		return nil
+	}
+	positions := make([]int, 0, 4)
+	for i, r := range letters {
+		switch {
+		case ap.radicals[i] < 0 && r != ap.letters[i]:
+			return nil
+		case ap.radicals[i] >= 0 && r == 'ا' && i > 0:
+			return nil
+		case ap.radicals[i] >= 0 && r == 'ه' && i == len(letters)-1:
+			return nil
+		case ap.radicals[i] >= 0:
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
+
+// arabicRootMatch is a pattern a word follows after its affixes are stripped
+type arabicRootMatch struct {
+	pattern *ArabicPattern
+	// positions of the root letters in the letters of the word
+	positions []int
+	score     int
+}
+
+// root returns the root letters of a match, alef at the beginning is written as hamza
+func (m *arabicRootMatch) root(letters []rune) string {
+	root := make([]rune, len(m.positions))
+	for i, p := range m.positions {
+		root[i] = letters[p]
+		if root[i] == 'ا' {
+			root[i] = 'ء'
+		}
+	}
+	return string(root)
+}
+
+// matches returns the patterns letters follow with and without affixes, the best first. Each pattern letter adds
+// 2 to the score of a match, each stripped affix and a fourth root letter subtract 1 and 2.
+func (pt *ArabicPatternTable) matches(letters []rune) []*arabicRootMatch {
+	matches := make([]*arabicRootMatch, 0)
+	word := string(letters)
+	for _, prefix := range arabicRootPrefixes {
+		for _, suffix := range arabicRootSuffixes {
+			if !strings.HasPrefix(word, prefix) || !strings.HasSuffix(word, suffix) || len(word) < len(prefix)+len(suffix) {
+				continue
+			}
+			stripped := []rune(word[len(prefix) : len(word)-len(suffix)])
+			offset := len([]rune(prefix))
+			for _, ap := range pt.patterns {
+				positions := ap.rootPositions(stripped)
+				if positions == nil {
+					continue
+				}
+				for i := range positions {
+					positions[i] += offset
+				}
+				score := 2*ap.patternLetters - TFint(prefix != "", 1, 0) - TFint(suffix != "", 1, 0) - TFint(len(positions) > 3, 2, 0)
+				matches = append(matches, &arabicRootMatch{pattern: ap, positions: positions, score: score})
+			}
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+	return matches
+}
+
+// ArabicRoot returns the probable Arabic root of an Ottoman word, like کتب for مکتوب or مکتب. The root is found by the
+// pattern with the most pattern letters the word follows. Words not following a pattern, and words with Turkish
+// letters or spaces, have no root and an empty string is returned.
+func ArabicRoot(word string) string {
+	letters := arabicLetters(word)
+	if len(letters) == 0 {
+		return ""
+	}
+	matches := arabicPatternTable.matches(letters)
+	if len(matches) == 0 {
+		return ""
+	}
+	return matches[0].root(letters)
+}
+
+// arabicRootUnits returns the indices of the units holding the root letters of the word they spell
+func arabicRootUnits(units []matchUnit) []int {
+	letters := make([]rune, 0, len(units))
+	owners := make([]int, 0, len(units))
+	for i, u := range units {
+		for _, r := range arabicLetters(u.unicode) {
+			letters = append(letters, r)
+			owners = append(owners, i)
+		}
+	}
+
+	matched := make([]int, 0, 4)
+	matches := arabicPatternTable.matches(letters)
+	if len(matches) == 0 {
+		return matched
+	}
+	for _, p := range matches[0].positions {
+		if len(matched) == 0 || matched[len(matched)-1] != owners[p] {
+			matched = append(matched, owners[p])
+		}
+	}
+	return matched
+}
+
+// arabicRootKey returns the Arabic root of r
+func arabicRootKey(r *Root) string {
+	return r.ArabicRoot
+}
+
+// AddArabicRoots finds the Arabic roots of the roots in rs with the pattern table in use and stores the checksum of
+// the table in rs. It returns the number of roots with an Arabic root.
+func AddArabicRoots(rs *RootSet) int {
+	found := 0
+	for _, r := range rs.Roots {
+		r.ArabicRoot = ""
+		if r.Ottoman != nil {
+			r.ArabicRoot = ArabicRoot(r.Ottoman.Unicode)
+		}
+		if r.ArabicRoot != "" {
+			found++
+		}
+	}
+	rs.ArabicPatterns = arabicPatternTable.Checksum
+	return found
+}
+
+// buildArabicRootIndex indexes roots by their Arabic roots, stored by csv2protobuf. Roots of root sets built without
+// Arabic roots, or with another pattern table, are analyzed again before they are indexed.
+func buildArabicRootIndex(rs *RootSet) {
+	if rs.ArabicPatterns != arabicPatternTable.Checksum {
+		log.Printf("Root set has Arabic roots of pattern table %q, they are found again with table %s", rs.ArabicPatterns, arabicPatternTable.Checksum)
+		AddArabicRoots(rs)
+	}
+	arabicRootIndex = BuildKeyIndex(rs.Roots, arabicRootKey)
+}
+
+// familyKeys returns the Arabic roots searched for word. Ottoman words are an Arabic root, like کتب or ک-ت-ب, if it's
+// in the index, or a word whose root is found by patterns. Latin words are looked up in the dictionary.
+func familyKeys(word string) []string {
+	keys := make([]string, 0)
+	add := func(key string) {
+		for _, k := range keys {
+			if k == key {
+				return
+			}
+		}
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	switch DetectField(word) {
+	case SearchField_VISENC:
+		word = VisencToUnicode(word)
+	case SearchField_OTTOMAN:
+	default:
+		for _, r := range PrefixSearchTurkishLatinExact(word) {
+			add(arabicRootKey(r))
+		}
+		return keys
+	}
+
+	letters := arabicLetters(strings.NewReplacer(" ", "", "-", "").Replace(word))
+	if len(letters) <= 4 && len(arabicRootIndex.Lookup(string(letters))) > 0 {
+		add(string(letters))
+	}
+	add(ArabicRoot(word))
+	return keys
+}
+
+// FamilySearch returns at most maxLen roots derived from the same Arabic root as word, the roots listed by more
+// dictionaries first. word is an Arabic root or an Ottoman, visenc or Turkish Latin word.
+func FamilySearch(word string, maxLen int) []*Root {
+	results := make([]*Root, 0)
+	for _, key := range familyKeys(word) {
+		results = append(results, rootsFromIndices(arabicRootIndex.Lookup(key))...)
+	}
+	results = filterResults(results)
+	sort.SliceStable(results, func(i, j int) bool { return len(results[i].Sources) > len(results[j].Sources) })
+	if maxLen < len(results) {
+		results = results[:maxLen]
+	}
+	return results
+}
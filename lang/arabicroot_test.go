@@ -0,0 +1,139 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// func ParseArabicPatterns(reader io.Reader) (*ArabicPatternTable, error) {
+func TestParseArabicPatterns(t *testing.T) {
+	badTables := map[string]string{
+		"duplicate name":    "name,ottoman,latin,example\nfa'l,فعل,1e23,cehl جهل\nfa'l,فعال,1i2â3,kitâb کتاب\n",
+		"no root letters":   "name,ottoman,latin,example\nmef'ûl,مکتوب,me12û3,mektûb مکتوب\n",
+		"root letter order": "name,ottoman,latin,example\nlu'f,لعف,3u21,\n",
+		"Latin pattern":     "name,ottoman,latin,example\nfa'l,fa'l,1e23,\n",
+		"marks":             "name,ottoman,latin,example\nfa'l,فَعْل,1e23,\n",
+		"missing field":     "name,ottoman,latin,example\nfa'l,فعل,1e23\n",
+		"Latin root letter": "name,ottoman,latin,example\nfa'l,فعل,1e2,\n",
+		"fourth root":       "name,ottoman,latin,example\nfa'l,فعل,1e234,\n",
+	}
+
+	for i, o := range badTables {
+		if _, err := ParseArabicPatterns(strings.NewReader(o)); err == nil {
+			t.Log(fmt.Sprintf("%s should fail ParseArabicPatterns", i))
+			t.Fail()
+		}
+	}
+
+	// every example follows its own pattern
+	for _, ap := range arabicPatternTable.patterns {
+		fields := strings.Fields(ap.Example)
+		if len(fields) != 2 || ap.rootPositions(arabicLetters(fields[1])) == nil {
+			t.Log(fmt.Sprintf("Example %s should follow %s %s", ap.Example, ap.Name, ap.Ottoman))
+			t.Fail()
+		}
+	}
+}
+
+// func ArabicRoot(word string) string {
+func TestArabicRoot(t *testing.T) {
+	testDict := map[string]string{
+		"مکتوب":    "کتب",
+		"مکتب":     "کتب",
+		"كاتب":     "کتب",
+		"کتاب":     "کتب",
+		"کتابت":    "کتب",
+		"الکتاب":   "کتب",
+		"مكتوبات":  "کتب",
+		"استقبال":  "قبل",
+		"اجتماع":   "جمع",
+		"تعلیم":    "علم",
+		"حوادث":    "حدث",
+		"حرکت":     "حرک",
+		"مترجم":    "ترجم",
+		"اکمال":    "کمل",
+		"مُکْتُوب": "کتب",
+		// Turkish words and words following no pattern
+		"خانه":      "",
+		"پاره":      "",
+		"کتابچه":    "",
+		"کتاب خانه": "",
+		"kitap":     "",
+	}
+
+	for w, e := range testDict {
+		if r := ArabicRoot(w); r != e {
+			t.Log(fmt.Sprintf("%s should be %s for ArabicRoot: %s", w, e, r))
+			t.Fail()
+		}
+	}
+}
+
+// func buildArabicRootIndex(rs *RootSet) {
+func TestBuildArabicRootIndex(t *testing.T) {
+	index := arabicRootIndex
+	defer func() { arabicRootIndex = index }()
+
+	mektup := NewRoot("mektup", "mkbo2wbu1", PartOfSpeech_NOUN)
+
+	// stored roots are kept for the pattern table in use
+	mektup.ArabicRoot = "ك-ت-ب"
+	buildArabicRootIndex(&RootSet{Roots: []*Root{mektup}, ArabicPatterns: arabicPatternTable.Checksum})
+	if mektup.ArabicRoot != "ك-ت-ب" {
+		t.Log(fmt.Sprintf("Arabic roots of the pattern table in use should be kept: %s", mektup.ArabicRoot))
+		t.Fail()
+	}
+
+	// roots found with another table, or none, are found again
+	for _, checksum := range []string{"", "00000000"} {
+		rs := &RootSet{Roots: []*Root{mektup}, ArabicPatterns: checksum}
+		buildArabicRootIndex(rs)
+		if mektup.ArabicRoot != "کتب" || rs.ArabicPatterns != arabicPatternTable.Checksum || len(arabicRootIndex.Lookup("کتب")) != 1 {
+			t.Log(fmt.Sprintf("Arabic roots of pattern table %q should be found again: %s %s", checksum, mektup.ArabicRoot, rs.ArabicPatterns))
+			t.Fail()
+		}
+		mektup.ArabicRoot = "ك-ت-ب"
+	}
+}
+
+// func FamilySearch(word string, maxLen int) []*Root {
+func TestFamilySearch(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	testDict := map[string][]string{
+		"کتب":       {"مکتوب", "کاتب", "کتاب"},
+		"ک-ت-ب":     {"مکتوب", "کاتب"},
+		"مکتوب":     {"مکتوب", "کاتب"},
+		"mektup":    {"مکتوب", "کاتب"},
+		"mkbo2ebu1": {"مکتوب", "کاتب"},
+		"تعلیم":     {"معلم"},
+	}
+
+	for w, expected := range testDict {
+		roots := FamilySearch(w, 1000)
+		found := make(map[string]bool)
+		for _, r := range roots {
+			found[r.Ottoman.Unicode] = true
+			if r.ArabicRoot == "" {
+				t.Log(fmt.Sprintf("%s should have an Arabic root for %s", r.Ottoman.Unicode, w))
+				t.Fail()
+			}
+		}
+		for _, e := range expected {
+			if !found[e] {
+				t.Log(fmt.Sprintf("%s should be in the family of %s: %d roots", e, w, len(roots)))
+				t.Fail()
+			}
+		}
+	}
+
+	if roots := FamilySearch("kitap", 3); len(roots) > 3 {
+		t.Log(fmt.Sprintf("FamilySearch should return at most 3 roots: %d", len(roots)))
+		t.Fail()
+	}
+	if roots := FamilySearch("خانه", 10); len(roots) != 0 {
+		t.Log(fmt.Sprintf("خانه should have no family: %d roots", len(roots)))
+		t.Fail()
+	}
+}
@@ -0,0 +1,419 @@
+package lang
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Aruz syllable weights. A closed syllable with a long vowel or ending in two consonants is overlong (med)
+// and counts as a long and a short syllable.
+const (
+	AruzShort    = "."
+	AruzLong     = "-"
+	AruzOverlong = "-."
+	// aruzAnceps is used in meter patterns for positions that may be filled by either weight
+	aruzAnceps = "~"
+)
+
+// Costs of aruz licenses used while matching a line to a meter
+const (
+	imaleCost        = 1.0
+	writtenImaleCost = 0.5
+	zihafCost        = 1.0
+	particleCost     = 0.25
+	medDropCost      = 0.5
+	violationCost    = 3.0
+)
+
+// aruzMeter is a meter in the catalogue with its feet and the pattern derived from them
+type aruzMeter struct {
+	name string
+	feet string
+	// pattern contains feet separated by spaces with ".", "-" and "~" for anceps positions
+	pattern string
+}
+
+// AruzMeters lists the common meters of divan poetry used for scansion
+var AruzMeters = []aruzMeter{
+	{"hezec-i müsemmen-i sâlim", "mefâîlün mefâîlün mefâîlün mefâîlün", ".--- .--- .--- .---"},
+	{"hezec-i müsemmen-i ahreb", "mef'ûlü mefâîlü mefâîlü feûlün", "--. .--. .--. .--"},
+	{"hezec-i müsemmen-i ahreb-i mekfûf", "mef'ûlü mefâîlün mef'ûlü mefâîlün", "--. .--- --. .---"},
+	{"hezec-i müseddes-i mahzûf", "mefâîlün mefâîlün feûlün", ".--- .--- .--"},
+	{"hezec-i müseddes-i ahreb-i mekfûf-i mahzûf", "mef'ûlü mefâilün feûlün", "--. .-.- .--"},
+	{"remel-i müsemmen-i sâlim", "fâilâtün fâilâtün fâilâtün fâilâtün", "-.-- -.-- -.-- -.--"},
+	{"remel-i müsemmen-i mahzûf", "fâilâtün fâilâtün fâilâtün fâilün", "-.-- -.-- -.-- -.-"},
+	{"remel-i müseddes-i mahzûf", "fâilâtün fâilâtün fâilün", "-.-- -.-- -.-"},
+	{"remel-i müsemmen-i mahbûn-i mahzûf", "feilâtün feilâtün feilâtün feilün", "~.-- ..-- ..-- ..-"},
+	{"remel-i müseddes-i mahbûn-i mahzûf", "feilâtün feilâtün feilün", "~.-- ..-- ..-"},
+	{"müctess-i müsemmen-i mahbûn-i mahzûf", "mefâilün feilâtün mefâilün feilün", ".-.- ..-- .-.- ..-"},
+	{"recez-i müsemmen-i sâlim", "müstef'ilün müstef'ilün müstef'ilün müstef'ilün", "--.- --.- --.- --.-"},
+	{"muzâri-i müsemmen-i ahreb", "mef'ûlü fâilâtü mefâîlü fâilün", "--. -.-. .--. -.-"},
+	{"mütekârib-i müsemmen-i mahzûf", "feûlün feûlün feûlün feûl", ".-- .-- .-- .-"},
+	{"münserih-i müsemmen-i matvî", "müfteilün fâilün müfteilün fâilün", "-..- -.- -..- -.-"},
+	{"serî-i müseddes-i matvî", "müfteilün müfteilün fâilün", "-..- -..- -.-"},
+}
+
+// aruzParticles are short Turkish words that may be read short or long freely
+var aruzParticles = map[string]bool{
+	"ve": true, "ki": true, "bu": true, "şu": true, "o": true, "ne": true,
+	"de": true, "da": true, "ü": true, "vü": true, "ile": true, "mi": true, "mı": true,
+}
+
+// aruzReading is one of the ways a syllable can be read with the cost of the license it uses
+type aruzReading struct {
+	weight  string
+	cost    float64
+	license string
+}
+
+// aruzSyllable keeps a syllable with its natural weight and the readings allowed by aruz conventions
+type aruzSyllable struct {
+	text     string
+	word     string
+	weight   string
+	readings []aruzReading
+}
+
+func isVowelRune(r rune) bool {
+	return strings.ContainsRune(allVowels, r)
+}
+
+// syllableWeight returns the natural aruz weight of a syllable
+func syllableWeight(syl string) string {
+	runes := []rune(syl)
+	vowelPos := -1
+	for i, r := range runes {
+		if isVowelRune(r) {
+			vowelPos = i
+			break
+		}
+	}
+
+	if vowelPos < 0 {
+		return AruzShort
+	}
+
+	longVowel := strings.ContainsRune(LONGVOWELS, runes[vowelPos])
+	coda := len(runes) - vowelPos - 1
+
+	switch {
+	case coda == 0 && longVowel:
+		return AruzLong
+	case coda == 0:
+		return AruzShort
+	case coda >= 2 || longVowel:
+		return AruzOverlong
+	default:
+		return AruzLong
+	}
+}
+
+// writtenVowels returns for each vowel of latin whether the Ottoman spelling writes it with elif, vav, ye or final he.
+// The alignment walks both spellings and is a heuristic, missing letters are considered not written.
+func writtenVowels(latin string, visenc string) []bool {
+	groups := SplitVisenc(DotlessSearchKey(visenc), false)
+	isVowelLetter := func(i int) bool {
+		if i >= len(groups) {
+			return false
+		}
+		g := groups[i]
+		return g == "e" || g == "w" || g == "y" || (g == "h" && i == len(groups)-1)
+	}
+
+	written := make([]bool, 0)
+	pos := 0
+	for i, r := range []rune(TurkishLower(latin)) {
+		if isVowelRune(r) {
+			if i == 0 && pos < len(groups) && groups[pos] == "e" {
+				pos++
+				if r == 'a' || r == 'â' || r == 'e' {
+					written = append(written, true)
+					continue
+				}
+			}
+			if isVowelLetter(pos) {
+				written = append(written, true)
+				pos++
+			} else {
+				written = append(written, false)
+			}
+		} else if unicode.IsLetter(r) {
+			if (r == 'v' && pos < len(groups) && groups[pos] == "w") || (r == 'y' && pos < len(groups) && groups[pos] == "y") {
+				pos++
+				continue
+			}
+			for isVowelLetter(pos) && pos < len(groups)-1 {
+				pos++
+			}
+			pos++
+		}
+	}
+	return written
+}
+
+// verseWord is a word of a verse line with its Latin reading and Ottoman spelling if found in the dictionary
+type verseWord struct {
+	latin  string
+	visenc string
+}
+
+// verseWords splits a line into words and finds the Latin reading of Ottoman words and the spelling of Latin ones
+func verseWords(line string) []verseWord {
+	words := make([]verseWord, 0)
+	for _, w := range strings.FieldsFunc(line, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '.' || r == ';' || r == '!' || r == '?' || r == '-' || r == '\t'
+	}) {
+		vw := verseWord{latin: w}
+		if ContainsArabicChars(w) {
+			vw.visenc = UnicodeToVisenc(w)
+			vw.latin = ""
+			if rootSet != nil {
+				if roots := PrefixSearchUnicodeExact(w); len(roots) > 0 {
+					vw.latin = roots[0].TurkishLatin
+				}
+			}
+		} else if rootSet != nil {
+			if roots := PrefixSearchTurkishLatinExact(TurkishLower(w)); len(roots) > 0 {
+				vw.visenc = roots[0].Ottoman.Visenc
+			}
+		}
+		if vw.latin != "" {
+			words = append(words, vw)
+		}
+	}
+	return words
+}
+
+// aruzSyllables splits a line into syllables and computes the readings each syllable permits
+func aruzSyllables(line string) []aruzSyllable {
+	words := verseWords(line)
+	out := make([]aruzSyllable, 0)
+
+	for wi, w := range words {
+		syllables := Syllabify(w.latin)
+		var written []bool
+		if w.visenc != "" {
+			written = writtenVowels(w.latin, w.visenc)
+		}
+		nextStartsWithVowel := false
+		if wi+1 < len(words) {
+			if r := []rune(TurkishLower(words[wi+1].latin)); len(r) > 0 && isVowelRune(r[0]) {
+				nextStartsWithVowel = true
+			}
+		}
+		particle := aruzParticles[TurkishLower(w.latin)]
+
+		for si, syl := range syllables {
+			weight := syllableWeight(syl)
+			s := aruzSyllable{text: syl, word: w.latin, weight: weight}
+			lastOfWord := si == len(syllables)-1
+			isWritten := si < len(written) && written[si]
+
+			switch weight {
+			case AruzShort:
+				s.readings = append(s.readings, aruzReading{AruzShort, 0, ""})
+				if particle {
+					s.readings = append(s.readings, aruzReading{AruzLong, particleCost, "imale"})
+				} else if isWritten {
+					s.readings = append(s.readings, aruzReading{AruzLong, writtenImaleCost, "imale"})
+				} else {
+					s.readings = append(s.readings, aruzReading{AruzLong, imaleCost, "imale"})
+				}
+			case AruzLong:
+				s.readings = append(s.readings, aruzReading{AruzLong, 0, ""})
+				if lastOfWord && nextStartsWithVowel && !strings.ContainsAny(syl, LONGVOWELS) {
+					s.readings = append(s.readings, aruzReading{AruzShort, 0, "vasl"})
+				} else if particle {
+					s.readings = append(s.readings, aruzReading{AruzShort, particleCost, "zihaf"})
+				} else {
+					s.readings = append(s.readings, aruzReading{AruzShort, zihafCost, "zihaf"})
+				}
+			case AruzOverlong:
+				s.readings = append(s.readings, aruzReading{AruzOverlong, 0, "med"})
+				if lastOfWord && nextStartsWithVowel {
+					s.readings = append(s.readings, aruzReading{AruzLong, 0, "vasl"})
+				} else {
+					s.readings = append(s.readings, aruzReading{AruzLong, medDropCost, ""})
+				}
+			}
+			out = append(out, s)
+		}
+	}
+
+	// the last syllable of a line always counts as long
+	if n := len(out); n > 0 {
+		out[n-1].readings = []aruzReading{{AruzLong, 0, ""}}
+	}
+
+	return out
+}
+
+// aruzStep records the choice made in the alignment for backtracking
+type aruzStep struct {
+	prevI, prevJ int
+	reading      int
+	mismatch     bool
+}
+
+// matchMeter aligns syllables to the meter pattern and returns the minimum cost, indices of syllables
+// that break the meter and the reading chosen for each syllable (-1 if the syllable is left out)
+func matchMeter(syllables []aruzSyllable, pattern string) (float64, []int, []int) {
+	slots := []rune(strings.ReplaceAll(pattern, " ", ""))
+	// the last position of a line is always free
+	if len(slots) > 0 {
+		slots[len(slots)-1] = []rune(aruzAnceps)[0]
+	}
+
+	n, m := len(syllables), len(slots)
+	inf := math.Inf(1)
+	cost := make([][]float64, n+1)
+	steps := make([][]aruzStep, n+1)
+	for i := range cost {
+		cost[i] = make([]float64, m+1)
+		steps[i] = make([]aruzStep, m+1)
+		for j := range cost[i] {
+			cost[i][j] = inf
+		}
+	}
+	cost[0][0] = 0
+
+	fits := func(weight string, j int) (bool, bool) {
+		w := []rune(weight)
+		if j+len(w) > m {
+			return false, false
+		}
+		for k, r := range w {
+			if slots[j+k] != r && string(slots[j+k]) != aruzAnceps {
+				return true, true
+			}
+		}
+		return true, false
+	}
+
+	for i := 0; i <= n; i++ {
+		for j := 0; j <= m; j++ {
+			c := cost[i][j]
+			if math.IsInf(c, 1) {
+				continue
+			}
+			if i < n {
+				for ri, rd := range syllables[i].readings {
+					ok, mismatch := fits(rd.weight, j)
+					if !ok {
+						continue
+					}
+					nc := c + rd.cost
+					if mismatch {
+						nc += violationCost
+					}
+					nj := j + len([]rune(rd.weight))
+					if nc < cost[i+1][nj] {
+						cost[i+1][nj] = nc
+						steps[i+1][nj] = aruzStep{i, j, ri, mismatch}
+					}
+				}
+				// a syllable too many for the meter
+				if nc := c + violationCost; nc < cost[i+1][j] {
+					cost[i+1][j] = nc
+					steps[i+1][j] = aruzStep{i, j, -1, true}
+				}
+			}
+			// a meter position left empty
+			if j < m {
+				if nc := c + violationCost; nc < cost[i][j+1] {
+					cost[i][j+1] = nc
+					steps[i][j+1] = aruzStep{i, j, -2, true}
+				}
+			}
+		}
+	}
+
+	readings := make([]int, n)
+	for i := range readings {
+		readings[i] = -1
+	}
+	violations := make([]bool, n)
+	for i, j := n, m; i > 0 || j > 0; {
+		st := steps[i][j]
+		// positions left empty are flagged at the syllable before them, or at the first syllable
+		if st.reading == -2 {
+			if i > 0 {
+				violations[i-1] = true
+			} else if n > 0 {
+				violations[0] = true
+			}
+		} else {
+			readings[i-1] = st.reading
+			violations[i-1] = violations[i-1] || st.mismatch
+		}
+		i, j = st.prevI, st.prevJ
+	}
+
+	flagged := make([]int, 0)
+	for i, v := range violations {
+		if v {
+			flagged = append(flagged, i)
+		}
+	}
+
+	return cost[n][m], flagged, readings
+}
+
+// ScanVerse splits a verse line in Latin or Ottoman script into syllables, matches them against AruzMeters
+// and returns the best fitting meter with the syllables that break it. Lines without syllables have no meter.
+func ScanVerse(line string) *VerseScan {
+	syllables := aruzSyllables(line)
+	scan := &VerseScan{Line: line, Syllables: make([]*AruzSyllable, len(syllables)), Violations: make([]int32, 0)}
+	if len(syllables) == 0 {
+		return scan
+	}
+
+	type candidate struct {
+		meter      aruzMeter
+		cost       float64
+		violations []int
+		readings   []int
+	}
+
+	candidates := make([]candidate, 0, len(AruzMeters))
+	for _, meter := range AruzMeters {
+		c, v, r := matchMeter(syllables, meter.pattern)
+		candidates = append(candidates, candidate{meter, c, v, r})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].cost < candidates[j].cost })
+
+	if len(candidates) == 0 {
+		return scan
+	}
+
+	best := candidates[0]
+	scan.Meter = &AruzMeter{Name: best.meter.name, Feet: best.meter.feet, Pattern: best.meter.pattern}
+	scan.Cost = best.cost
+
+	readings := best.readings
+	var sb strings.Builder
+	violated := make(map[int]bool)
+	for _, v := range best.violations {
+		violated[v] = true
+		scan.Violations = append(scan.Violations, int32(v))
+	}
+
+	for i, s := range syllables {
+		as := &AruzSyllable{Text: s.text, Word: s.word, Weight: s.weight, Violation: violated[i]}
+		if r := readings[i]; r >= 0 {
+			rd := s.readings[r]
+			as.Reading = rd.weight
+			if rd.license != "" {
+				as.Licenses = append(as.Licenses, rd.license)
+			}
+		}
+		sb.WriteString(as.Reading)
+		scan.Syllables[i] = as
+	}
+	scan.Scansion = sb.String()
+
+	return scan
+}
@@ -0,0 +1,102 @@
+package lang
+
+import (
+	"fmt"
+	"testing"
+)
+
+// func Syllabify(s string) []string {
+func TestSyllabify(t *testing.T) {
+	testDict := map[string][]string{
+		"kitap":    {"ki", "tap"},
+		"türkçe":   {"türk", "çe"},
+		"saat":     {"sa", "at"},
+		"araba":    {"a", "ra", "ba"},
+		"İstanbul": {"is", "tan", "bul"},
+		"ağ'ın":    {"a", "ğın"},
+		"":         {},
+	}
+
+	for i, o := range testDict {
+		if got := Syllabify(i); !CompareStringSlices(got, o) {
+			t.Log(fmt.Sprintf("%s, %v fails for Syllabify: %v", i, o, got))
+			t.Fail()
+		}
+	}
+}
+
+// func syllableWeight(syl string) string {
+func TestSyllableWeight(t *testing.T) {
+	testDict := map[string]string{
+		"be":   AruzShort,
+		"fâ":   AruzLong,
+		"can":  AruzLong,
+		"yâr":  AruzOverlong,
+		"türk": AruzOverlong,
+	}
+
+	for i, o := range testDict {
+		if syllableWeight(i) != o {
+			t.Log(fmt.Sprintf("%s, %s fails for syllableWeight", i, o))
+			t.Fail()
+		}
+	}
+}
+
+// func matchMeter(syllables []aruzSyllable, pattern string) (float64, []int, []int) {
+func TestMatchMeter(t *testing.T) {
+	syllables := func(weights ...string) []aruzSyllable {
+		out := make([]aruzSyllable, len(weights))
+		for i, w := range weights {
+			out[i] = aruzSyllable{weight: w, readings: []aruzReading{{weight: w}}}
+		}
+		return out
+	}
+
+	type testCase struct {
+		syllables []aruzSyllable
+		pattern   string
+		broken    bool
+	}
+
+	testCases := []testCase{
+		{syllables(AruzShort, AruzLong, AruzLong, AruzShort), ".--.", false},
+		// lines shorter than their meter are flagged where positions are left empty
+		{syllables(AruzShort, AruzLong), ".--.", true},
+		{syllables(AruzLong, AruzShort), "-.-.", true},
+		{syllables(AruzLong), ".-", true},
+	}
+
+	for _, tc := range testCases {
+		cost, flagged, _ := matchMeter(tc.syllables, tc.pattern)
+		if (len(flagged) > 0) != tc.broken || (cost > 0) != tc.broken {
+			t.Log(fmt.Sprintf("%v, %s should be broken %t for matchMeter: %v with cost %f", tc.syllables, tc.pattern, tc.broken, flagged, cost))
+			t.Fail()
+		}
+	}
+}
+
+// func ScanVerse(line string) *VerseScan {
+func TestScanVerse(t *testing.T) {
+	testDict := map[string]string{
+		"Beni candan usandırdı cefâdan yâr usanmaz mı": "mefâîlün mefâîlün mefâîlün mefâîlün",
+		"Ey kadd-i girân bâr-ı gamun bârgirânum":       "mef'ûlü mefâîlü mefâîlü feûlün",
+		"Sana lâyık olan ey dil eşi yok gül gibi yâr":  "feilâtün feilâtün feilâtün feilün",
+		"Bir şûle-i cevvâle olup ben de giderdim":      "mef'ûlü mefâîlü mefâîlü feûlün",
+	}
+
+	for i, o := range testDict {
+		scan := ScanVerse(i)
+		if scan.Meter == nil || scan.Meter.Feet != o {
+			t.Log(fmt.Sprintf("%s, %s fails for ScanVerse: %v", i, o, scan.Meter))
+			t.Fail()
+		}
+	}
+
+	for _, i := range []string{"", "  ", "...", "123"} {
+		if scan := ScanVerse(i); scan.Meter != nil || len(scan.Syllables) > 0 {
+			t.Log(fmt.Sprintf("%q has no syllables and should have no meter for ScanVerse: %v", i, scan.Meter))
+			t.Fail()
+		}
+	}
+}
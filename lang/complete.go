@@ -0,0 +1,284 @@
+package lang
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// CompletionK is the number of completions kept for each prefix
+const CompletionK = 20
+
+// completionScanLimit is the number of keys a prefix may have to be ranked when it's completed, prefixes with more keys
+// keep their top CompletionK keys in CompletionIndex
+const completionScanLimit = 256
+
+// CompletionIndex finds the best keys of a KeyIndex beginning with a prefix.
+// Keys are ranked by the frequency of their words in the language model, then by the number of dictionaries listing them
+// and then shorter keys first.
+type CompletionIndex struct {
+	index *KeyIndex
+	// frequency and sources of the best root of each key
+	frequencies []int64
+	sources     []int
+	// top keeps the best key positions of prefixes with more than completionScanLimit keys, these are the upper nodes of the key trie
+	top map[string][]int32
+}
+
+var turkishLatinCompletionIndex *CompletionIndex
+var visencCompletionIndex *CompletionIndex
+var unicodeCompletionIndex *CompletionIndex
+var phoneticCompletionIndex *CompletionIndex
+
+// buildCompletionIndices builds the completion indices of the key indices, it's called again when a language model is loaded
+func buildCompletionIndices() {
+	if rootSet == nil {
+		return
+	}
+	turkishLatinCompletionIndex = BuildCompletionIndex(turkishLatinIndex)
+	visencCompletionIndex = BuildCompletionIndex(visencIndex)
+	unicodeCompletionIndex = BuildCompletionIndex(unicodeIndex)
+	phoneticCompletionIndex = BuildCompletionIndex(phoneticIndex)
+}
+
+// BuildCompletionIndex ranks the keys of index and keeps the top keys of prefixes with many keys
+func BuildCompletionIndex(index *KeyIndex) *CompletionIndex {
+	ci := CompletionIndex{
+		index:       index,
+		frequencies: make([]int64, index.Len()),
+		sources:     make([]int, index.Len()),
+		top:         make(map[string][]int32),
+	}
+
+	for i := 0; i < index.Len(); i++ {
+		for _, p := range index.Postings(i) {
+			r := rootSet.Roots[p]
+			if f := wordFrequency(r.TurkishLatin); f > ci.frequencies[i] {
+				ci.frequencies[i] = f
+			}
+			if len(r.Sources) > ci.sources[i] {
+				ci.sources[i] = len(r.Sources)
+			}
+		}
+	}
+
+	// a prefix is seen first at the first key beginning with it, so only prefixes longer than the common prefix with the previous key are new
+	previous := ""
+	for i := 0; i < index.Len(); i++ {
+		key := index.Key(i)
+		common := commonPrefixLength(previous, key)
+		previous = key
+
+		for l := common; l <= len(key); {
+			prefix := key[:l]
+			lo, hi := index.PrefixRange(prefix)
+			// longer prefixes have fewer keys
+			if hi-lo <= completionScanLimit {
+				break
+			}
+			if _, exists := ci.top[prefix]; !exists {
+				ci.top[prefix] = ci.rank(lo, hi, CompletionK)
+			}
+			if l == len(key) {
+				break
+			}
+			_, size := utf8.DecodeRuneInString(key[l:])
+			l += size
+		}
+	}
+
+	return &ci
+}
+
+// commonPrefixLength returns the length of the common prefix of a and b in bytes, at a rune boundary
+func commonPrefixLength(a string, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	for n > 0 && n < len(b) && !utf8.RuneStart(b[n]) {
+		n--
+	}
+	return n
+}
+
+// wordFrequency returns the count of word in the language model, or 0
+func wordFrequency(word string) int64 {
+	if languageModel == nil {
+		return 0
+	}
+	return languageModel.model.WordCounts[strings.ToLower(word)]
+}
+
+// better checks whether the key at i ranks above the key at j
+func (ci *CompletionIndex) better(i int32, j int32) bool {
+	if ci.frequencies[i] != ci.frequencies[j] {
+		return ci.frequencies[i] > ci.frequencies[j]
+	}
+	if ci.sources[i] != ci.sources[j] {
+		return ci.sources[i] > ci.sources[j]
+	}
+	if li, lj := len(ci.index.Key(int(i))), len(ci.index.Key(int(j))); li != lj {
+		return li < lj
+	}
+	return i < j
+}
+
+// rank returns the best k key positions between lo and hi, the best first
+func (ci *CompletionIndex) rank(lo int, hi int, k int) []int32 {
+	best := make([]int32, 0, k+1)
+	for i := int32(lo); i < int32(hi); i++ {
+		if len(best) == k && !ci.better(i, best[k-1]) {
+			continue
+		}
+		pos := len(best)
+		for pos > 0 && ci.better(i, best[pos-1]) {
+			pos--
+		}
+		best = append(best, 0)
+		copy(best[pos+1:], best[pos:])
+		best[pos] = i
+		if len(best) > k {
+			best = best[:k]
+		}
+	}
+	return best
+}
+
+// Complete returns at most k roots whose keys begin with prefix, the best ranked first
+func (ci *CompletionIndex) Complete(prefix string, k int) []*Root {
+	if ci == nil {
+		return make([]*Root, 0)
+	}
+	if k <= 0 || k > CompletionK {
+		k = CompletionK
+	}
+
+	var keys []int32
+	lo, hi := ci.index.PrefixRange(prefix)
+	if top, exists := ci.top[prefix]; exists && hi-lo > completionScanLimit {
+		keys = top
+	} else {
+		keys = ci.rank(lo, hi, k)
+	}
+
+	roots := make([]*Root, 0, k)
+	for _, i := range keys {
+		roots = append(roots, rootsFromIndices(ci.index.Postings(int(i)))...)
+	}
+	roots = filterResults(roots)
+	if len(roots) > k {
+		roots = roots[:k]
+	}
+	return roots
+}
+
+// CompleteTurkishLatin returns at most k roots whose TurkishLatin begins with prefix, the most frequent first
+func CompleteTurkishLatin(prefix string, k int) []*Root {
+	return turkishLatinCompletionIndex.Complete(prefix, k)
+}
+
+// CompleteVisenc returns at most k roots whose visenc begins with prefix, the most frequent first
+func CompleteVisenc(prefix string, k int) []*Root {
+	return visencCompletionIndex.Complete(visencSearchKey(prefix), k)
+}
+
+// CompleteUnicode returns at most k roots whose Unicode begins with prefix, the most frequent first
+func CompleteUnicode(prefix string, k int) []*Root {
+	return unicodeCompletionIndex.Complete(unicodeSearchKey(prefix), k)
+}
+
+// CompleteAuto completes prefix in the field DetectField finds for it. Abjad values are not completed.
+func CompleteAuto(prefix string, k int) []*Root {
+	switch DetectField(prefix) {
+	case SearchField_OTTOMAN:
+		return CompleteUnicode(prefix, k)
+	case SearchField_VISENC:
+		return CompleteVisenc(prefix, k)
+	}
+	return CompleteTurkishLatin(prefix, k)
+}
+
+// CompleteRequest answers a completion request, Ottoman prefixes and completions are written in the requested script
+func CompleteRequest(in *CompletionRequest) *CompletionResponse {
+	profile := GetScriptProfile(in.Script)
+	prefix := in.Prefix
+	k := int(in.ResultLimit)
+
+	var roots []*Root
+	switch in.SearchField {
+	case SearchField_TURKISH_LATIN:
+		roots = CompleteTurkishLatin(prefix, k)
+	case SearchField_VISENC:
+		roots = CompleteVisenc(prefix, k)
+	case SearchField_OTTOMAN:
+		roots = CompleteUnicode(profile.OttomanUnicode(prefix), k)
+	default:
+		if ContainsArabicChars(prefix) {
+			prefix = profile.OttomanUnicode(prefix)
+		}
+		roots = CompleteAuto(prefix, k)
+	}
+
+	return &CompletionResponse{Sequence: in.Sequence, Prefix: in.Prefix, Roots: profile.LocalizeRoots(roots)}
+}
+
+// completionStream answers the requests read by recv with send until recv fails.
+// When requests arrive faster than they are answered, only the latest is answered and stale keystrokes are dropped.
+func completionStream(recv func() (*CompletionRequest, error), send func(*CompletionResponse) error) error {
+	var mu sync.Mutex
+	var pending *CompletionRequest
+	var recvErr error
+	arrived := make(chan struct{}, 1)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			in, err := recv()
+			mu.Lock()
+			if err != nil {
+				recvErr = err
+				mu.Unlock()
+				close(done)
+				return
+			}
+			pending = in
+			mu.Unlock()
+			select {
+			case arrived <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	latest := func() *CompletionRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		in := pending
+		pending = nil
+		return in
+	}
+
+	for {
+		select {
+		case <-arrived:
+			if in := latest(); in != nil {
+				if err := send(CompleteRequest(in)); err != nil {
+					return err
+				}
+			}
+		case <-done:
+			// the last input is answered before the stream is closed
+			if in := latest(); in != nil {
+				if err := send(CompleteRequest(in)); err != nil {
+					return err
+				}
+			}
+			if recvErr == io.EOF {
+				return nil
+			}
+			return recvErr
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package lang
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// func (ci *CompletionIndex) Complete(prefix string, k int) []*Root {
+func TestComplete(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	for _, prefix := range []string{"k", "ki", "kit", "kitab", "zzzz"} {
+		roots := CompleteTurkishLatin(prefix, 10)
+		if len(roots) > 10 {
+			t.Log(fmt.Sprintf("%d completions for %s, expected at most 10", len(roots), prefix))
+			t.Fail()
+		}
+		for _, r := range roots {
+			if !strings.HasPrefix(r.TurkishLatin, prefix) {
+				t.Log(fmt.Sprintf("Completion %s doesn't begin with %s", r.TurkishLatin, prefix))
+				t.Fail()
+			}
+		}
+	}
+
+	// the kept top keys of a prefix with many keys are the same as ranking all of its keys
+	ci := turkishLatinCompletionIndex
+	lo, hi := ci.index.PrefixRange("k")
+	top, exists := ci.top["k"]
+	if !exists || hi-lo <= completionScanLimit {
+		t.Log(fmt.Sprintf("k has %d keys and should keep its top keys", hi-lo))
+		t.Fail()
+	}
+	ranked := ci.rank(lo, hi, CompletionK)
+	for i := range ranked {
+		if i >= len(top) || top[i] != ranked[i] {
+			t.Log(fmt.Sprintf("Top keys of k %v should be %v", top, ranked))
+			t.Fail()
+			break
+		}
+	}
+
+	if roots := CompleteAuto("کتا", 5); len(roots) == 0 || len(roots) > 5 {
+		t.Log(fmt.Sprintf("کتا should have between 1 and 5 completions: %d", len(roots)))
+		t.Fail()
+	}
+}
+
+// func completionStream(recv func() (*CompletionRequest, error), send func(*CompletionResponse) error) error {
+func TestCompletionStream(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	requests := make(chan *CompletionRequest)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	drained := make(chan struct{})
+	sequences := make([]int64, 0)
+
+	recv := func() (*CompletionRequest, error) {
+		in, ok := <-requests
+		if !ok {
+			close(drained)
+			return nil, io.EOF
+		}
+		return in, nil
+	}
+	send := func(out *CompletionResponse) error {
+		sequences = append(sequences, out.Sequence)
+		if len(sequences) == 1 {
+			close(started)
+			<-release
+		}
+		return nil
+	}
+
+	result := make(chan error)
+	go func() { result <- completionStream(recv, send) }()
+
+	requests <- &CompletionRequest{Prefix: "k", Sequence: 1}
+	<-started
+	// keystrokes typed while the first completion is sent are stale except the last
+	requests <- &CompletionRequest{Prefix: "ki", Sequence: 2}
+	requests <- &CompletionRequest{Prefix: "kit", Sequence: 3}
+	requests <- &CompletionRequest{Prefix: "kita", Sequence: 4}
+	close(requests)
+	<-drained
+	close(release)
+
+	if err := <-result; err != nil {
+		t.Log(fmt.Sprintf("Stream should end without error at EOF: %s", err))
+		t.Fail()
+	}
+	if len(sequences) != 2 || sequences[0] != 1 || sequences[1] != 4 {
+		t.Log(fmt.Sprintf("Stream should answer 1 and 4: %v", sequences))
+		t.Fail()
+	}
+}
+
+func BenchmarkCompleteTurkishLatin(b *testing.B) {
+	InitSearch(PROTOBUFFILE)
+	prefixes := []string{"k", "ki", "kit", "kita", "kitab"}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		CompleteTurkishLatin(prefixes[i%len(prefixes)], 10)
+	}
+}
@@ -0,0 +1,34 @@
+package lang
+
+import (
+	"unicode"
+)
+
+// isArabicScript checks whether r is in Arabic, Arabic Supplement, Arabic Extended-A or Arabic presentation forms blocks
+func isArabicScript(r rune) bool {
+	return (r >= 0x0600 && r <= 0x06FF) || (r >= 0x0750 && r <= 0x077F) || (r >= 0x08A0 && r <= 0x08FF) ||
+		isPresentationForm(r) || r == 0xFEFF
+}
+
+// Covers checks whether r is read as visenc in the script, either directly or after presentation forms and tatweel are normalized.
+// Characters outside Arabic script blocks, other than format characters like zero width joiners, are not read as visenc and are covered.
+func (p *ScriptProfile) Covers(r rune) bool {
+	if !isArabicScript(r) && !unicode.Is(unicode.Cf, r) {
+		return true
+	}
+	for _, n := range NormalizeArabic(string(r)) {
+		if _, exists := p.unicodeToVisenc[string(n)]; !exists {
+			return false
+		}
+	}
+	return true
+}
+
+// UnmappedCodePoints adds the number of characters in text that are not covered by the script to counts
+func (p *ScriptProfile) UnmappedCodePoints(text string, counts map[rune]int) {
+	for _, r := range text {
+		if !p.Covers(r) {
+			counts[r]++
+		}
+	}
+}
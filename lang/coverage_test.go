@@ -0,0 +1,36 @@
+package lang
+
+import (
+	"fmt"
+	"testing"
+)
+
+// func (p *ScriptProfile) Covers(r rune) bool {
+func TestCovers(t *testing.T) {
+	testDict := map[rune]bool{
+		'a':      true,
+		'ک':      true,
+		'\u0670': true,
+		'\u0640': true,
+		'ﻻ':      true,
+		'\u200C': true,
+		'؛':      false,
+		'\u00AD': false,
+		'\u0761': false,
+	}
+
+	p := GetScriptProfile(Script_OTTOMAN_TURKISH)
+	for i, o := range testDict {
+		if p.Covers(i) != o {
+			t.Log(fmt.Sprintf("%U should be covered: %t", i, o))
+			t.Fail()
+		}
+	}
+
+	counts := make(map[rune]int)
+	p.UnmappedCodePoints("کتاب؛ قلم؛", counts)
+	if len(counts) != 1 || counts['؛'] != 2 {
+		t.Log(fmt.Sprintf("Unmapped code points: %v", counts))
+		t.Fail()
+	}
+}
@@ -25,12 +25,17 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// FAMILY searches words derived from the Arabic root of the search string
 type SearchType int32
 
 const (
-	SearchType_PREFIX SearchType = 0
-	SearchType_FUZZY  SearchType = 1
-	SearchType_REGEX  SearchType = 2
+	SearchType_PREFIX  SearchType = 0
+	SearchType_FUZZY   SearchType = 1
+	SearchType_REGEX   SearchType = 2
+	SearchType_SUFFIX  SearchType = 3
+	SearchType_VARIANT SearchType = 4
+	SearchType_PATTERN SearchType = 5
+	SearchType_FAMILY  SearchType = 6
 )
 
 // Enum value maps for SearchType.
@@ -39,11 +44,19 @@ var (
 		0: "PREFIX",
 		1: "FUZZY",
 		2: "REGEX",
+		3: "SUFFIX",
+		4: "VARIANT",
+		5: "PATTERN",
+		6: "FAMILY",
 	}
 	SearchType_value = map[string]int32{
-		"PREFIX": 0,
-		"FUZZY":  1,
-		"REGEX":  2,
+		"PREFIX":  0,
+		"FUZZY":   1,
+		"REGEX":   2,
+		"SUFFIX":  3,
+		"VARIANT": 4,
+		"PATTERN": 5,
+		"FAMILY":  6,
 	}
 )
 
@@ -74,6 +87,7 @@ func (SearchType) EnumDescriptor() ([]byte, []int) {
 	return file_lang_dervaze_proto_rawDescGZIP(), []int{0}
 }
 
+// AUTO searches the field detected for the search string, ALL searches every field it may be written in
 type SearchField int32
 
 const (
@@ -82,6 +96,7 @@ const (
 	SearchField_VISENC        SearchField = 2
 	SearchField_OTTOMAN       SearchField = 3
 	SearchField_ABJAD         SearchField = 4
+	SearchField_ALL           SearchField = 5
 )
 
 // Enum value maps for SearchField.
@@ -92,6 +107,7 @@ var (
 		2: "VISENC",
 		3: "OTTOMAN",
 		4: "ABJAD",
+		5: "ALL",
 	}
 	SearchField_value = map[string]int32{
 		"AUTO":          0,
@@ -99,6 +115,7 @@ var (
 		"VISENC":        2,
 		"OTTOMAN":       3,
 		"ABJAD":         4,
+		"ALL":           5,
 	}
 )
 
@@ -129,6 +146,59 @@ func (SearchField) EnumDescriptor() ([]byte, []int) {
 	return file_lang_dervaze_proto_rawDescGZIP(), []int{1}
 }
 
+// Script selects the Unicode letters, searchable characters and abjad values used to write visenc
+type Script int32
+
+const (
+	Script_OTTOMAN_TURKISH Script = 0
+	Script_PERSIAN         Script = 1
+	Script_ARABIC          Script = 2
+	Script_URDU            Script = 3
+)
+
+// Enum value maps for Script.
+var (
+	Script_name = map[int32]string{
+		0: "OTTOMAN_TURKISH",
+		1: "PERSIAN",
+		2: "ARABIC",
+		3: "URDU",
+	}
+	Script_value = map[string]int32{
+		"OTTOMAN_TURKISH": 0,
+		"PERSIAN":         1,
+		"ARABIC":          2,
+		"URDU":            3,
+	}
+)
+
+func (x Script) Enum() *Script {
+	p := new(Script)
+	*p = x
+	return p
+}
+
+func (x Script) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Script) Descriptor() protoreflect.EnumDescriptor {
+	return file_lang_dervaze_proto_enumTypes[2].Descriptor()
+}
+
+func (Script) Type() protoreflect.EnumType {
+	return &file_lang_dervaze_proto_enumTypes[2]
+}
+
+func (x Script) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Script.Descriptor instead.
+func (Script) EnumDescriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{2}
+}
+
 type Req int32
 
 const (
@@ -162,11 +232,11 @@ func (x Req) String() string {
 }
 
 func (Req) Descriptor() protoreflect.EnumDescriptor {
-	return file_lang_dervaze_proto_enumTypes[2].Descriptor()
+	return file_lang_dervaze_proto_enumTypes[3].Descriptor()
 }
 
 func (Req) Type() protoreflect.EnumType {
-	return &file_lang_dervaze_proto_enumTypes[2]
+	return &file_lang_dervaze_proto_enumTypes[3]
 }
 
 func (x Req) Number() protoreflect.EnumNumber {
@@ -175,7 +245,7 @@ func (x Req) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Req.Descriptor instead.
 func (Req) EnumDescriptor() ([]byte, []int) {
-	return file_lang_dervaze_proto_rawDescGZIP(), []int{2}
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{3}
 }
 
 type PartOfSpeech int32
@@ -211,11 +281,11 @@ func (x PartOfSpeech) String() string {
 }
 
 func (PartOfSpeech) Descriptor() protoreflect.EnumDescriptor {
-	return file_lang_dervaze_proto_enumTypes[3].Descriptor()
+	return file_lang_dervaze_proto_enumTypes[4].Descriptor()
 }
 
 func (PartOfSpeech) Type() protoreflect.EnumType {
-	return &file_lang_dervaze_proto_enumTypes[3]
+	return &file_lang_dervaze_proto_enumTypes[4]
 }
 
 func (x PartOfSpeech) Number() protoreflect.EnumNumber {
@@ -224,7 +294,112 @@ func (x PartOfSpeech) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PartOfSpeech.Descriptor instead.
 func (PartOfSpeech) EnumDescriptor() ([]byte, []int) {
-	return file_lang_dervaze_proto_rawDescGZIP(), []int{3}
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{4}
+}
+
+type Alternation int32
+
+const (
+	Alternation_NO_ALTERNATION      Alternation = 0
+	Alternation_CONSONANT_SOFTENING Alternation = 1
+	Alternation_VOWEL_ELISION       Alternation = 2
+	Alternation_GEMINATION          Alternation = 3
+	Alternation_HARD_FINAL          Alternation = 4
+)
+
+// Enum value maps for Alternation.
+var (
+	Alternation_name = map[int32]string{
+		0: "NO_ALTERNATION",
+		1: "CONSONANT_SOFTENING",
+		2: "VOWEL_ELISION",
+		3: "GEMINATION",
+		4: "HARD_FINAL",
+	}
+	Alternation_value = map[string]int32{
+		"NO_ALTERNATION":      0,
+		"CONSONANT_SOFTENING": 1,
+		"VOWEL_ELISION":       2,
+		"GEMINATION":          3,
+		"HARD_FINAL":          4,
+	}
+)
+
+func (x Alternation) Enum() *Alternation {
+	p := new(Alternation)
+	*p = x
+	return p
+}
+
+func (x Alternation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Alternation) Descriptor() protoreflect.EnumDescriptor {
+	return file_lang_dervaze_proto_enumTypes[5].Descriptor()
+}
+
+func (Alternation) Type() protoreflect.EnumType {
+	return &file_lang_dervaze_proto_enumTypes[5]
+}
+
+func (x Alternation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Alternation.Descriptor instead.
+func (Alternation) EnumDescriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{5}
+}
+
+// PLURAL_OF links a plural to its singular, like kütüb to kitâb, and SINGULAR_OF the singular to its plurals
+type RelationType int32
+
+const (
+	RelationType_RELATED     RelationType = 0
+	RelationType_PLURAL_OF   RelationType = 1
+	RelationType_SINGULAR_OF RelationType = 2
+)
+
+// Enum value maps for RelationType.
+var (
+	RelationType_name = map[int32]string{
+		0: "RELATED",
+		1: "PLURAL_OF",
+		2: "SINGULAR_OF",
+	}
+	RelationType_value = map[string]int32{
+		"RELATED":     0,
+		"PLURAL_OF":   1,
+		"SINGULAR_OF": 2,
+	}
+)
+
+func (x RelationType) Enum() *RelationType {
+	p := new(RelationType)
+	*p = x
+	return p
+}
+
+func (x RelationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_lang_dervaze_proto_enumTypes[6].Descriptor()
+}
+
+func (RelationType) Type() protoreflect.EnumType {
+	return &file_lang_dervaze_proto_enumTypes[6]
+}
+
+func (x RelationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationType.Descriptor instead.
+func (RelationType) EnumDescriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{6}
 }
 
 type TranslationDirection int32
@@ -257,11 +432,11 @@ func (x TranslationDirection) String() string {
 }
 
 func (TranslationDirection) Descriptor() protoreflect.EnumDescriptor {
-	return file_lang_dervaze_proto_enumTypes[4].Descriptor()
+	return file_lang_dervaze_proto_enumTypes[7].Descriptor()
 }
 
 func (TranslationDirection) Type() protoreflect.EnumType {
-	return &file_lang_dervaze_proto_enumTypes[4]
+	return &file_lang_dervaze_proto_enumTypes[7]
 }
 
 func (x TranslationDirection) Number() protoreflect.EnumNumber {
@@ -270,7 +445,58 @@ func (x TranslationDirection) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TranslationDirection.Descriptor instead.
 func (TranslationDirection) EnumDescriptor() ([]byte, []int) {
-	return file_lang_dervaze_proto_rawDescGZIP(), []int{4}
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{7}
+}
+
+// DICTIONARY candidates are roots read like the input, COMPLETION candidates are roots beginning with it
+// and GUESS candidates are spelled by phonetic rules
+type CandidateSource int32
+
+const (
+	CandidateSource_DICTIONARY CandidateSource = 0
+	CandidateSource_COMPLETION CandidateSource = 1
+	CandidateSource_GUESS      CandidateSource = 2
+)
+
+// Enum value maps for CandidateSource.
+var (
+	CandidateSource_name = map[int32]string{
+		0: "DICTIONARY",
+		1: "COMPLETION",
+		2: "GUESS",
+	}
+	CandidateSource_value = map[string]int32{
+		"DICTIONARY": 0,
+		"COMPLETION": 1,
+		"GUESS":      2,
+	}
+)
+
+func (x CandidateSource) Enum() *CandidateSource {
+	p := new(CandidateSource)
+	*p = x
+	return p
+}
+
+func (x CandidateSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CandidateSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_lang_dervaze_proto_enumTypes[8].Descriptor()
+}
+
+func (CandidateSource) Type() protoreflect.EnumType {
+	return &file_lang_dervaze_proto_enumTypes[8]
+}
+
+func (x CandidateSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CandidateSource.Descriptor instead.
+func (CandidateSource) EnumDescriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{8}
 }
 
 type SearchRequest struct {
@@ -282,6 +508,14 @@ type SearchRequest struct {
 	SearchString string      `protobuf:"bytes,11,opt,name=searchString,proto3" json:"searchString,omitempty"`
 	SearchType   SearchType  `protobuf:"varint,14,opt,name=searchType,proto3,enum=dervaze.SearchType" json:"searchType,omitempty"`
 	ResultLimit  int32       `protobuf:"varint,15,opt,name=resultLimit,proto3" json:"resultLimit,omitempty"`
+	// strip suffixes from searchString and return the roots with the detected suffix chain
+	Stem bool `protobuf:"varint,16,opt,name=stem,proto3" json:"stem,omitempty"`
+	// script of searchString and of Unicode spellings in the results
+	Script Script `protobuf:"varint,17,opt,name=script,proto3,enum=dervaze.Script" json:"script,omitempty"`
+	// structured query like tr:kitab* pos:noun abjad:400..500, used instead of searchField and searchString
+	Query string `protobuf:"bytes,18,opt,name=query,proto3" json:"query,omitempty"`
+	// add match spans and explanations to the results
+	Explain bool `protobuf:"varint,19,opt,name=explain,proto3" json:"explain,omitempty"`
 }
 
 func (x *SearchRequest) Reset() {
@@ -344,6 +578,34 @@ func (x *SearchRequest) GetResultLimit() int32 {
 	return 0
 }
 
+func (x *SearchRequest) GetStem() bool {
+	if x != nil {
+		return x.Stem
+	}
+	return false
+}
+
+func (x *SearchRequest) GetScript() Script {
+	if x != nil {
+		return x.Script
+	}
+	return Script_OTTOMAN_TURKISH
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
 type OttomanWord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -355,6 +617,8 @@ type OttomanWord struct {
 	VisencLetters    []string `protobuf:"bytes,4,rep,name=visencLetters,proto3" json:"visencLetters,omitempty"`
 	SearchKey        string   `protobuf:"bytes,5,opt,name=searchKey,proto3" json:"searchKey,omitempty"`
 	DotlessSearchKey string   `protobuf:"bytes,6,opt,name=dotlessSearchKey,proto3" json:"dotlessSearchKey,omitempty"`
+	// script of unicode and abjad
+	Script Script `protobuf:"varint,7,opt,name=script,proto3,enum=dervaze.Script" json:"script,omitempty"`
 }
 
 func (x *OttomanWord) Reset() {
@@ -431,6 +695,13 @@ func (x *OttomanWord) GetDotlessSearchKey() string {
 	return ""
 }
 
+func (x *OttomanWord) GetScript() Script {
+	if x != nil {
+		return x.Script
+	}
+	return Script_OTTOMAN_TURKISH
+}
+
 type Root struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -450,6 +721,15 @@ type Root struct {
 	LastVowelHard         bool         `protobuf:"varint,18,opt,name=lastVowelHard,proto3" json:"lastVowelHard,omitempty"`
 	LastConsonantHard     bool         `protobuf:"varint,19,opt,name=lastConsonantHard,proto3" json:"lastConsonantHard,omitempty"`
 	HasConsonantSoftening bool         `protobuf:"varint,20,opt,name=hasConsonantSoftening,proto3" json:"hasConsonantSoftening,omitempty"`
+	PhonologyRules        []string     `protobuf:"bytes,21,rep,name=phonologyRules,proto3" json:"phonologyRules,omitempty"`
+	// effectiveTurkishLatin and effectiveVisenc hold the stem before vowel-initial suffixes
+	Alternation Alternation `protobuf:"varint,22,opt,name=alternation,proto3,enum=dervaze.Alternation" json:"alternation,omitempty"`
+	// dictionaries listing the root, e.g. redhouse
+	Sources []string `protobuf:"bytes,23,rep,name=sources,proto3" json:"sources,omitempty"`
+	// letters of the Arabic root the word is derived from, e.g. کتب for مکتوب, empty for words not following an Arabic pattern
+	ArabicRoot string `protobuf:"bytes,24,opt,name=arabicRoot,proto3" json:"arabicRoot,omitempty"`
+	// links to other roots, like the singular of a plural
+	Relations []*Relation `protobuf:"bytes,25,rep,name=relations,proto3" json:"relations,omitempty"`
 }
 
 func (x *Root) Reset() {
@@ -582,16 +862,56 @@ func (x *Root) GetHasConsonantSoftening() bool {
 	return false
 }
 
-type RootSet struct {
+func (x *Root) GetPhonologyRules() []string {
+	if x != nil {
+		return x.PhonologyRules
+	}
+	return nil
+}
+
+func (x *Root) GetAlternation() Alternation {
+	if x != nil {
+		return x.Alternation
+	}
+	return Alternation_NO_ALTERNATION
+}
+
+func (x *Root) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *Root) GetArabicRoot() string {
+	if x != nil {
+		return x.ArabicRoot
+	}
+	return ""
+}
+
+func (x *Root) GetRelations() []*Relation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+// Relation links a root to another root, written with its Latin spelling and visenc as roots have no ids
+type Relation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Roots []*Root `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	Type         RelationType `protobuf:"varint,1,opt,name=type,proto3,enum=dervaze.RelationType" json:"type,omitempty"`
+	TurkishLatin string       `protobuf:"bytes,2,opt,name=turkishLatin,proto3" json:"turkishLatin,omitempty"`
+	Visenc       string       `protobuf:"bytes,3,opt,name=visenc,proto3" json:"visenc,omitempty"`
+	// how the link is found, like the plural pattern fu'ul or the Persian ending -ân
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func (x *RootSet) Reset() {
-	*x = RootSet{}
+func (x *Relation) Reset() {
+	*x = Relation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lang_dervaze_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -599,13 +919,13 @@ func (x *RootSet) Reset() {
 	}
 }
 
-func (x *RootSet) String() string {
+func (x *Relation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RootSet) ProtoMessage() {}
+func (*Relation) ProtoMessage() {}
 
-func (x *RootSet) ProtoReflect() protoreflect.Message {
+func (x *Relation) ProtoReflect() protoreflect.Message {
 	mi := &file_lang_dervaze_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -617,40 +937,59 @@ func (x *RootSet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RootSet.ProtoReflect.Descriptor instead.
-func (*RootSet) Descriptor() ([]byte, []int) {
+// Deprecated: Use Relation.ProtoReflect.Descriptor instead.
+func (*Relation) Descriptor() ([]byte, []int) {
 	return file_lang_dervaze_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *RootSet) GetRoots() []*Root {
+func (x *Relation) GetType() RelationType {
 	if x != nil {
-		return x.Roots
+		return x.Type
 	}
-	return nil
+	return RelationType_RELATED
 }
 
-type Suffix struct {
+func (x *Relation) GetTurkishLatin() string {
+	if x != nil {
+		return x.TurkishLatin
+	}
+	return ""
+}
+
+func (x *Relation) GetVisenc() string {
+	if x != nil {
+		return x.Visenc
+	}
+	return ""
+}
+
+func (x *Relation) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type SearchResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TurkishLatin               string       `protobuf:"bytes,1,opt,name=turkishLatin,proto3" json:"turkishLatin,omitempty"`
-	Ottoman                    *OttomanWord `protobuf:"bytes,2,opt,name=ottoman,proto3" json:"ottoman,omitempty"`
-	MorphologicalClass         string       `protobuf:"bytes,3,opt,name=morphologicalClass,proto3" json:"morphologicalClass,omitempty"`
-	RequiredLastVowel          string       `protobuf:"bytes,4,opt,name=requiredLastVowel,proto3" json:"requiredLastVowel,omitempty"`
-	RequiresPOS                PartOfSpeech `protobuf:"varint,5,opt,name=requiresPOS,proto3,enum=dervaze.PartOfSpeech" json:"requiresPOS,omitempty"`
-	RequiresEndsWithVowel      Req          `protobuf:"varint,6,opt,name=requiresEndsWithVowel,proto3,enum=dervaze.Req" json:"requiresEndsWithVowel,omitempty"`
-	RequiresHasSingleVowel     Req          `protobuf:"varint,7,opt,name=requiresHasSingleVowel,proto3,enum=dervaze.Req" json:"requiresHasSingleVowel,omitempty"`
-	RequiresLastConsonantHard  Req          `protobuf:"varint,8,opt,name=requiresLastConsonantHard,proto3,enum=dervaze.Req" json:"requiresLastConsonantHard,omitempty"`
-	SetsLastVowelTo            string       `protobuf:"bytes,9,opt,name=setsLastVowelTo,proto3" json:"setsLastVowelTo,omitempty"`
-	RequiresContinuationSuffix Req          `protobuf:"varint,10,opt,name=requiresContinuationSuffix,proto3,enum=dervaze.Req" json:"requiresContinuationSuffix,omitempty"`
-	InvalidateSuffixClasses    []string     `protobuf:"bytes,11,rep,name=invalidateSuffixClasses,proto3" json:"invalidateSuffixClasses,omitempty"`
-	ConvertsPOSto              PartOfSpeech `protobuf:"varint,12,opt,name=convertsPOSto,proto3,enum=dervaze.PartOfSpeech" json:"convertsPOSto,omitempty"`
-	EndsWithVowel              bool         `protobuf:"varint,13,opt,name=endsWithVowel,proto3" json:"endsWithVowel,omitempty"`
-}
-
-func (x *Suffix) Reset() {
-	*x = Suffix{}
+	Root *Root `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// suffixes attached to the root in order, as written in the search string
+	Suffixes []*Suffix `protobuf:"bytes,2,rep,name=suffixes,proto3" json:"suffixes,omitempty"`
+	// orthographic variants that differ between the search string and the root
+	Variants []string `protobuf:"bytes,3,rep,name=variants,proto3" json:"variants,omitempty"`
+	// field the root is found in, set by ALL searches
+	Field SearchField `protobuf:"varint,4,opt,name=field,proto3,enum=dervaze.SearchField" json:"field,omitempty"`
+	// parts of the root matching the search string and how it's found, set when explain is requested
+	Match *Match `protobuf:"bytes,5,opt,name=match,proto3" json:"match,omitempty"`
+	// the root is found through a related root in the search string, like a singular through its PLURAL_OF plural
+	Relation *Relation `protobuf:"bytes,6,opt,name=relation,proto3" json:"relation,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lang_dervaze_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -658,13 +997,13 @@ func (x *Suffix) Reset() {
 	}
 }
 
-func (x *Suffix) String() string {
+func (x *SearchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Suffix) ProtoMessage() {}
+func (*SearchResult) ProtoMessage() {}
 
-func (x *Suffix) ProtoReflect() protoreflect.Message {
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
 	mi := &file_lang_dervaze_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -676,113 +1015,65 @@ func (x *Suffix) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Suffix.ProtoReflect.Descriptor instead.
-func (*Suffix) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
 	return file_lang_dervaze_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Suffix) GetTurkishLatin() string {
+func (x *SearchResult) GetRoot() *Root {
 	if x != nil {
-		return x.TurkishLatin
+		return x.Root
 	}
-	return ""
+	return nil
 }
 
-func (x *Suffix) GetOttoman() *OttomanWord {
+func (x *SearchResult) GetSuffixes() []*Suffix {
 	if x != nil {
-		return x.Ottoman
+		return x.Suffixes
 	}
 	return nil
 }
 
-func (x *Suffix) GetMorphologicalClass() string {
+func (x *SearchResult) GetVariants() []string {
 	if x != nil {
-		return x.MorphologicalClass
+		return x.Variants
 	}
-	return ""
+	return nil
 }
 
-func (x *Suffix) GetRequiredLastVowel() string {
+func (x *SearchResult) GetField() SearchField {
 	if x != nil {
-		return x.RequiredLastVowel
+		return x.Field
 	}
-	return ""
+	return SearchField_AUTO
 }
 
-func (x *Suffix) GetRequiresPOS() PartOfSpeech {
+func (x *SearchResult) GetMatch() *Match {
 	if x != nil {
-		return x.RequiresPOS
+		return x.Match
 	}
-	return PartOfSpeech_NOUN
+	return nil
 }
 
-func (x *Suffix) GetRequiresEndsWithVowel() Req {
+func (x *SearchResult) GetRelation() *Relation {
 	if x != nil {
-		return x.RequiresEndsWithVowel
+		return x.Relation
 	}
-	return Req_NEVER
+	return nil
 }
 
-func (x *Suffix) GetRequiresHasSingleVowel() Req {
-	if x != nil {
-		return x.RequiresHasSingleVowel
-	}
-	return Req_NEVER
+// MatchSpan is a matched part of a string from start to end in runes, end is excluded
+type MatchSpan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *Suffix) GetRequiresLastConsonantHard() Req {
-	if x != nil {
-		return x.RequiresLastConsonantHard
-	}
-	return Req_NEVER
-}
-
-func (x *Suffix) GetSetsLastVowelTo() string {
-	if x != nil {
-		return x.SetsLastVowelTo
-	}
-	return ""
-}
-
-func (x *Suffix) GetRequiresContinuationSuffix() Req {
-	if x != nil {
-		return x.RequiresContinuationSuffix
-	}
-	return Req_NEVER
-}
-
-func (x *Suffix) GetInvalidateSuffixClasses() []string {
-	if x != nil {
-		return x.InvalidateSuffixClasses
-	}
-	return nil
-}
-
-func (x *Suffix) GetConvertsPOSto() PartOfSpeech {
-	if x != nil {
-		return x.ConvertsPOSto
-	}
-	return PartOfSpeech_NOUN
-}
-
-func (x *Suffix) GetEndsWithVowel() bool {
-	if x != nil {
-		return x.EndsWithVowel
-	}
-	return false
-}
-
-type SuffixSet struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Suffixes []*Suffix `protobuf:"bytes,1,rep,name=suffixes,proto3" json:"suffixes,omitempty"`
-	Tag      string    `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-}
-
-func (x *SuffixSet) Reset() {
-	*x = SuffixSet{}
+func (x *MatchSpan) Reset() {
+	*x = MatchSpan{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lang_dervaze_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -790,13 +1081,13 @@ func (x *SuffixSet) Reset() {
 	}
 }
 
-func (x *SuffixSet) String() string {
+func (x *MatchSpan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuffixSet) ProtoMessage() {}
+func (*MatchSpan) ProtoMessage() {}
 
-func (x *SuffixSet) ProtoReflect() protoreflect.Message {
+func (x *MatchSpan) ProtoReflect() protoreflect.Message {
 	mi := &file_lang_dervaze_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -808,39 +1099,36 @@ func (x *SuffixSet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuffixSet.ProtoReflect.Descriptor instead.
-func (*SuffixSet) Descriptor() ([]byte, []int) {
+// Deprecated: Use MatchSpan.ProtoReflect.Descriptor instead.
+func (*MatchSpan) Descriptor() ([]byte, []int) {
 	return file_lang_dervaze_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SuffixSet) GetSuffixes() []*Suffix {
+func (x *MatchSpan) GetStart() int32 {
 	if x != nil {
-		return x.Suffixes
+		return x.Start
 	}
-	return nil
+	return 0
 }
 
-func (x *SuffixSet) GetTag() string {
+func (x *MatchSpan) GetEnd() int32 {
 	if x != nil {
-		return x.Tag
+		return x.End
 	}
-	return ""
+	return 0
 }
 
-type TranslateRequest struct {
+type ScoreComponent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to R:
-	//	*TranslateRequest_TurkishLatin
-	//	*TranslateRequest_Visenc
-	//	*TranslateRequest_Ottoman
-	R isTranslateRequest_R `protobuf_oneof:"r"`
+	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *TranslateRequest) Reset() {
-	*x = TranslateRequest{}
+func (x *ScoreComponent) Reset() {
+	*x = ScoreComponent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lang_dervaze_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -848,13 +1136,13 @@ func (x *TranslateRequest) Reset() {
 	}
 }
 
-func (x *TranslateRequest) String() string {
+func (x *ScoreComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranslateRequest) ProtoMessage() {}
+func (*ScoreComponent) ProtoMessage() {}
 
-func (x *TranslateRequest) ProtoReflect() protoreflect.Message {
+func (x *ScoreComponent) ProtoReflect() protoreflect.Message {
 	mi := &file_lang_dervaze_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -866,75 +1154,46 @@ func (x *TranslateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranslateRequest.ProtoReflect.Descriptor instead.
-func (*TranslateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScoreComponent.ProtoReflect.Descriptor instead.
+func (*ScoreComponent) Descriptor() ([]byte, []int) {
 	return file_lang_dervaze_proto_rawDescGZIP(), []int{6}
 }
 
-func (m *TranslateRequest) GetR() isTranslateRequest_R {
-	if m != nil {
-		return m.R
-	}
-	return nil
-}
-
-func (x *TranslateRequest) GetTurkishLatin() string {
-	if x, ok := x.GetR().(*TranslateRequest_TurkishLatin); ok {
-		return x.TurkishLatin
-	}
-	return ""
-}
-
-func (x *TranslateRequest) GetVisenc() string {
-	if x, ok := x.GetR().(*TranslateRequest_Visenc); ok {
-		return x.Visenc
+func (x *ScoreComponent) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TranslateRequest) GetOttoman() string {
-	if x, ok := x.GetR().(*TranslateRequest_Ottoman); ok {
-		return x.Ottoman
+func (x *ScoreComponent) GetValue() float64 {
+	if x != nil {
+		return x.Value
 	}
-	return ""
-}
-
-type isTranslateRequest_R interface {
-	isTranslateRequest_R()
-}
-
-type TranslateRequest_TurkishLatin struct {
-	TurkishLatin string `protobuf:"bytes,2,opt,name=turkishLatin,proto3,oneof"`
-}
-
-type TranslateRequest_Visenc struct {
-	Visenc string `protobuf:"bytes,3,opt,name=visenc,proto3,oneof"`
-}
-
-type TranslateRequest_Ottoman struct {
-	Ottoman string `protobuf:"bytes,4,opt,name=ottoman,proto3,oneof"`
+	return 0
 }
 
-func (*TranslateRequest_TurkishLatin) isTranslateRequest_R() {}
-
-func (*TranslateRequest_Visenc) isTranslateRequest_R() {}
-
-func (*TranslateRequest_Ottoman) isTranslateRequest_R() {}
-
-type TranslationWord struct {
+// Match tells which parts of a root match the search string and how the root is found
+type Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Root             *Root                `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
-	Suffixes         []*Suffix            `protobuf:"bytes,2,rep,name=suffixes,proto3" json:"suffixes,omitempty"`
-	Remaining        string               `protobuf:"bytes,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
-	Direction        TranslationDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=dervaze.TranslationDirection" json:"direction,omitempty"`
-	OttomanRemaining *OttomanWord         `protobuf:"bytes,5,opt,name=ottomanRemaining,proto3" json:"ottomanRemaining,omitempty"`
-}
-
-func (x *TranslationWord) Reset() {
-	*x = TranslationWord{}
+	TurkishLatinSpans []*MatchSpan `protobuf:"bytes,1,rep,name=turkishLatinSpans,proto3" json:"turkishLatinSpans,omitempty"`
+	UnicodeSpans      []*MatchSpan `protobuf:"bytes,2,rep,name=unicodeSpans,proto3" json:"unicodeSpans,omitempty"`
+	VisencSpans       []*MatchSpan `protobuf:"bytes,3,rep,name=visencSpans,proto3" json:"visencSpans,omitempty"`
+	Field             SearchField  `protobuf:"varint,4,opt,name=field,proto3,enum=dervaze.SearchField" json:"field,omitempty"`
+	Type              SearchType   `protobuf:"varint,5,opt,name=type,proto3,enum=dervaze.SearchType" json:"type,omitempty"`
+	// index the root is found in, e.g. turkishLatinIndex or visencReverseIndex
+	Index string `protobuf:"bytes,6,opt,name=index,proto3" json:"index,omitempty"`
+	// normalizations applied to the search string or the root, e.g. joiners, diacritics, stem or a variant rule
+	Normalizations []string `protobuf:"bytes,7,rep,name=normalizations,proto3" json:"normalizations,omitempty"`
+	// score components like coverage, the share of the root matched
+	Scores []*ScoreComponent `protobuf:"bytes,8,rep,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lang_dervaze_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,13 +1201,13 @@ func (x *TranslationWord) Reset() {
 	}
 }
 
-func (x *TranslationWord) String() string {
+func (x *Match) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranslationWord) ProtoMessage() {}
+func (*Match) ProtoMessage() {}
 
-func (x *TranslationWord) ProtoReflect() protoreflect.Message {
+func (x *Match) ProtoReflect() protoreflect.Message {
 	mi := &file_lang_dervaze_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -960,57 +1219,82 @@ func (x *TranslationWord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranslationWord.ProtoReflect.Descriptor instead.
-func (*TranslationWord) Descriptor() ([]byte, []int) {
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
 	return file_lang_dervaze_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TranslationWord) GetRoot() *Root {
+func (x *Match) GetTurkishLatinSpans() []*MatchSpan {
 	if x != nil {
-		return x.Root
+		return x.TurkishLatinSpans
 	}
 	return nil
 }
 
-func (x *TranslationWord) GetSuffixes() []*Suffix {
+func (x *Match) GetUnicodeSpans() []*MatchSpan {
 	if x != nil {
-		return x.Suffixes
+		return x.UnicodeSpans
 	}
 	return nil
 }
 
-func (x *TranslationWord) GetRemaining() string {
+func (x *Match) GetVisencSpans() []*MatchSpan {
 	if x != nil {
-		return x.Remaining
+		return x.VisencSpans
+	}
+	return nil
+}
+
+func (x *Match) GetField() SearchField {
+	if x != nil {
+		return x.Field
+	}
+	return SearchField_AUTO
+}
+
+func (x *Match) GetType() SearchType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchType_PREFIX
+}
+
+func (x *Match) GetIndex() string {
+	if x != nil {
+		return x.Index
 	}
 	return ""
 }
 
-func (x *TranslationWord) GetDirection() TranslationDirection {
+func (x *Match) GetNormalizations() []string {
 	if x != nil {
-		return x.Direction
+		return x.Normalizations
 	}
-	return TranslationDirection_tr2otm
+	return nil
 }
 
-func (x *TranslationWord) GetOttomanRemaining() *OttomanWord {
+func (x *Match) GetScores() []*ScoreComponent {
 	if x != nil {
-		return x.OttomanRemaining
+		return x.Scores
 	}
 	return nil
 }
 
-type TranslationVariety struct {
+type RootSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Varieties []*TranslationWord   `protobuf:"bytes,1,rep,name=varieties,proto3" json:"varieties,omitempty"`
-	Direction TranslationDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=dervaze.TranslationDirection" json:"direction,omitempty"`
+	Roots   []*Root         `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	Results []*SearchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	// version and checksum of the visenc table the roots are built with
+	VisencVersion string `protobuf:"bytes,3,opt,name=visencVersion,proto3" json:"visencVersion,omitempty"`
+	// checksum of the Arabic pattern table the Arabic roots of the roots are found with, empty if they aren't stored
+	ArabicPatterns string `protobuf:"bytes,4,opt,name=arabicPatterns,proto3" json:"arabicPatterns,omitempty"`
 }
 
-func (x *TranslationVariety) Reset() {
-	*x = TranslationVariety{}
+func (x *RootSet) Reset() {
+	*x = RootSet{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lang_dervaze_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1018,13 +1302,13 @@ func (x *TranslationVariety) Reset() {
 	}
 }
 
-func (x *TranslationVariety) String() string {
+func (x *RootSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranslationVariety) ProtoMessage() {}
+func (*RootSet) ProtoMessage() {}
 
-func (x *TranslationVariety) ProtoReflect() protoreflect.Message {
+func (x *RootSet) ProtoReflect() protoreflect.Message {
 	mi := &file_lang_dervaze_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1036,36 +1320,61 @@ func (x *TranslationVariety) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranslationVariety.ProtoReflect.Descriptor instead.
-func (*TranslationVariety) Descriptor() ([]byte, []int) {
+// Deprecated: Use RootSet.ProtoReflect.Descriptor instead.
+func (*RootSet) Descriptor() ([]byte, []int) {
 	return file_lang_dervaze_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TranslationVariety) GetVarieties() []*TranslationWord {
+func (x *RootSet) GetRoots() []*Root {
 	if x != nil {
-		return x.Varieties
+		return x.Roots
 	}
 	return nil
 }
 
-func (x *TranslationVariety) GetDirection() TranslationDirection {
+func (x *RootSet) GetResults() []*SearchResult {
 	if x != nil {
-		return x.Direction
+		return x.Results
 	}
-	return TranslationDirection_tr2otm
+	return nil
 }
 
-type TranslationSentence struct {
+func (x *RootSet) GetVisencVersion() string {
+	if x != nil {
+		return x.VisencVersion
+	}
+	return ""
+}
+
+func (x *RootSet) GetArabicPatterns() string {
+	if x != nil {
+		return x.ArabicPatterns
+	}
+	return ""
+}
+
+type Suffix struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Words     []*TranslationVariety `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
-	Direction TranslationDirection  `protobuf:"varint,2,opt,name=direction,proto3,enum=dervaze.TranslationDirection" json:"direction,omitempty"`
+	TurkishLatin               string       `protobuf:"bytes,1,opt,name=turkishLatin,proto3" json:"turkishLatin,omitempty"`
+	Ottoman                    *OttomanWord `protobuf:"bytes,2,opt,name=ottoman,proto3" json:"ottoman,omitempty"`
+	MorphologicalClass         string       `protobuf:"bytes,3,opt,name=morphologicalClass,proto3" json:"morphologicalClass,omitempty"`
+	RequiredLastVowel          string       `protobuf:"bytes,4,opt,name=requiredLastVowel,proto3" json:"requiredLastVowel,omitempty"`
+	RequiresPOS                PartOfSpeech `protobuf:"varint,5,opt,name=requiresPOS,proto3,enum=dervaze.PartOfSpeech" json:"requiresPOS,omitempty"`
+	RequiresEndsWithVowel      Req          `protobuf:"varint,6,opt,name=requiresEndsWithVowel,proto3,enum=dervaze.Req" json:"requiresEndsWithVowel,omitempty"`
+	RequiresHasSingleVowel     Req          `protobuf:"varint,7,opt,name=requiresHasSingleVowel,proto3,enum=dervaze.Req" json:"requiresHasSingleVowel,omitempty"`
+	RequiresLastConsonantHard  Req          `protobuf:"varint,8,opt,name=requiresLastConsonantHard,proto3,enum=dervaze.Req" json:"requiresLastConsonantHard,omitempty"`
+	SetsLastVowelTo            string       `protobuf:"bytes,9,opt,name=setsLastVowelTo,proto3" json:"setsLastVowelTo,omitempty"`
+	RequiresContinuationSuffix Req          `protobuf:"varint,10,opt,name=requiresContinuationSuffix,proto3,enum=dervaze.Req" json:"requiresContinuationSuffix,omitempty"`
+	InvalidateSuffixClasses    []string     `protobuf:"bytes,11,rep,name=invalidateSuffixClasses,proto3" json:"invalidateSuffixClasses,omitempty"`
+	ConvertsPOSto              PartOfSpeech `protobuf:"varint,12,opt,name=convertsPOSto,proto3,enum=dervaze.PartOfSpeech" json:"convertsPOSto,omitempty"`
+	EndsWithVowel              bool         `protobuf:"varint,13,opt,name=endsWithVowel,proto3" json:"endsWithVowel,omitempty"`
 }
 
-func (x *TranslationSentence) Reset() {
-	*x = TranslationSentence{}
+func (x *Suffix) Reset() {
+	*x = Suffix{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lang_dervaze_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1073,13 +1382,13 @@ func (x *TranslationSentence) Reset() {
 	}
 }
 
-func (x *TranslationSentence) String() string {
+func (x *Suffix) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranslationSentence) ProtoMessage() {}
+func (*Suffix) ProtoMessage() {}
 
-func (x *TranslationSentence) ProtoReflect() protoreflect.Message {
+func (x *Suffix) ProtoReflect() protoreflect.Message {
 	mi := &file_lang_dervaze_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1091,36 +1400,113 @@ func (x *TranslationSentence) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranslationSentence.ProtoReflect.Descriptor instead.
-func (*TranslationSentence) Descriptor() ([]byte, []int) {
+// Deprecated: Use Suffix.ProtoReflect.Descriptor instead.
+func (*Suffix) Descriptor() ([]byte, []int) {
 	return file_lang_dervaze_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TranslationSentence) GetWords() []*TranslationVariety {
+func (x *Suffix) GetTurkishLatin() string {
 	if x != nil {
-		return x.Words
+		return x.TurkishLatin
 	}
-	return nil
+	return ""
 }
 
-func (x *TranslationSentence) GetDirection() TranslationDirection {
+func (x *Suffix) GetOttoman() *OttomanWord {
 	if x != nil {
-		return x.Direction
+		return x.Ottoman
 	}
-	return TranslationDirection_tr2otm
+	return nil
 }
 
-type TranslateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Request   *TranslateRequest      `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	Sentences []*TranslationSentence `protobuf:"bytes,2,rep,name=sentences,proto3" json:"sentences,omitempty"`
+func (x *Suffix) GetMorphologicalClass() string {
+	if x != nil {
+		return x.MorphologicalClass
+	}
+	return ""
 }
 
-func (x *TranslateResponse) Reset() {
-	*x = TranslateResponse{}
+func (x *Suffix) GetRequiredLastVowel() string {
+	if x != nil {
+		return x.RequiredLastVowel
+	}
+	return ""
+}
+
+func (x *Suffix) GetRequiresPOS() PartOfSpeech {
+	if x != nil {
+		return x.RequiresPOS
+	}
+	return PartOfSpeech_NOUN
+}
+
+func (x *Suffix) GetRequiresEndsWithVowel() Req {
+	if x != nil {
+		return x.RequiresEndsWithVowel
+	}
+	return Req_NEVER
+}
+
+func (x *Suffix) GetRequiresHasSingleVowel() Req {
+	if x != nil {
+		return x.RequiresHasSingleVowel
+	}
+	return Req_NEVER
+}
+
+func (x *Suffix) GetRequiresLastConsonantHard() Req {
+	if x != nil {
+		return x.RequiresLastConsonantHard
+	}
+	return Req_NEVER
+}
+
+func (x *Suffix) GetSetsLastVowelTo() string {
+	if x != nil {
+		return x.SetsLastVowelTo
+	}
+	return ""
+}
+
+func (x *Suffix) GetRequiresContinuationSuffix() Req {
+	if x != nil {
+		return x.RequiresContinuationSuffix
+	}
+	return Req_NEVER
+}
+
+func (x *Suffix) GetInvalidateSuffixClasses() []string {
+	if x != nil {
+		return x.InvalidateSuffixClasses
+	}
+	return nil
+}
+
+func (x *Suffix) GetConvertsPOSto() PartOfSpeech {
+	if x != nil {
+		return x.ConvertsPOSto
+	}
+	return PartOfSpeech_NOUN
+}
+
+func (x *Suffix) GetEndsWithVowel() bool {
+	if x != nil {
+		return x.EndsWithVowel
+	}
+	return false
+}
+
+type SuffixSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suffixes []*Suffix `protobuf:"bytes,1,rep,name=suffixes,proto3" json:"suffixes,omitempty"`
+	Tag      string    `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *SuffixSet) Reset() {
+	*x = SuffixSet{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lang_dervaze_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1128,332 +1514,3353 @@ func (x *TranslateResponse) Reset() {
 	}
 }
 
-func (x *TranslateResponse) String() string {
+func (x *SuffixSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranslateResponse) ProtoMessage() {}
+func (*SuffixSet) ProtoMessage() {}
 
-func (x *TranslateResponse) ProtoReflect() protoreflect.Message {
+func (x *SuffixSet) ProtoReflect() protoreflect.Message {
 	mi := &file_lang_dervaze_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TranslateResponse.ProtoReflect.Descriptor instead.
-func (*TranslateResponse) Descriptor() ([]byte, []int) {
-	return file_lang_dervaze_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *TranslateResponse) GetRequest() *TranslateRequest {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-func (x *TranslateResponse) GetSentences() []*TranslationSentence {
-	if x != nil {
-		return x.Sentences
-	}
-	return nil
-}
-
-var File_lang_dervaze_proto protoreflect.FileDescriptor
-
-var file_lang_dervaze_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x22, 0xc2, 0x01,
-	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x36, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x0a, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x22, 0xc5, 0x01, 0x0a, 0x0b, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x57, 0x6f,
-	0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e,
-	0x69, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x6e, 0x69,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x62, 0x6a, 0x61, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x62, 0x6a, 0x61, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x69,
-	0x73, 0x65, 0x6e, 0x63, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0d, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x2a,
-	0x0a, 0x10, 0x64, 0x6f, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b,
-	0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x6f, 0x74, 0x6c, 0x65, 0x73,
-	0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0xd7, 0x04, 0x0a, 0x04, 0x52,
-	0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61,
-	0x74, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69,
-	0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d,
-	0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61,
-	0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x07,
-	0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x56,
-	0x6f, 0x77, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
-	0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e,
-	0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61,
-	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x65,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65,
-	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x34, 0x0a, 0x15, 0x65,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c,
-	0x61, 0x74, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x65, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69,
-	0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x69,
-	0x73, 0x65, 0x6e, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x62, 0x6a, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x62, 0x6a, 0x61,
-	0x64, 0x12, 0x39, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63,
-	0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a,
-	0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x52, 0x0c,
-	0x70, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12, 0x24, 0x0a, 0x0d,
-	0x65, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f, 0x77,
-	0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56,
-	0x6f, 0x77, 0x65, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61,
-	0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x48, 0x61, 0x72, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x48, 0x61, 0x72, 0x64,
-	0x12, 0x2c, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e,
-	0x74, 0x48, 0x61, 0x72, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6c, 0x61, 0x73,
-	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x48, 0x61, 0x72, 0x64, 0x12, 0x34,
-	0x0a, 0x15, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x53, 0x6f,
-	0x66, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x68,
-	0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x53, 0x6f, 0x66, 0x74, 0x65,
-	0x6e, 0x69, 0x6e, 0x67, 0x22, 0x2e, 0x0a, 0x07, 0x52, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x74, 0x12,
-	0x23, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05, 0x72,
-	0x6f, 0x6f, 0x74, 0x73, 0x22, 0xde, 0x05, 0x0a, 0x06, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12,
-	0x22, 0x0a, 0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61,
-	0x74, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f,
-	0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x6f, 0x74, 0x74, 0x6f,
-	0x6d, 0x61, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x6f, 0x6c, 0x6f, 0x67,
-	0x69, 0x63, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x43, 0x6c,
-	0x61, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4c,
-	0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65,
-	0x6c, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x50, 0x4f, 0x53,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
-	0x2e, 0x50, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x52, 0x0b, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x50, 0x4f, 0x53, 0x12, 0x42, 0x0a, 0x15, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f,
-	0x77, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x72, 0x76,
-	0x61, 0x7a, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x73, 0x45, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x44,
-	0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x48, 0x61, 0x73, 0x53, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c,
-	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x16, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x48, 0x61, 0x73, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56,
-	0x6f, 0x77, 0x65, 0x6c, 0x12, 0x4a, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73,
-	0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x48, 0x61, 0x72,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a,
-	0x65, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x19, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x4c,
-	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x48, 0x61, 0x72, 0x64,
-	0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65,
-	0x6c, 0x54, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x74, 0x73, 0x4c,
-	0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x54, 0x6f, 0x12, 0x4c, 0x0a, 0x1a, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c,
-	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x1a, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x38, 0x0a, 0x17, 0x69, 0x6e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x43, 0x6c, 0x61, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x69, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x73, 0x50, 0x4f,
-	0x53, 0x74, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x72, 0x76,
-	0x61, 0x7a, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68,
-	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x73, 0x50, 0x4f, 0x53, 0x74, 0x6f, 0x12,
-	0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f, 0x77, 0x65, 0x6c,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68,
-	0x56, 0x6f, 0x77, 0x65, 0x6c, 0x22, 0x4a, 0x0a, 0x09, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x53,
-	0x65, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53,
-	0x75, 0x66, 0x66, 0x69, 0x78, 0x52, 0x08, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
-	0x67, 0x22, 0x73, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68,
-	0x4c, 0x61, 0x74, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x74,
-	0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x06, 0x76,
-	0x69, 0x73, 0x65, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x76,
-	0x69, 0x73, 0x65, 0x6e, 0x63, 0x12, 0x1a, 0x0a, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61,
-	0x6e, 0x42, 0x03, 0x0a, 0x01, 0x72, 0x22, 0xfe, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61,
-	0x7a, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x2b, 0x0a,
-	0x08, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78,
-	0x52, 0x08, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x64, 0x65,
-	0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x10, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e,
-	0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61,
-	0x6e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x10, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x52, 0x65,
-	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x12, 0x36,
-	0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x65, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x09, 0x76, 0x61, 0x72,
-	0x69, 0x65, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x64, 0x65, 0x72, 0x76,
-	0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x77,
-	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x72,
-	0x76, 0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x56, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x3b,
-	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x11,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x65, 0x72, 0x76,
-	0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x2a, 0x2e, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x46, 0x55, 0x5a, 0x5a, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45, 0x58,
-	0x10, 0x02, 0x2a, 0x4e, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54,
-	0x55, 0x52, 0x4b, 0x49, 0x53, 0x48, 0x5f, 0x4c, 0x41, 0x54, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0a,
-	0x0a, 0x06, 0x56, 0x49, 0x53, 0x45, 0x4e, 0x43, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x54,
-	0x54, 0x4f, 0x4d, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x42, 0x4a, 0x41, 0x44,
-	0x10, 0x04, 0x2a, 0x27, 0x0a, 0x03, 0x52, 0x65, 0x71, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x56,
-	0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x41, 0x59, 0x42, 0x45, 0x10, 0x01, 0x12,
-	0x0a, 0x0a, 0x06, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x02, 0x2a, 0x33, 0x0a, 0x0c, 0x50,
-	0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12, 0x08, 0x0a, 0x04, 0x4e,
-	0x4f, 0x55, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x45, 0x52, 0x42, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x55, 0x4e, 0x10, 0x02,
-	0x2a, 0x2e, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x74, 0x72, 0x32, 0x6f,
-	0x74, 0x6d, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x6f, 0x74, 0x6d, 0x32, 0x74, 0x72, 0x10, 0x01,
-	0x32, 0x8c, 0x02, 0x0a, 0x07, 0x44, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x0f,
-	0x56, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x54, 0x6f, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x12,
-	0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61,
-	0x6e, 0x57, 0x6f, 0x72, 0x64, 0x1a, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e,
-	0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a,
-	0x0f, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x54, 0x6f, 0x56, 0x69, 0x73, 0x65, 0x6e, 0x63,
-	0x12, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d,
-	0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x1a, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
-	0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x22, 0x00, 0x12, 0x39,
-	0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x16, 0x2e,
-	0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e,
-	0x52, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x74, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x36, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x72,
-	0x70, 0x63, 0x42, 0x0c, 0x44, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x0c, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2f, 0x6c, 0x61, 0x6e, 0x67,
-	0xa2, 0x02, 0x04, 0x44, 0x52, 0x56, 0x5a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_lang_dervaze_proto_rawDescOnce sync.Once
-	file_lang_dervaze_proto_rawDescData = file_lang_dervaze_proto_rawDesc
-)
-
-func file_lang_dervaze_proto_rawDescGZIP() []byte {
-	file_lang_dervaze_proto_rawDescOnce.Do(func() {
-		file_lang_dervaze_proto_rawDescData = protoimpl.X.CompressGZIP(file_lang_dervaze_proto_rawDescData)
-	})
-	return file_lang_dervaze_proto_rawDescData
-}
-
-var file_lang_dervaze_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_lang_dervaze_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_lang_dervaze_proto_goTypes = []interface{}{
-	(SearchType)(0),             // 0: dervaze.SearchType
-	(SearchField)(0),            // 1: dervaze.SearchField
-	(Req)(0),                    // 2: dervaze.Req
-	(PartOfSpeech)(0),           // 3: dervaze.PartOfSpeech
-	(TranslationDirection)(0),   // 4: dervaze.TranslationDirection
-	(*SearchRequest)(nil),       // 5: dervaze.SearchRequest
-	(*OttomanWord)(nil),         // 6: dervaze.OttomanWord
-	(*Root)(nil),                // 7: dervaze.Root
-	(*RootSet)(nil),             // 8: dervaze.RootSet
-	(*Suffix)(nil),              // 9: dervaze.Suffix
-	(*SuffixSet)(nil),           // 10: dervaze.SuffixSet
-	(*TranslateRequest)(nil),    // 11: dervaze.TranslateRequest
-	(*TranslationWord)(nil),     // 12: dervaze.TranslationWord
-	(*TranslationVariety)(nil),  // 13: dervaze.TranslationVariety
-	(*TranslationSentence)(nil), // 14: dervaze.TranslationSentence
-	(*TranslateResponse)(nil),   // 15: dervaze.TranslateResponse
-}
-var file_lang_dervaze_proto_depIdxs = []int32{
-	1,  // 0: dervaze.SearchRequest.searchField:type_name -> dervaze.SearchField
-	0,  // 1: dervaze.SearchRequest.searchType:type_name -> dervaze.SearchType
-	6,  // 2: dervaze.Root.ottoman:type_name -> dervaze.OttomanWord
-	3,  // 3: dervaze.Root.partOfSpeech:type_name -> dervaze.PartOfSpeech
-	7,  // 4: dervaze.RootSet.roots:type_name -> dervaze.Root
-	6,  // 5: dervaze.Suffix.ottoman:type_name -> dervaze.OttomanWord
-	3,  // 6: dervaze.Suffix.requiresPOS:type_name -> dervaze.PartOfSpeech
-	2,  // 7: dervaze.Suffix.requiresEndsWithVowel:type_name -> dervaze.Req
-	2,  // 8: dervaze.Suffix.requiresHasSingleVowel:type_name -> dervaze.Req
-	2,  // 9: dervaze.Suffix.requiresLastConsonantHard:type_name -> dervaze.Req
-	2,  // 10: dervaze.Suffix.requiresContinuationSuffix:type_name -> dervaze.Req
-	3,  // 11: dervaze.Suffix.convertsPOSto:type_name -> dervaze.PartOfSpeech
-	9,  // 12: dervaze.SuffixSet.suffixes:type_name -> dervaze.Suffix
-	7,  // 13: dervaze.TranslationWord.root:type_name -> dervaze.Root
-	9,  // 14: dervaze.TranslationWord.suffixes:type_name -> dervaze.Suffix
-	4,  // 15: dervaze.TranslationWord.direction:type_name -> dervaze.TranslationDirection
-	6,  // 16: dervaze.TranslationWord.ottomanRemaining:type_name -> dervaze.OttomanWord
-	12, // 17: dervaze.TranslationVariety.varieties:type_name -> dervaze.TranslationWord
-	4,  // 18: dervaze.TranslationVariety.direction:type_name -> dervaze.TranslationDirection
-	13, // 19: dervaze.TranslationSentence.words:type_name -> dervaze.TranslationVariety
-	4,  // 20: dervaze.TranslationSentence.direction:type_name -> dervaze.TranslationDirection
-	11, // 21: dervaze.TranslateResponse.request:type_name -> dervaze.TranslateRequest
-	14, // 22: dervaze.TranslateResponse.sentences:type_name -> dervaze.TranslationSentence
-	6,  // 23: dervaze.Dervaze.VisencToOttoman:input_type -> dervaze.OttomanWord
-	6,  // 24: dervaze.Dervaze.OttomanToVisenc:input_type -> dervaze.OttomanWord
-	5,  // 25: dervaze.Dervaze.SearchRoots:input_type -> dervaze.SearchRequest
-	11, // 26: dervaze.Dervaze.Translate:input_type -> dervaze.TranslateRequest
-	6,  // 27: dervaze.Dervaze.VisencToOttoman:output_type -> dervaze.OttomanWord
-	6,  // 28: dervaze.Dervaze.OttomanToVisenc:output_type -> dervaze.OttomanWord
-	8,  // 29: dervaze.Dervaze.SearchRoots:output_type -> dervaze.RootSet
-	15, // 30: dervaze.Dervaze.Translate:output_type -> dervaze.TranslateResponse
-	27, // [27:31] is the sub-list for method output_type
-	23, // [23:27] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
-}
-
-func init() { file_lang_dervaze_proto_init() }
-func file_lang_dervaze_proto_init() {
-	if File_lang_dervaze_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_lang_dervaze_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuffixSet.ProtoReflect.Descriptor instead.
+func (*SuffixSet) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SuffixSet) GetSuffixes() []*Suffix {
+	if x != nil {
+		return x.Suffixes
+	}
+	return nil
+}
+
+func (x *SuffixSet) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type TranslateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to R:
+	//	*TranslateRequest_TurkishLatin
+	//	*TranslateRequest_Visenc
+	//	*TranslateRequest_Ottoman
+	R isTranslateRequest_R `protobuf_oneof:"r"`
+}
+
+func (x *TranslateRequest) Reset() {
+	*x = TranslateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslateRequest) ProtoMessage() {}
+
+func (x *TranslateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslateRequest.ProtoReflect.Descriptor instead.
+func (*TranslateRequest) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *TranslateRequest) GetR() isTranslateRequest_R {
+	if m != nil {
+		return m.R
+	}
+	return nil
+}
+
+func (x *TranslateRequest) GetTurkishLatin() string {
+	if x, ok := x.GetR().(*TranslateRequest_TurkishLatin); ok {
+		return x.TurkishLatin
+	}
+	return ""
+}
+
+func (x *TranslateRequest) GetVisenc() string {
+	if x, ok := x.GetR().(*TranslateRequest_Visenc); ok {
+		return x.Visenc
+	}
+	return ""
+}
+
+func (x *TranslateRequest) GetOttoman() string {
+	if x, ok := x.GetR().(*TranslateRequest_Ottoman); ok {
+		return x.Ottoman
+	}
+	return ""
+}
+
+type isTranslateRequest_R interface {
+	isTranslateRequest_R()
+}
+
+type TranslateRequest_TurkishLatin struct {
+	TurkishLatin string `protobuf:"bytes,2,opt,name=turkishLatin,proto3,oneof"`
+}
+
+type TranslateRequest_Visenc struct {
+	Visenc string `protobuf:"bytes,3,opt,name=visenc,proto3,oneof"`
+}
+
+type TranslateRequest_Ottoman struct {
+	Ottoman string `protobuf:"bytes,4,opt,name=ottoman,proto3,oneof"`
+}
+
+func (*TranslateRequest_TurkishLatin) isTranslateRequest_R() {}
+
+func (*TranslateRequest_Visenc) isTranslateRequest_R() {}
+
+func (*TranslateRequest_Ottoman) isTranslateRequest_R() {}
+
+type TranslationWord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root             *Root                `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Suffixes         []*Suffix            `protobuf:"bytes,2,rep,name=suffixes,proto3" json:"suffixes,omitempty"`
+	Remaining        string               `protobuf:"bytes,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Direction        TranslationDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=dervaze.TranslationDirection" json:"direction,omitempty"`
+	OttomanRemaining *OttomanWord         `protobuf:"bytes,5,opt,name=ottomanRemaining,proto3" json:"ottomanRemaining,omitempty"`
+	// probability of this reading in the context of the sentence
+	Probability float64 `protobuf:"fixed64,6,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (x *TranslationWord) Reset() {
+	*x = TranslationWord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslationWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslationWord) ProtoMessage() {}
+
+func (x *TranslationWord) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslationWord.ProtoReflect.Descriptor instead.
+func (*TranslationWord) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TranslationWord) GetRoot() *Root {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *TranslationWord) GetSuffixes() []*Suffix {
+	if x != nil {
+		return x.Suffixes
+	}
+	return nil
+}
+
+func (x *TranslationWord) GetRemaining() string {
+	if x != nil {
+		return x.Remaining
+	}
+	return ""
+}
+
+func (x *TranslationWord) GetDirection() TranslationDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TranslationDirection_tr2otm
+}
+
+func (x *TranslationWord) GetOttomanRemaining() *OttomanWord {
+	if x != nil {
+		return x.OttomanRemaining
+	}
+	return nil
+}
+
+func (x *TranslationWord) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+type TranslationVariety struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Varieties []*TranslationWord   `protobuf:"bytes,1,rep,name=varieties,proto3" json:"varieties,omitempty"`
+	Direction TranslationDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=dervaze.TranslationDirection" json:"direction,omitempty"`
+}
+
+func (x *TranslationVariety) Reset() {
+	*x = TranslationVariety{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslationVariety) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslationVariety) ProtoMessage() {}
+
+func (x *TranslationVariety) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslationVariety.ProtoReflect.Descriptor instead.
+func (*TranslationVariety) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TranslationVariety) GetVarieties() []*TranslationWord {
+	if x != nil {
+		return x.Varieties
+	}
+	return nil
+}
+
+func (x *TranslationVariety) GetDirection() TranslationDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TranslationDirection_tr2otm
+}
+
+type TranslationSentence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Words     []*TranslationVariety `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	Direction TranslationDirection  `protobuf:"varint,2,opt,name=direction,proto3,enum=dervaze.TranslationDirection" json:"direction,omitempty"`
+}
+
+func (x *TranslationSentence) Reset() {
+	*x = TranslationSentence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslationSentence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslationSentence) ProtoMessage() {}
+
+func (x *TranslationSentence) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslationSentence.ProtoReflect.Descriptor instead.
+func (*TranslationSentence) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TranslationSentence) GetWords() []*TranslationVariety {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *TranslationSentence) GetDirection() TranslationDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TranslationDirection_tr2otm
+}
+
+type TranslateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request   *TranslateRequest      `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Sentences []*TranslationSentence `protobuf:"bytes,2,rep,name=sentences,proto3" json:"sentences,omitempty"`
+}
+
+func (x *TranslateResponse) Reset() {
+	*x = TranslateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslateResponse) ProtoMessage() {}
+
+func (x *TranslateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslateResponse.ProtoReflect.Descriptor instead.
+func (*TranslateResponse) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TranslateResponse) GetRequest() *TranslateRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *TranslateResponse) GetSentences() []*TranslationSentence {
+	if x != nil {
+		return x.Sentences
+	}
+	return nil
+}
+
+// CompletionRequest is the input typed so far, sent at each keystroke
+type CompletionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix      string      `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	SearchField SearchField `protobuf:"varint,2,opt,name=searchField,proto3,enum=dervaze.SearchField" json:"searchField,omitempty"`
+	ResultLimit int32       `protobuf:"varint,3,opt,name=resultLimit,proto3" json:"resultLimit,omitempty"`
+	Script      Script      `protobuf:"varint,4,opt,name=script,proto3,enum=dervaze.Script" json:"script,omitempty"`
+	// number of the keystroke, returned with its completions
+	Sequence int64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *CompletionRequest) Reset() {
+	*x = CompletionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionRequest) ProtoMessage() {}
+
+func (x *CompletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionRequest.ProtoReflect.Descriptor instead.
+func (*CompletionRequest) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CompletionRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *CompletionRequest) GetSearchField() SearchField {
+	if x != nil {
+		return x.SearchField
+	}
+	return SearchField_AUTO
+}
+
+func (x *CompletionRequest) GetResultLimit() int32 {
+	if x != nil {
+		return x.ResultLimit
+	}
+	return 0
+}
+
+func (x *CompletionRequest) GetScript() Script {
+	if x != nil {
+		return x.Script
+	}
+	return Script_OTTOMAN_TURKISH
+}
+
+func (x *CompletionRequest) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type CompletionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence int64   `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Prefix   string  `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Roots    []*Root `protobuf:"bytes,3,rep,name=roots,proto3" json:"roots,omitempty"`
+}
+
+func (x *CompletionResponse) Reset() {
+	*x = CompletionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionResponse) ProtoMessage() {}
+
+func (x *CompletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionResponse.ProtoReflect.Descriptor instead.
+func (*CompletionResponse) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CompletionResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *CompletionResponse) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *CompletionResponse) GetRoots() []*Root {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+// PhoneticRequest is a word typed in Latin letters as it's pronounced, like kitab, hane or sheker
+type PhoneticRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latin       string `protobuf:"bytes,1,opt,name=latin,proto3" json:"latin,omitempty"`
+	ResultLimit int32  `protobuf:"varint,2,opt,name=resultLimit,proto3" json:"resultLimit,omitempty"`
+	// script of the Unicode spellings in the response
+	Script Script `protobuf:"varint,3,opt,name=script,proto3,enum=dervaze.Script" json:"script,omitempty"`
+}
+
+func (x *PhoneticRequest) Reset() {
+	*x = PhoneticRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhoneticRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhoneticRequest) ProtoMessage() {}
+
+func (x *PhoneticRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhoneticRequest.ProtoReflect.Descriptor instead.
+func (*PhoneticRequest) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PhoneticRequest) GetLatin() string {
+	if x != nil {
+		return x.Latin
+	}
+	return ""
+}
+
+func (x *PhoneticRequest) GetResultLimit() int32 {
+	if x != nil {
+		return x.ResultLimit
+	}
+	return 0
+}
+
+func (x *PhoneticRequest) GetScript() Script {
+	if x != nil {
+		return x.Script
+	}
+	return Script_OTTOMAN_TURKISH
+}
+
+// PhoneticLetter is an Ottoman letter of a candidate and the Latin letters it's written for.
+// Latin letters of guesses may have no Ottoman letter, like short vowels, dictionary letters have no Latin.
+type PhoneticLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latin   string `protobuf:"bytes,1,opt,name=latin,proto3" json:"latin,omitempty"`
+	Visenc  string `protobuf:"bytes,2,opt,name=visenc,proto3" json:"visenc,omitempty"`
+	Unicode string `protobuf:"bytes,3,opt,name=unicode,proto3" json:"unicode,omitempty"`
+}
+
+func (x *PhoneticLetter) Reset() {
+	*x = PhoneticLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhoneticLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhoneticLetter) ProtoMessage() {}
+
+func (x *PhoneticLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhoneticLetter.ProtoReflect.Descriptor instead.
+func (*PhoneticLetter) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PhoneticLetter) GetLatin() string {
+	if x != nil {
+		return x.Latin
+	}
+	return ""
+}
+
+func (x *PhoneticLetter) GetVisenc() string {
+	if x != nil {
+		return x.Visenc
+	}
+	return ""
+}
+
+func (x *PhoneticLetter) GetUnicode() string {
+	if x != nil {
+		return x.Unicode
+	}
+	return ""
+}
+
+type PhoneticCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ottoman *OttomanWord    `protobuf:"bytes,1,opt,name=ottoman,proto3" json:"ottoman,omitempty"`
+	Source  CandidateSource `protobuf:"varint,2,opt,name=source,proto3,enum=dervaze.CandidateSource" json:"source,omitempty"`
+	// dictionary root of the candidate, empty for guesses
+	Root    *Root             `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Letters []*PhoneticLetter `protobuf:"bytes,4,rep,name=letters,proto3" json:"letters,omitempty"`
+	// total cost of the rules of a guess
+	Cost float64 `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *PhoneticCandidate) Reset() {
+	*x = PhoneticCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhoneticCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhoneticCandidate) ProtoMessage() {}
+
+func (x *PhoneticCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhoneticCandidate.ProtoReflect.Descriptor instead.
+func (*PhoneticCandidate) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PhoneticCandidate) GetOttoman() *OttomanWord {
+	if x != nil {
+		return x.Ottoman
+	}
+	return nil
+}
+
+func (x *PhoneticCandidate) GetSource() CandidateSource {
+	if x != nil {
+		return x.Source
+	}
+	return CandidateSource_DICTIONARY
+}
+
+func (x *PhoneticCandidate) GetRoot() *Root {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *PhoneticCandidate) GetLetters() []*PhoneticLetter {
+	if x != nil {
+		return x.Letters
+	}
+	return nil
+}
+
+func (x *PhoneticCandidate) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type PhoneticResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latin      string               `protobuf:"bytes,1,opt,name=latin,proto3" json:"latin,omitempty"`
+	Candidates []*PhoneticCandidate `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (x *PhoneticResponse) Reset() {
+	*x = PhoneticResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhoneticResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhoneticResponse) ProtoMessage() {}
+
+func (x *PhoneticResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhoneticResponse.ProtoReflect.Descriptor instead.
+func (*PhoneticResponse) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PhoneticResponse) GetLatin() string {
+	if x != nil {
+		return x.Latin
+	}
+	return ""
+}
+
+func (x *PhoneticResponse) GetCandidates() []*PhoneticCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type SpellCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// number of suggestions for each misspelled word
+	SuggestionLimit int32 `protobuf:"varint,2,opt,name=suggestionLimit,proto3" json:"suggestionLimit,omitempty"`
+	// script of the text and of the suggestions
+	Script Script `protobuf:"varint,3,opt,name=script,proto3,enum=dervaze.Script" json:"script,omitempty"`
+}
+
+func (x *SpellCheckRequest) Reset() {
+	*x = SpellCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpellCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpellCheckRequest) ProtoMessage() {}
+
+func (x *SpellCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpellCheckRequest.ProtoReflect.Descriptor instead.
+func (*SpellCheckRequest) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SpellCheckRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SpellCheckRequest) GetSuggestionLimit() int32 {
+	if x != nil {
+		return x.SuggestionLimit
+	}
+	return 0
+}
+
+func (x *SpellCheckRequest) GetScript() Script {
+	if x != nil {
+		return x.Script
+	}
+	return Script_OTTOMAN_TURKISH
+}
+
+type SpellSuggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ottoman *OttomanWord `protobuf:"bytes,1,opt,name=ottoman,proto3" json:"ottoman,omitempty"`
+	// dictionary root of the suggestion, suffixes of the misspelled word are kept after it
+	Root *Root `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// letter edit distance from the misspelled word, similar letters cost less than 1
+	Distance float64 `protobuf:"fixed64,3,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *SpellSuggestion) Reset() {
+	*x = SpellSuggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpellSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpellSuggestion) ProtoMessage() {}
+
+func (x *SpellSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpellSuggestion.ProtoReflect.Descriptor instead.
+func (*SpellSuggestion) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SpellSuggestion) GetOttoman() *OttomanWord {
+	if x != nil {
+		return x.Ottoman
+	}
+	return nil
+}
+
+func (x *SpellSuggestion) GetRoot() *Root {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *SpellSuggestion) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type Misspelling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// byte offsets of the word in the text
+	Start       int32              `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End         int32              `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Suggestions []*SpellSuggestion `protobuf:"bytes,4,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+}
+
+func (x *Misspelling) Reset() {
+	*x = Misspelling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Misspelling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Misspelling) ProtoMessage() {}
+
+func (x *Misspelling) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Misspelling.ProtoReflect.Descriptor instead.
+func (*Misspelling) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Misspelling) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *Misspelling) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Misspelling) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *Misspelling) GetSuggestions() []*SpellSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type SpellCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Misspellings []*Misspelling `protobuf:"bytes,1,rep,name=misspellings,proto3" json:"misspellings,omitempty"`
+	WordCount    int32          `protobuf:"varint,2,opt,name=wordCount,proto3" json:"wordCount,omitempty"`
+}
+
+func (x *SpellCheckResponse) Reset() {
+	*x = SpellCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpellCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpellCheckResponse) ProtoMessage() {}
+
+func (x *SpellCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpellCheckResponse.ProtoReflect.Descriptor instead.
+func (*SpellCheckResponse) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SpellCheckResponse) GetMisspellings() []*Misspelling {
+	if x != nil {
+		return x.Misspellings
+	}
+	return nil
+}
+
+func (x *SpellCheckResponse) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+type OCRToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// OCR confidence of each character of text from 0 to 1, characters without a confidence are certain
+	Confidences []float32 `protobuf:"fixed32,2,rep,packed,name=confidences,proto3" json:"confidences,omitempty"`
+}
+
+func (x *OCRToken) Reset() {
+	*x = OCRToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OCRToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OCRToken) ProtoMessage() {}
+
+func (x *OCRToken) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OCRToken.ProtoReflect.Descriptor instead.
+func (*OCRToken) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OCRToken) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *OCRToken) GetConfidences() []float32 {
+	if x != nil {
+		return x.Confidences
+	}
+	return nil
+}
+
+type OCRRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tokens are corrected one by one, text is used if there are no tokens
+	Tokens []*OCRToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Text   string      `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// number of candidates for each token
+	CandidateLimit int32 `protobuf:"varint,3,opt,name=candidateLimit,proto3" json:"candidateLimit,omitempty"`
+	// script of the OCR output and of the candidates
+	Script Script `protobuf:"varint,4,opt,name=script,proto3,enum=dervaze.Script" json:"script,omitempty"`
+}
+
+func (x *OCRRequest) Reset() {
+	*x = OCRRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OCRRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OCRRequest) ProtoMessage() {}
+
+func (x *OCRRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OCRRequest.ProtoReflect.Descriptor instead.
+func (*OCRRequest) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OCRRequest) GetTokens() []*OCRToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *OCRRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *OCRRequest) GetCandidateLimit() int32 {
+	if x != nil {
+		return x.CandidateLimit
+	}
+	return 0
+}
+
+func (x *OCRRequest) GetScript() Script {
+	if x != nil {
+		return x.Script
+	}
+	return Script_OTTOMAN_TURKISH
+}
+
+type OCRCorrection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// byte offsets of the token in the request text
+	Start int32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int32 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	// the token is spelled as a dictionary word, the candidates are other readings of it
+	Known bool `protobuf:"varint,4,opt,name=known,proto3" json:"known,omitempty"`
+	// distances of the candidates are the costs of reading them as the token
+	Candidates []*SpellSuggestion `protobuf:"bytes,5,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (x *OCRCorrection) Reset() {
+	*x = OCRCorrection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OCRCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OCRCorrection) ProtoMessage() {}
+
+func (x *OCRCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OCRCorrection.ProtoReflect.Descriptor instead.
+func (*OCRCorrection) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OCRCorrection) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *OCRCorrection) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *OCRCorrection) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *OCRCorrection) GetKnown() bool {
+	if x != nil {
+		return x.Known
+	}
+	return false
+}
+
+func (x *OCRCorrection) GetCandidates() []*SpellSuggestion {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type OCRResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Corrections []*OCRCorrection `protobuf:"bytes,1,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	// request text with unknown tokens replaced by their first candidates
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *OCRResponse) Reset() {
+	*x = OCRResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OCRResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OCRResponse) ProtoMessage() {}
+
+func (x *OCRResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OCRResponse.ProtoReflect.Descriptor instead.
+func (*OCRResponse) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OCRResponse) GetCorrections() []*OCRCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+func (x *OCRResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WaznRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// word is analyzed by AnalyzeWazn
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// root letters like کتب, ک-ت-ب or k-t-b and a pattern name like mef'ûl are generated by GenerateWazn,
+	// every pattern is applied if pattern is empty
+	Root    string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// script of the Ottoman words in the request and the response
+	Script Script `protobuf:"varint,4,opt,name=script,proto3,enum=dervaze.Script" json:"script,omitempty"`
+}
+
+func (x *WaznRequest) Reset() {
+	*x = WaznRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaznRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaznRequest) ProtoMessage() {}
+
+func (x *WaznRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaznRequest.ProtoReflect.Descriptor instead.
+func (*WaznRequest) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WaznRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *WaznRequest) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *WaznRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *WaznRequest) GetScript() Script {
+	if x != nil {
+		return x.Script
+	}
+	return Script_OTTOMAN_TURKISH
+}
+
+// WaznForm is an Arabic root written in an Arabic derivation pattern (wazn)
+type WaznForm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pattern name in Latin transcription like mef'ûl and its Ottoman spelling like مفعول
+	Pattern        string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	PatternOttoman string `protobuf:"bytes,2,opt,name=patternOttoman,proto3" json:"patternOttoman,omitempty"`
+	ArabicRoot     string `protobuf:"bytes,3,opt,name=arabicRoot,proto3" json:"arabicRoot,omitempty"`
+	// spellings of the root in the pattern, like mektûb and مکتوب for کتب, weak roots are not changed
+	TurkishLatin string       `protobuf:"bytes,4,opt,name=turkishLatin,proto3" json:"turkishLatin,omitempty"`
+	Ottoman      *OttomanWord `protobuf:"bytes,5,opt,name=ottoman,proto3" json:"ottoman,omitempty"`
+	// dictionary roots spelled as the form, the form is not in the dictionary if there are none
+	Roots []*Root `protobuf:"bytes,6,rep,name=roots,proto3" json:"roots,omitempty"`
+	// analyzed forms with more pattern letters and fewer stripped affixes have higher scores
+	Score int32 `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *WaznForm) Reset() {
+	*x = WaznForm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaznForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaznForm) ProtoMessage() {}
+
+func (x *WaznForm) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaznForm.ProtoReflect.Descriptor instead.
+func (*WaznForm) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WaznForm) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *WaznForm) GetPatternOttoman() string {
+	if x != nil {
+		return x.PatternOttoman
+	}
+	return ""
+}
+
+func (x *WaznForm) GetArabicRoot() string {
+	if x != nil {
+		return x.ArabicRoot
+	}
+	return ""
+}
+
+func (x *WaznForm) GetTurkishLatin() string {
+	if x != nil {
+		return x.TurkishLatin
+	}
+	return ""
+}
+
+func (x *WaznForm) GetOttoman() *OttomanWord {
+	if x != nil {
+		return x.Ottoman
+	}
+	return nil
+}
+
+func (x *WaznForm) GetRoots() []*Root {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+func (x *WaznForm) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type WaznResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Forms []*WaznForm `protobuf:"bytes,1,rep,name=forms,proto3" json:"forms,omitempty"`
+}
+
+func (x *WaznResponse) Reset() {
+	*x = WaznResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaznResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaznResponse) ProtoMessage() {}
+
+func (x *WaznResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaznResponse.ProtoReflect.Descriptor instead.
+func (*WaznResponse) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WaznResponse) GetForms() []*WaznForm {
+	if x != nil {
+		return x.Forms
+	}
+	return nil
+}
+
+// NGramModel keeps word and character n-gram counts trained from a corpus.
+// N-grams are joined by a space, sentence boundaries are <s> and </s>.
+type NGramModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WordOrder  int32            `protobuf:"varint,1,opt,name=wordOrder,proto3" json:"wordOrder,omitempty"`
+	CharOrder  int32            `protobuf:"varint,2,opt,name=charOrder,proto3" json:"charOrder,omitempty"`
+	WordCounts map[string]int64 `protobuf:"bytes,3,rep,name=wordCounts,proto3" json:"wordCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	CharCounts map[string]int64 `protobuf:"bytes,4,rep,name=charCounts,proto3" json:"charCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TotalWords int64            `protobuf:"varint,5,opt,name=totalWords,proto3" json:"totalWords,omitempty"`
+	TotalChars int64            `protobuf:"varint,6,opt,name=totalChars,proto3" json:"totalChars,omitempty"`
+	Tag        string           `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *NGramModel) Reset() {
+	*x = NGramModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NGramModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NGramModel) ProtoMessage() {}
+
+func (x *NGramModel) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NGramModel.ProtoReflect.Descriptor instead.
+func (*NGramModel) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *NGramModel) GetWordOrder() int32 {
+	if x != nil {
+		return x.WordOrder
+	}
+	return 0
+}
+
+func (x *NGramModel) GetCharOrder() int32 {
+	if x != nil {
+		return x.CharOrder
+	}
+	return 0
+}
+
+func (x *NGramModel) GetWordCounts() map[string]int64 {
+	if x != nil {
+		return x.WordCounts
+	}
+	return nil
+}
+
+func (x *NGramModel) GetCharCounts() map[string]int64 {
+	if x != nil {
+		return x.CharCounts
+	}
+	return nil
+}
+
+func (x *NGramModel) GetTotalWords() int64 {
+	if x != nil {
+		return x.TotalWords
+	}
+	return 0
+}
+
+func (x *NGramModel) GetTotalChars() int64 {
+	if x != nil {
+		return x.TotalChars
+	}
+	return 0
+}
+
+func (x *NGramModel) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type RhymeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word           string         `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	SearchField    SearchField    `protobuf:"varint,2,opt,name=searchField,proto3,enum=dervaze.SearchField" json:"searchField,omitempty"`
+	PartsOfSpeech  []PartOfSpeech `protobuf:"varint,3,rep,packed,name=partsOfSpeech,proto3,enum=dervaze.PartOfSpeech" json:"partsOfSpeech,omitempty"`
+	SyllableCount  int32          `protobuf:"varint,4,opt,name=syllableCount,proto3" json:"syllableCount,omitempty"`
+	MinMatchLength int32          `protobuf:"varint,5,opt,name=minMatchLength,proto3" json:"minMatchLength,omitempty"`
+	ResultLimit    int32          `protobuf:"varint,6,opt,name=resultLimit,proto3" json:"resultLimit,omitempty"`
+}
+
+func (x *RhymeRequest) Reset() {
+	*x = RhymeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RhymeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RhymeRequest) ProtoMessage() {}
+
+func (x *RhymeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RhymeRequest.ProtoReflect.Descriptor instead.
+func (*RhymeRequest) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RhymeRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *RhymeRequest) GetSearchField() SearchField {
+	if x != nil {
+		return x.SearchField
+	}
+	return SearchField_AUTO
+}
+
+func (x *RhymeRequest) GetPartsOfSpeech() []PartOfSpeech {
+	if x != nil {
+		return x.PartsOfSpeech
+	}
+	return nil
+}
+
+func (x *RhymeRequest) GetSyllableCount() int32 {
+	if x != nil {
+		return x.SyllableCount
+	}
+	return 0
+}
+
+func (x *RhymeRequest) GetMinMatchLength() int32 {
+	if x != nil {
+		return x.MinMatchLength
+	}
+	return 0
+}
+
+func (x *RhymeRequest) GetResultLimit() int32 {
+	if x != nil {
+		return x.ResultLimit
+	}
+	return 0
+}
+
+type RhymeMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root        *Root `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	MatchLength int32 `protobuf:"varint,2,opt,name=matchLength,proto3" json:"matchLength,omitempty"`
+}
+
+func (x *RhymeMatch) Reset() {
+	*x = RhymeMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RhymeMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RhymeMatch) ProtoMessage() {}
+
+func (x *RhymeMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RhymeMatch.ProtoReflect.Descriptor instead.
+func (*RhymeMatch) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RhymeMatch) GetRoot() *Root {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *RhymeMatch) GetMatchLength() int32 {
+	if x != nil {
+		return x.MatchLength
+	}
+	return 0
+}
+
+type RhymeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *RhymeRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Rhymes  []*RhymeMatch `protobuf:"bytes,2,rep,name=rhymes,proto3" json:"rhymes,omitempty"`
+}
+
+func (x *RhymeResponse) Reset() {
+	*x = RhymeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RhymeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RhymeResponse) ProtoMessage() {}
+
+func (x *RhymeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RhymeResponse.ProtoReflect.Descriptor instead.
+func (*RhymeResponse) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RhymeResponse) GetRequest() *RhymeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *RhymeResponse) GetRhymes() []*RhymeMatch {
+	if x != nil {
+		return x.Rhymes
+	}
+	return nil
+}
+
+type VerseScanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line string `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *VerseScanRequest) Reset() {
+	*x = VerseScanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerseScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerseScanRequest) ProtoMessage() {}
+
+func (x *VerseScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerseScanRequest.ProtoReflect.Descriptor instead.
+func (*VerseScanRequest) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VerseScanRequest) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+type AruzSyllable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text      string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Word      string   `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	Weight    string   `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Reading   string   `protobuf:"bytes,4,opt,name=reading,proto3" json:"reading,omitempty"`
+	Licenses  []string `protobuf:"bytes,5,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	Violation bool     `protobuf:"varint,6,opt,name=violation,proto3" json:"violation,omitempty"`
+}
+
+func (x *AruzSyllable) Reset() {
+	*x = AruzSyllable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AruzSyllable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AruzSyllable) ProtoMessage() {}
+
+func (x *AruzSyllable) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AruzSyllable.ProtoReflect.Descriptor instead.
+func (*AruzSyllable) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AruzSyllable) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AruzSyllable) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *AruzSyllable) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+func (x *AruzSyllable) GetReading() string {
+	if x != nil {
+		return x.Reading
+	}
+	return ""
+}
+
+func (x *AruzSyllable) GetLicenses() []string {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+func (x *AruzSyllable) GetViolation() bool {
+	if x != nil {
+		return x.Violation
+	}
+	return false
+}
+
+type AruzMeter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Feet    string `protobuf:"bytes,2,opt,name=feet,proto3" json:"feet,omitempty"`
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *AruzMeter) Reset() {
+	*x = AruzMeter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AruzMeter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AruzMeter) ProtoMessage() {}
+
+func (x *AruzMeter) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AruzMeter.ProtoReflect.Descriptor instead.
+func (*AruzMeter) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AruzMeter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AruzMeter) GetFeet() string {
+	if x != nil {
+		return x.Feet
+	}
+	return ""
+}
+
+func (x *AruzMeter) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type VerseScan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line       string          `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	Syllables  []*AruzSyllable `protobuf:"bytes,2,rep,name=syllables,proto3" json:"syllables,omitempty"`
+	Meter      *AruzMeter      `protobuf:"bytes,3,opt,name=meter,proto3" json:"meter,omitempty"`
+	Scansion   string          `protobuf:"bytes,4,opt,name=scansion,proto3" json:"scansion,omitempty"`
+	Violations []int32         `protobuf:"varint,5,rep,packed,name=violations,proto3" json:"violations,omitempty"`
+	Cost       float64         `protobuf:"fixed64,6,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *VerseScan) Reset() {
+	*x = VerseScan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerseScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerseScan) ProtoMessage() {}
+
+func (x *VerseScan) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerseScan.ProtoReflect.Descriptor instead.
+func (*VerseScan) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VerseScan) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *VerseScan) GetSyllables() []*AruzSyllable {
+	if x != nil {
+		return x.Syllables
+	}
+	return nil
+}
+
+func (x *VerseScan) GetMeter() *AruzMeter {
+	if x != nil {
+		return x.Meter
+	}
+	return nil
+}
+
+func (x *VerseScan) GetScansion() string {
+	if x != nil {
+		return x.Scansion
+	}
+	return ""
+}
+
+func (x *VerseScan) GetViolations() []int32 {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *VerseScan) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type PhonologyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+}
+
+func (x *PhonologyRequest) Reset() {
+	*x = PhonologyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhonologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhonologyRequest) ProtoMessage() {}
+
+func (x *PhonologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhonologyRequest.ProtoReflect.Descriptor instead.
+func (*PhonologyRequest) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PhonologyRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+type PhonologyAnalysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word               string   `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Syllables          []string `protobuf:"bytes,2,rep,name=syllables,proto3" json:"syllables,omitempty"`
+	HarmonyClass       string   `protobuf:"bytes,3,opt,name=harmonyClass,proto3" json:"harmonyClass,omitempty"`
+	LongVowels         []string `protobuf:"bytes,4,rep,name=longVowels,proto3" json:"longVowels,omitempty"`
+	LastVowel          string   `protobuf:"bytes,5,opt,name=lastVowel,proto3" json:"lastVowel,omitempty"`
+	LastConsonant      string   `protobuf:"bytes,6,opt,name=lastConsonant,proto3" json:"lastConsonant,omitempty"`
+	EffectiveLastVowel string   `protobuf:"bytes,7,opt,name=effectiveLastVowel,proto3" json:"effectiveLastVowel,omitempty"`
+	EndsWithVowel      bool     `protobuf:"varint,8,opt,name=endsWithVowel,proto3" json:"endsWithVowel,omitempty"`
+	HasSingleVowel     bool     `protobuf:"varint,9,opt,name=hasSingleVowel,proto3" json:"hasSingleVowel,omitempty"`
+	LastVowelHard      bool     `protobuf:"varint,10,opt,name=lastVowelHard,proto3" json:"lastVowelHard,omitempty"`
+	LastConsonantHard  bool     `protobuf:"varint,11,opt,name=lastConsonantHard,proto3" json:"lastConsonantHard,omitempty"`
+	HarmonyConsistent  bool     `protobuf:"varint,12,opt,name=harmonyConsistent,proto3" json:"harmonyConsistent,omitempty"`
+	HarmonyBreaks      []int32  `protobuf:"varint,13,rep,packed,name=harmonyBreaks,proto3" json:"harmonyBreaks,omitempty"`
+	HyphenationPoints  []int32  `protobuf:"varint,14,rep,packed,name=hyphenationPoints,proto3" json:"hyphenationPoints,omitempty"`
+	Hyphenated         string   `protobuf:"bytes,15,opt,name=hyphenated,proto3" json:"hyphenated,omitempty"`
+	RuleTrace          []string `protobuf:"bytes,16,rep,name=ruleTrace,proto3" json:"ruleTrace,omitempty"`
+}
+
+func (x *PhonologyAnalysis) Reset() {
+	*x = PhonologyAnalysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lang_dervaze_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhonologyAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhonologyAnalysis) ProtoMessage() {}
+
+func (x *PhonologyAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_lang_dervaze_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhonologyAnalysis.ProtoReflect.Descriptor instead.
+func (*PhonologyAnalysis) Descriptor() ([]byte, []int) {
+	return file_lang_dervaze_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PhonologyAnalysis) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *PhonologyAnalysis) GetSyllables() []string {
+	if x != nil {
+		return x.Syllables
+	}
+	return nil
+}
+
+func (x *PhonologyAnalysis) GetHarmonyClass() string {
+	if x != nil {
+		return x.HarmonyClass
+	}
+	return ""
+}
+
+func (x *PhonologyAnalysis) GetLongVowels() []string {
+	if x != nil {
+		return x.LongVowels
+	}
+	return nil
+}
+
+func (x *PhonologyAnalysis) GetLastVowel() string {
+	if x != nil {
+		return x.LastVowel
+	}
+	return ""
+}
+
+func (x *PhonologyAnalysis) GetLastConsonant() string {
+	if x != nil {
+		return x.LastConsonant
+	}
+	return ""
+}
+
+func (x *PhonologyAnalysis) GetEffectiveLastVowel() string {
+	if x != nil {
+		return x.EffectiveLastVowel
+	}
+	return ""
+}
+
+func (x *PhonologyAnalysis) GetEndsWithVowel() bool {
+	if x != nil {
+		return x.EndsWithVowel
+	}
+	return false
+}
+
+func (x *PhonologyAnalysis) GetHasSingleVowel() bool {
+	if x != nil {
+		return x.HasSingleVowel
+	}
+	return false
+}
+
+func (x *PhonologyAnalysis) GetLastVowelHard() bool {
+	if x != nil {
+		return x.LastVowelHard
+	}
+	return false
+}
+
+func (x *PhonologyAnalysis) GetLastConsonantHard() bool {
+	if x != nil {
+		return x.LastConsonantHard
+	}
+	return false
+}
+
+func (x *PhonologyAnalysis) GetHarmonyConsistent() bool {
+	if x != nil {
+		return x.HarmonyConsistent
+	}
+	return false
+}
+
+func (x *PhonologyAnalysis) GetHarmonyBreaks() []int32 {
+	if x != nil {
+		return x.HarmonyBreaks
+	}
+	return nil
+}
+
+func (x *PhonologyAnalysis) GetHyphenationPoints() []int32 {
+	if x != nil {
+		return x.HyphenationPoints
+	}
+	return nil
+}
+
+func (x *PhonologyAnalysis) GetHyphenated() string {
+	if x != nil {
+		return x.Hyphenated
+	}
+	return ""
+}
+
+func (x *PhonologyAnalysis) GetRuleTrace() []string {
+	if x != nil {
+		return x.RuleTrace
+	}
+	return nil
+}
+
+var File_lang_dervaze_proto protoreflect.FileDescriptor
+
+var file_lang_dervaze_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x22, 0xaf, 0x02,
+	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x0a, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
+	0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x22,
+	0xee, 0x01, 0x0a, 0x0b, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x6e, 0x69, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x62, 0x6a, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x61, 0x62, 0x6a, 0x61, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x69, 0x73, 0x65, 0x6e,
+	0x63, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x64,
+	0x6f, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x6f, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a,
+	0x65, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x22, 0xa2, 0x06, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x75, 0x72,
+	0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x12, 0x2e, 0x0a,
+	0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e,
+	0x57, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x61,
+	0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65,
+	0x6c, 0x12, 0x34, 0x0a, 0x15, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x75,
+	0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x75, 0x72, 0x6b, 0x69,
+	0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x56, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x69, 0x73, 0x65, 0x6e,
+	0x63, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x62, 0x6a, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x61, 0x62, 0x6a, 0x61, 0x64, 0x12, 0x39, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x4f,
+	0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70,
+	0x65, 0x65, 0x63, 0x68, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65,
+	0x63, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f,
+	0x77, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x6e, 0x64, 0x73, 0x57,
+	0x69, 0x74, 0x68, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x68, 0x61, 0x73, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x6f, 0x77, 0x65, 0x6c,
+	0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x48, 0x61, 0x72,
+	0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77,
+	0x65, 0x6c, 0x48, 0x61, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x48, 0x61, 0x72, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74,
+	0x48, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6e, 0x61, 0x6e, 0x74, 0x53, 0x6f, 0x66, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e,
+	0x74, 0x53, 0x6f, 0x66, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x68,
+	0x6f, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x15, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x68, 0x6f, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a,
+	0x65, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61,
+	0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x72, 0x61, 0x62, 0x69, 0x63, 0x52, 0x6f,
+	0x6f, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x61, 0x62, 0x69, 0x63,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a,
+	0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0xfb, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a,
+	0x65, 0x2e, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x52, 0x08, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2a,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64, 0x65, 0x72, 0x76,
+	0x61, 0x7a, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x2d, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x33, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x22, 0x3a, 0x0a, 0x0e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0xfb, 0x02, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x40, 0x0a, 0x11, 0x74, 0x75,
+	0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x53, 0x70, 0x61, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x11, 0x74, 0x75, 0x72, 0x6b, 0x69,
+	0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x53, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0c,
+	0x75, 0x6e, 0x69, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x63, 0x6f, 0x64, 0x65, 0x53,
+	0x70, 0x61, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x53, 0x70,
+	0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x72, 0x76,
+	0x61, 0x7a, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x0b, 0x76,
+	0x69, 0x73, 0x65, 0x6e, 0x63, 0x53, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76,
+	0x61, 0x7a, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a,
+	0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xad,
+	0x01, 0x0a, 0x07, 0x52, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f,
+	0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x65, 0x72, 0x76,
+	0x61, 0x7a, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x12,
+	0x2f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x72, 0x61, 0x62, 0x69, 0x63,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x72, 0x61, 0x62, 0x69, 0x63, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22, 0xde,
+	0x05, 0x0a, 0x06, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x75, 0x72,
+	0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x12, 0x2e, 0x0a,
+	0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e,
+	0x57, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x12, 0x2e, 0x0a,
+	0x12, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x6f, 0x72, 0x70, 0x68,
+	0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x2c, 0x0a,
+	0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77,
+	0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x50, 0x4f, 0x53, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x4f,
+	0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x73, 0x50, 0x4f, 0x53, 0x12, 0x42, 0x0a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73,
+	0x45, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x65,
+	0x71, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x64, 0x73, 0x57,
+	0x69, 0x74, 0x68, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x44, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x73, 0x48, 0x61, 0x73, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x6f, 0x77,
+	0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61,
+	0x7a, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73,
+	0x48, 0x61, 0x73, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x4a,
+	0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x48, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x52,
+	0x19, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x48, 0x61, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65,
+	0x74, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x54, 0x6f, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x74, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77,
+	0x65, 0x6c, 0x54, 0x6f, 0x12, 0x4c, 0x0a, 0x1a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61,
+	0x7a, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x1a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x12, 0x38, 0x0a, 0x17, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x17, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x73, 0x50, 0x4f, 0x53, 0x74, 0x6f, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x73, 0x50, 0x4f, 0x53, 0x74, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x64,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x65, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x22,
+	0x4a, 0x0a, 0x09, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x53, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x08,
+	0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x52,
+	0x08, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x73, 0x0a, 0x10, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68,
+	0x4c, 0x61, 0x74, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x06, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x12,
+	0x1a, 0x0a, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x42, 0x03, 0x0a, 0x01, 0x72,
+	0x22, 0xa0, 0x02, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x57, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x6f, 0x6f,
+	0x74, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x75, 0x66, 0x66, 0x69,
+	0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x65, 0x72, 0x76,
+	0x61, 0x7a, 0x65, 0x2e, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x52, 0x08, 0x73, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x40, 0x0a, 0x10, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76,
+	0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x52,
+	0x10, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x76, 0x61,
+	0x72, 0x69, 0x65, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x65, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x85, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69,
+	0x65, 0x74, 0x79, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x74, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xca,
+	0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x36, 0x0a, 0x0b,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
+	0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x6d, 0x0a, 0x12, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52,
+	0x6f, 0x6f, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x0f, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x74, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x58,
+	0x0a, 0x0e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x6e, 0x69, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x75, 0x6e, 0x69, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x11, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x74, 0x69, 0x63, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2e,
+	0x0a, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61,
+	0x6e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x07, 0x6c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x10, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x74, 0x69, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61,
+	0x7a, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x22, 0x7a, 0x0a, 0x11, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x80, 0x01, 0x0a,
+	0x0f, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f,
+	0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e,
+	0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x85, 0x01, 0x0a, 0x0b, 0x4d, 0x69, 0x73, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x70, 0x65, 0x6c, 0x6c,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6c, 0x0a, 0x12, 0x53, 0x70, 0x65, 0x6c, 0x6c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x0c, 0x6d, 0x69, 0x73, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4d, 0x69,
+	0x73, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x70,
+	0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x40, 0x0a, 0x08, 0x4f, 0x43, 0x52, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x0a, 0x4f, 0x43, 0x52, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
+	0x2e, 0x4f, 0x43, 0x52, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a,
+	0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x06,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x0d, 0x4f, 0x43, 0x52, 0x43, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x38, 0x0a, 0x0a,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x70, 0x65, 0x6c, 0x6c,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x0b, 0x4f, 0x43, 0x52, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x72,
+	0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x43, 0x52, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x22, 0x78, 0x0a, 0x0b, 0x57, 0x61, 0x7a, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0xfb, 0x01,
+	0x0a, 0x08, 0x57, 0x61, 0x7a, 0x6e, 0x46, 0x6f, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x4f,
+	0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x72, 0x61, 0x62, 0x69, 0x63, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x72, 0x61, 0x62, 0x69, 0x63, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x75, 0x72, 0x6b, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x69, 0x6e,
+	0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f,
+	0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e,
+	0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05,
+	0x72, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x37, 0x0a, 0x0c, 0x57,
+	0x61, 0x7a, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x66,
+	0x6f, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x65, 0x72,
+	0x76, 0x61, 0x7a, 0x65, 0x2e, 0x57, 0x61, 0x7a, 0x6e, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x05, 0x66,
+	0x6f, 0x72, 0x6d, 0x73, 0x22, 0xa2, 0x03, 0x0a, 0x0a, 0x4e, 0x47, 0x72, 0x61, 0x6d, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x43, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4e, 0x47,
+	0x72, 0x61, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61,
+	0x7a, 0x65, 0x2e, 0x4e, 0x47, 0x72, 0x61, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x68,
+	0x61, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x1a, 0x3d, 0x0a, 0x0f, 0x57,
+	0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x68,
+	0x61, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x87, 0x02, 0x0a, 0x0c, 0x52, 0x68,
+	0x79, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x36,
+	0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x73, 0x4f,
+	0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70,
+	0x65, 0x65, 0x63, 0x68, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x73, 0x4f, 0x66, 0x53, 0x70, 0x65,
+	0x65, 0x63, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x79, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x79, 0x6c, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x69, 0x6e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x51, 0x0a, 0x0a, 0x52, 0x68, 0x79, 0x6d, 0x65, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x04,
+	0x72, 0x6f, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x6d, 0x0a, 0x0d, 0x52, 0x68, 0x79, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61,
+	0x7a, 0x65, 0x2e, 0x52, 0x68, 0x79, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x68, 0x79, 0x6d,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61,
+	0x7a, 0x65, 0x2e, 0x52, 0x68, 0x79, 0x6d, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x06, 0x72,
+	0x68, 0x79, 0x6d, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x73, 0x65, 0x53, 0x63,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xa2, 0x01,
+	0x0a, 0x0c, 0x41, 0x72, 0x75, 0x7a, 0x53, 0x79, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x09, 0x41, 0x72, 0x75, 0x7a, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x65, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x22, 0xce, 0x01, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x73, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x79, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
+	0x2e, 0x41, 0x72, 0x75, 0x7a, 0x53, 0x79, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x73,
+	0x79, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a,
+	0x65, 0x2e, 0x41, 0x72, 0x75, 0x7a, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x05, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f,
+	0x73, 0x74, 0x22, 0x26, 0x0a, 0x10, 0x50, 0x68, 0x6f, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xdf, 0x04, 0x0a, 0x11, 0x50,
+	0x68, 0x6f, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x79, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x79, 0x6c, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e,
+	0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x6e, 0x67, 0x56, 0x6f,
+	0x77, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x6e, 0x67,
+	0x56, 0x6f, 0x77, 0x65, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f,
+	0x77, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x56,
+	0x6f, 0x77, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e,
+	0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x65, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x6f, 0x77, 0x65, 0x6c,
+	0x12, 0x26, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x6f, 0x77,
+	0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x56, 0x6f, 0x77, 0x65, 0x6c, 0x48, 0x61, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x77, 0x65, 0x6c, 0x48, 0x61, 0x72, 0x64, 0x12, 0x2c,
+	0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x48,
+	0x61, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x74, 0x48, 0x61, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x11,
+	0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x68, 0x61,
+	0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0d, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x73,
+	0x12, 0x2c, 0x0a, 0x11, 0x68, 0x79, 0x70, 0x68, 0x65, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x05, 0x52, 0x11, 0x68, 0x79, 0x70,
+	0x68, 0x65, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x68, 0x79, 0x70, 0x68, 0x65, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x68, 0x79, 0x70, 0x68, 0x65, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x65, 0x18, 0x10, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x65, 0x2a, 0x60, 0x0a, 0x0a,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52,
+	0x45, 0x46, 0x49, 0x58, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x55, 0x5a, 0x5a, 0x59, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x55, 0x46, 0x46, 0x49, 0x58, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x41, 0x52, 0x49,
+	0x41, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x54, 0x54, 0x45, 0x52, 0x4e,
+	0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x10, 0x06, 0x2a, 0x57,
+	0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a,
+	0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x55, 0x52, 0x4b, 0x49,
+	0x53, 0x48, 0x5f, 0x4c, 0x41, 0x54, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x49,
+	0x53, 0x45, 0x4e, 0x43, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x41,
+	0x4e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x42, 0x4a, 0x41, 0x44, 0x10, 0x04, 0x12, 0x07,
+	0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x05, 0x2a, 0x40, 0x0a, 0x06, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x41, 0x4e, 0x5f, 0x54, 0x55, 0x52,
+	0x4b, 0x49, 0x53, 0x48, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x52, 0x53, 0x49, 0x41,
+	0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x52, 0x41, 0x42, 0x49, 0x43, 0x10, 0x02, 0x12,
+	0x08, 0x0a, 0x04, 0x55, 0x52, 0x44, 0x55, 0x10, 0x03, 0x2a, 0x27, 0x0a, 0x03, 0x52, 0x65, 0x71,
+	0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d,
+	0x41, 0x59, 0x42, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53,
+	0x10, 0x02, 0x2a, 0x33, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65,
+	0x63, 0x68, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x55, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x56, 0x45, 0x52, 0x42, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x55, 0x4e, 0x10, 0x02, 0x2a, 0x6d, 0x0a, 0x0b, 0x41, 0x6c, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x41, 0x4c, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f,
+	0x4e, 0x53, 0x4f, 0x4e, 0x41, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x46, 0x54, 0x45, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x4f, 0x57, 0x45, 0x4c, 0x5f, 0x45, 0x4c, 0x49,
+	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x45, 0x4d, 0x49, 0x4e, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x48, 0x41, 0x52, 0x44, 0x5f, 0x46,
+	0x49, 0x4e, 0x41, 0x4c, 0x10, 0x04, 0x2a, 0x3b, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x4c, 0x55, 0x52, 0x41, 0x4c, 0x5f, 0x4f, 0x46,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x49, 0x4e, 0x47, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x4f,
+	0x46, 0x10, 0x02, 0x2a, 0x2e, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x74,
+	0x72, 0x32, 0x6f, 0x74, 0x6d, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x6f, 0x74, 0x6d, 0x32, 0x74,
+	0x72, 0x10, 0x01, 0x2a, 0x3c, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x49, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x41, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x55, 0x45, 0x53, 0x53, 0x10,
+	0x02, 0x32, 0xef, 0x06, 0x0a, 0x07, 0x44, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x12, 0x3f, 0x0a,
+	0x0f, 0x56, 0x69, 0x73, 0x65, 0x6e, 0x63, 0x54, 0x6f, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e,
+	0x12, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d,
+	0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x1a, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
+	0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x0f, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x54, 0x6f, 0x56, 0x69, 0x73, 0x65, 0x6e,
+	0x63, 0x12, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f,
+	0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x1a, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a,
+	0x65, 0x2e, 0x4f, 0x74, 0x74, 0x6f, 0x6d, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x22, 0x00, 0x12,
+	0x39, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x16,
+	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
+	0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x74, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a,
+	0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x68, 0x79, 0x6d, 0x65, 0x73, 0x12, 0x15,
+	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x52, 0x68, 0x79, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e,
+	0x52, 0x68, 0x79, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x09, 0x53, 0x63, 0x61, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x64,
+	0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a,
+	0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x10, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x12, 0x19, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64,
+	0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x50, 0x68, 0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x12, 0x18, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61,
+	0x7a, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x12, 0x1a, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x70, 0x65, 0x6c, 0x6c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64,
+	0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x4f, 0x43, 0x52, 0x12, 0x13, 0x2e, 0x64, 0x65, 0x72, 0x76,
+	0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x43, 0x52, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x4f, 0x43, 0x52, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x57, 0x61, 0x7a, 0x6e, 0x12, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e,
+	0x57, 0x61, 0x7a, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x65,
+	0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x57, 0x61, 0x7a, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x57, 0x61, 0x7a, 0x6e, 0x12, 0x14, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2e, 0x57,
+	0x61, 0x7a, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x65, 0x72,
+	0x76, 0x61, 0x7a, 0x65, 0x2e, 0x57, 0x61, 0x7a, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x36, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x72, 0x76, 0x61,
+	0x7a, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x42, 0x0c, 0x44, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x0c, 0x64, 0x65, 0x72, 0x76, 0x61, 0x7a, 0x65, 0x2f,
+	0x6c, 0x61, 0x6e, 0x67, 0xa2, 0x02, 0x04, 0x44, 0x52, 0x56, 0x5a, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_lang_dervaze_proto_rawDescOnce sync.Once
+	file_lang_dervaze_proto_rawDescData = file_lang_dervaze_proto_rawDesc
+)
+
+func file_lang_dervaze_proto_rawDescGZIP() []byte {
+	file_lang_dervaze_proto_rawDescOnce.Do(func() {
+		file_lang_dervaze_proto_rawDescData = protoimpl.X.CompressGZIP(file_lang_dervaze_proto_rawDescData)
+	})
+	return file_lang_dervaze_proto_rawDescData
+}
+
+var file_lang_dervaze_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_lang_dervaze_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_lang_dervaze_proto_goTypes = []interface{}{
+	(SearchType)(0),             // 0: dervaze.SearchType
+	(SearchField)(0),            // 1: dervaze.SearchField
+	(Script)(0),                 // 2: dervaze.Script
+	(Req)(0),                    // 3: dervaze.Req
+	(PartOfSpeech)(0),           // 4: dervaze.PartOfSpeech
+	(Alternation)(0),            // 5: dervaze.Alternation
+	(RelationType)(0),           // 6: dervaze.RelationType
+	(TranslationDirection)(0),   // 7: dervaze.TranslationDirection
+	(CandidateSource)(0),        // 8: dervaze.CandidateSource
+	(*SearchRequest)(nil),       // 9: dervaze.SearchRequest
+	(*OttomanWord)(nil),         // 10: dervaze.OttomanWord
+	(*Root)(nil),                // 11: dervaze.Root
+	(*Relation)(nil),            // 12: dervaze.Relation
+	(*SearchResult)(nil),        // 13: dervaze.SearchResult
+	(*MatchSpan)(nil),           // 14: dervaze.MatchSpan
+	(*ScoreComponent)(nil),      // 15: dervaze.ScoreComponent
+	(*Match)(nil),               // 16: dervaze.Match
+	(*RootSet)(nil),             // 17: dervaze.RootSet
+	(*Suffix)(nil),              // 18: dervaze.Suffix
+	(*SuffixSet)(nil),           // 19: dervaze.SuffixSet
+	(*TranslateRequest)(nil),    // 20: dervaze.TranslateRequest
+	(*TranslationWord)(nil),     // 21: dervaze.TranslationWord
+	(*TranslationVariety)(nil),  // 22: dervaze.TranslationVariety
+	(*TranslationSentence)(nil), // 23: dervaze.TranslationSentence
+	(*TranslateResponse)(nil),   // 24: dervaze.TranslateResponse
+	(*CompletionRequest)(nil),   // 25: dervaze.CompletionRequest
+	(*CompletionResponse)(nil),  // 26: dervaze.CompletionResponse
+	(*PhoneticRequest)(nil),     // 27: dervaze.PhoneticRequest
+	(*PhoneticLetter)(nil),      // 28: dervaze.PhoneticLetter
+	(*PhoneticCandidate)(nil),   // 29: dervaze.PhoneticCandidate
+	(*PhoneticResponse)(nil),    // 30: dervaze.PhoneticResponse
+	(*SpellCheckRequest)(nil),   // 31: dervaze.SpellCheckRequest
+	(*SpellSuggestion)(nil),     // 32: dervaze.SpellSuggestion
+	(*Misspelling)(nil),         // 33: dervaze.Misspelling
+	(*SpellCheckResponse)(nil),  // 34: dervaze.SpellCheckResponse
+	(*OCRToken)(nil),            // 35: dervaze.OCRToken
+	(*OCRRequest)(nil),          // 36: dervaze.OCRRequest
+	(*OCRCorrection)(nil),       // 37: dervaze.OCRCorrection
+	(*OCRResponse)(nil),         // 38: dervaze.OCRResponse
+	(*WaznRequest)(nil),         // 39: dervaze.WaznRequest
+	(*WaznForm)(nil),            // 40: dervaze.WaznForm
+	(*WaznResponse)(nil),        // 41: dervaze.WaznResponse
+	(*NGramModel)(nil),          // 42: dervaze.NGramModel
+	(*RhymeRequest)(nil),        // 43: dervaze.RhymeRequest
+	(*RhymeMatch)(nil),          // 44: dervaze.RhymeMatch
+	(*RhymeResponse)(nil),       // 45: dervaze.RhymeResponse
+	(*VerseScanRequest)(nil),    // 46: dervaze.VerseScanRequest
+	(*AruzSyllable)(nil),        // 47: dervaze.AruzSyllable
+	(*AruzMeter)(nil),           // 48: dervaze.AruzMeter
+	(*VerseScan)(nil),           // 49: dervaze.VerseScan
+	(*PhonologyRequest)(nil),    // 50: dervaze.PhonologyRequest
+	(*PhonologyAnalysis)(nil),   // 51: dervaze.PhonologyAnalysis
+	nil,                         // 52: dervaze.NGramModel.WordCountsEntry
+	nil,                         // 53: dervaze.NGramModel.CharCountsEntry
+}
+var file_lang_dervaze_proto_depIdxs = []int32{
+	1,  // 0: dervaze.SearchRequest.searchField:type_name -> dervaze.SearchField
+	0,  // 1: dervaze.SearchRequest.searchType:type_name -> dervaze.SearchType
+	2,  // 2: dervaze.SearchRequest.script:type_name -> dervaze.Script
+	2,  // 3: dervaze.OttomanWord.script:type_name -> dervaze.Script
+	10, // 4: dervaze.Root.ottoman:type_name -> dervaze.OttomanWord
+	4,  // 5: dervaze.Root.partOfSpeech:type_name -> dervaze.PartOfSpeech
+	5,  // 6: dervaze.Root.alternation:type_name -> dervaze.Alternation
+	12, // 7: dervaze.Root.relations:type_name -> dervaze.Relation
+	6,  // 8: dervaze.Relation.type:type_name -> dervaze.RelationType
+	11, // 9: dervaze.SearchResult.root:type_name -> dervaze.Root
+	18, // 10: dervaze.SearchResult.suffixes:type_name -> dervaze.Suffix
+	1,  // 11: dervaze.SearchResult.field:type_name -> dervaze.SearchField
+	16, // 12: dervaze.SearchResult.match:type_name -> dervaze.Match
+	12, // 13: dervaze.SearchResult.relation:type_name -> dervaze.Relation
+	14, // 14: dervaze.Match.turkishLatinSpans:type_name -> dervaze.MatchSpan
+	14, // 15: dervaze.Match.unicodeSpans:type_name -> dervaze.MatchSpan
+	14, // 16: dervaze.Match.visencSpans:type_name -> dervaze.MatchSpan
+	1,  // 17: dervaze.Match.field:type_name -> dervaze.SearchField
+	0,  // 18: dervaze.Match.type:type_name -> dervaze.SearchType
+	15, // 19: dervaze.Match.scores:type_name -> dervaze.ScoreComponent
+	11, // 20: dervaze.RootSet.roots:type_name -> dervaze.Root
+	13, // 21: dervaze.RootSet.results:type_name -> dervaze.SearchResult
+	10, // 22: dervaze.Suffix.ottoman:type_name -> dervaze.OttomanWord
+	4,  // 23: dervaze.Suffix.requiresPOS:type_name -> dervaze.PartOfSpeech
+	3,  // 24: dervaze.Suffix.requiresEndsWithVowel:type_name -> dervaze.Req
+	3,  // 25: dervaze.Suffix.requiresHasSingleVowel:type_name -> dervaze.Req
+	3,  // 26: dervaze.Suffix.requiresLastConsonantHard:type_name -> dervaze.Req
+	3,  // 27: dervaze.Suffix.requiresContinuationSuffix:type_name -> dervaze.Req
+	4,  // 28: dervaze.Suffix.convertsPOSto:type_name -> dervaze.PartOfSpeech
+	18, // 29: dervaze.SuffixSet.suffixes:type_name -> dervaze.Suffix
+	11, // 30: dervaze.TranslationWord.root:type_name -> dervaze.Root
+	18, // 31: dervaze.TranslationWord.suffixes:type_name -> dervaze.Suffix
+	7,  // 32: dervaze.TranslationWord.direction:type_name -> dervaze.TranslationDirection
+	10, // 33: dervaze.TranslationWord.ottomanRemaining:type_name -> dervaze.OttomanWord
+	21, // 34: dervaze.TranslationVariety.varieties:type_name -> dervaze.TranslationWord
+	7,  // 35: dervaze.TranslationVariety.direction:type_name -> dervaze.TranslationDirection
+	22, // 36: dervaze.TranslationSentence.words:type_name -> dervaze.TranslationVariety
+	7,  // 37: dervaze.TranslationSentence.direction:type_name -> dervaze.TranslationDirection
+	20, // 38: dervaze.TranslateResponse.request:type_name -> dervaze.TranslateRequest
+	23, // 39: dervaze.TranslateResponse.sentences:type_name -> dervaze.TranslationSentence
+	1,  // 40: dervaze.CompletionRequest.searchField:type_name -> dervaze.SearchField
+	2,  // 41: dervaze.CompletionRequest.script:type_name -> dervaze.Script
+	11, // 42: dervaze.CompletionResponse.roots:type_name -> dervaze.Root
+	2,  // 43: dervaze.PhoneticRequest.script:type_name -> dervaze.Script
+	10, // 44: dervaze.PhoneticCandidate.ottoman:type_name -> dervaze.OttomanWord
+	8,  // 45: dervaze.PhoneticCandidate.source:type_name -> dervaze.CandidateSource
+	11, // 46: dervaze.PhoneticCandidate.root:type_name -> dervaze.Root
+	28, // 47: dervaze.PhoneticCandidate.letters:type_name -> dervaze.PhoneticLetter
+	29, // 48: dervaze.PhoneticResponse.candidates:type_name -> dervaze.PhoneticCandidate
+	2,  // 49: dervaze.SpellCheckRequest.script:type_name -> dervaze.Script
+	10, // 50: dervaze.SpellSuggestion.ottoman:type_name -> dervaze.OttomanWord
+	11, // 51: dervaze.SpellSuggestion.root:type_name -> dervaze.Root
+	32, // 52: dervaze.Misspelling.suggestions:type_name -> dervaze.SpellSuggestion
+	33, // 53: dervaze.SpellCheckResponse.misspellings:type_name -> dervaze.Misspelling
+	35, // 54: dervaze.OCRRequest.tokens:type_name -> dervaze.OCRToken
+	2,  // 55: dervaze.OCRRequest.script:type_name -> dervaze.Script
+	32, // 56: dervaze.OCRCorrection.candidates:type_name -> dervaze.SpellSuggestion
+	37, // 57: dervaze.OCRResponse.corrections:type_name -> dervaze.OCRCorrection
+	2,  // 58: dervaze.WaznRequest.script:type_name -> dervaze.Script
+	10, // 59: dervaze.WaznForm.ottoman:type_name -> dervaze.OttomanWord
+	11, // 60: dervaze.WaznForm.roots:type_name -> dervaze.Root
+	40, // 61: dervaze.WaznResponse.forms:type_name -> dervaze.WaznForm
+	52, // 62: dervaze.NGramModel.wordCounts:type_name -> dervaze.NGramModel.WordCountsEntry
+	53, // 63: dervaze.NGramModel.charCounts:type_name -> dervaze.NGramModel.CharCountsEntry
+	1,  // 64: dervaze.RhymeRequest.searchField:type_name -> dervaze.SearchField
+	4,  // 65: dervaze.RhymeRequest.partsOfSpeech:type_name -> dervaze.PartOfSpeech
+	11, // 66: dervaze.RhymeMatch.root:type_name -> dervaze.Root
+	43, // 67: dervaze.RhymeResponse.request:type_name -> dervaze.RhymeRequest
+	44, // 68: dervaze.RhymeResponse.rhymes:type_name -> dervaze.RhymeMatch
+	47, // 69: dervaze.VerseScan.syllables:type_name -> dervaze.AruzSyllable
+	48, // 70: dervaze.VerseScan.meter:type_name -> dervaze.AruzMeter
+	10, // 71: dervaze.Dervaze.VisencToOttoman:input_type -> dervaze.OttomanWord
+	10, // 72: dervaze.Dervaze.OttomanToVisenc:input_type -> dervaze.OttomanWord
+	9,  // 73: dervaze.Dervaze.SearchRoots:input_type -> dervaze.SearchRequest
+	20, // 74: dervaze.Dervaze.Translate:input_type -> dervaze.TranslateRequest
+	43, // 75: dervaze.Dervaze.FindRhymes:input_type -> dervaze.RhymeRequest
+	46, // 76: dervaze.Dervaze.ScanVerse:input_type -> dervaze.VerseScanRequest
+	50, // 77: dervaze.Dervaze.AnalyzePhonology:input_type -> dervaze.PhonologyRequest
+	25, // 78: dervaze.Dervaze.Complete:input_type -> dervaze.CompletionRequest
+	27, // 79: dervaze.Dervaze.ConvertPhonetic:input_type -> dervaze.PhoneticRequest
+	31, // 80: dervaze.Dervaze.CheckSpelling:input_type -> dervaze.SpellCheckRequest
+	36, // 81: dervaze.Dervaze.CorrectOCR:input_type -> dervaze.OCRRequest
+	39, // 82: dervaze.Dervaze.AnalyzeWazn:input_type -> dervaze.WaznRequest
+	39, // 83: dervaze.Dervaze.GenerateWazn:input_type -> dervaze.WaznRequest
+	10, // 84: dervaze.Dervaze.VisencToOttoman:output_type -> dervaze.OttomanWord
+	10, // 85: dervaze.Dervaze.OttomanToVisenc:output_type -> dervaze.OttomanWord
+	17, // 86: dervaze.Dervaze.SearchRoots:output_type -> dervaze.RootSet
+	24, // 87: dervaze.Dervaze.Translate:output_type -> dervaze.TranslateResponse
+	45, // 88: dervaze.Dervaze.FindRhymes:output_type -> dervaze.RhymeResponse
+	49, // 89: dervaze.Dervaze.ScanVerse:output_type -> dervaze.VerseScan
+	51, // 90: dervaze.Dervaze.AnalyzePhonology:output_type -> dervaze.PhonologyAnalysis
+	26, // 91: dervaze.Dervaze.Complete:output_type -> dervaze.CompletionResponse
+	30, // 92: dervaze.Dervaze.ConvertPhonetic:output_type -> dervaze.PhoneticResponse
+	34, // 93: dervaze.Dervaze.CheckSpelling:output_type -> dervaze.SpellCheckResponse
+	38, // 94: dervaze.Dervaze.CorrectOCR:output_type -> dervaze.OCRResponse
+	41, // 95: dervaze.Dervaze.AnalyzeWazn:output_type -> dervaze.WaznResponse
+	41, // 96: dervaze.Dervaze.GenerateWazn:output_type -> dervaze.WaznResponse
+	84, // [84:97] is the sub-list for method output_type
+	71, // [71:84] is the sub-list for method input_type
+	71, // [71:71] is the sub-list for extension type_name
+	71, // [71:71] is the sub-list for extension extendee
+	0,  // [0:71] is the sub-list for field type_name
+}
+
+func init() { file_lang_dervaze_proto_init() }
+func file_lang_dervaze_proto_init() {
+	if File_lang_dervaze_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_lang_dervaze_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OttomanWord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Root); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Relation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchSpan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RootSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Suffix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuffixSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslationWord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslationVariety); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslationSentence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1464,8 +4871,8 @@ func file_lang_dervaze_proto_init() {
 				return nil
 			}
 		}
-		file_lang_dervaze_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OttomanWord); i {
+		file_lang_dervaze_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhoneticRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1476,8 +4883,8 @@ func file_lang_dervaze_proto_init() {
 				return nil
 			}
 		}
-		file_lang_dervaze_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Root); i {
+		file_lang_dervaze_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhoneticLetter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1488,8 +4895,8 @@ func file_lang_dervaze_proto_init() {
 				return nil
 			}
 		}
-		file_lang_dervaze_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RootSet); i {
+		file_lang_dervaze_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhoneticCandidate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1500,8 +4907,8 @@ func file_lang_dervaze_proto_init() {
 				return nil
 			}
 		}
-		file_lang_dervaze_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Suffix); i {
+		file_lang_dervaze_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhoneticResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1512,8 +4919,8 @@ func file_lang_dervaze_proto_init() {
 				return nil
 			}
 		}
-		file_lang_dervaze_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuffixSet); i {
+		file_lang_dervaze_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpellCheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1524,8 +4931,8 @@ func file_lang_dervaze_proto_init() {
 				return nil
 			}
 		}
-		file_lang_dervaze_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslateRequest); i {
+		file_lang_dervaze_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpellSuggestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1536,8 +4943,8 @@ func file_lang_dervaze_proto_init() {
 				return nil
 			}
 		}
-		file_lang_dervaze_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslationWord); i {
+		file_lang_dervaze_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Misspelling); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1548,8 +4955,8 @@ func file_lang_dervaze_proto_init() {
 				return nil
 			}
 		}
-		file_lang_dervaze_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslationVariety); i {
+		file_lang_dervaze_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpellCheckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1560,8 +4967,8 @@ func file_lang_dervaze_proto_init() {
 				return nil
 			}
 		}
-		file_lang_dervaze_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslationSentence); i {
+		file_lang_dervaze_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCRToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1572,8 +4979,188 @@ func file_lang_dervaze_proto_init() {
 				return nil
 			}
 		}
-		file_lang_dervaze_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslateResponse); i {
+		file_lang_dervaze_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCRRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCRCorrection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCRResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaznRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaznForm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaznResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NGramModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RhymeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RhymeMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RhymeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerseScanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AruzSyllable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AruzMeter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerseScan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhonologyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lang_dervaze_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhonologyAnalysis); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1585,7 +5172,7 @@ func file_lang_dervaze_proto_init() {
 			}
 		}
 	}
-	file_lang_dervaze_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_lang_dervaze_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*TranslateRequest_TurkishLatin)(nil),
 		(*TranslateRequest_Visenc)(nil),
 		(*TranslateRequest_Ottoman)(nil),
@@ -1595,8 +5182,8 @@ func file_lang_dervaze_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lang_dervaze_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   11,
+			NumEnums:      9,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // DervazeServerImpl implementation
@@ -21,19 +23,42 @@ func (DervazeServerImpl) mustEmbedUnimplementedDervazeServer() {}
 // VisencToOttoman converts a visenc string to Ottoman unicode
 func (DervazeServerImpl) VisencToOttoman(ctx context.Context, in *OttomanWord) (*OttomanWord, error) {
 
-	out, err := MakeOttomanWord(in.Visenc, "")
+	out, err := GetScriptProfile(in.Script).MakeOttomanWord(in.Visenc, "")
 	return out, err
 
 }
 
 // OttomanToVisenc converts a Unicode string to Visenc
 func (DervazeServerImpl) OttomanToVisenc(ctx context.Context, in *OttomanWord) (*OttomanWord, error) {
-	out, err := MakeOttomanWord("", in.Unicode)
+	out, err := GetScriptProfile(in.Script).MakeOttomanWord("", in.Unicode)
 	return out, err
 }
 
-// SearchRoots makes a search with various fields and types and returns a Rootset described by the result
+// SearchRoots makes a search with various fields and types and returns a Rootset described by the result.
+// Ottoman search strings and spellings in the results are written in the requested script.
+// When explain is requested, each result carries its match spans and how it's found.
 func (DervazeServerImpl) SearchRoots(ctx context.Context, in *SearchRequest) (*RootSet, error) {
+	profile := GetScriptProfile(in.Script)
+
+	// regular expressions are matched as they are written, patterns are compiled with the letters of the script
+	searchesArabic := in.SearchField == SearchField_AUTO || in.SearchField == SearchField_ALL
+	if in.SearchType != SearchType_REGEX && in.SearchType != SearchType_PATTERN && (in.SearchField == SearchField_OTTOMAN || (searchesArabic && ContainsArabicChars(in.SearchString))) {
+		in = proto.Clone(in).(*SearchRequest)
+		in.SearchString = profile.OttomanUnicode(in.SearchString)
+	}
+
+	rs, err := searchRoots(in)
+	if rs != nil && in.Explain {
+		ExplainResults(in, rs)
+	}
+	if rs != nil {
+		rs.Roots = profile.LocalizeRoots(rs.Roots)
+		rs.Results = profile.LocalizeResults(rs.Results)
+	}
+	return rs, err
+}
+
+func searchRoots(in *SearchRequest) (*RootSet, error) {
 
 	var rootList []*Root
 	var err error = nil
@@ -42,6 +67,65 @@ func (DervazeServerImpl) SearchRoots(ctx context.Context, in *SearchRequest) (*R
 	searchString := in.SearchString
 	maxLen := int(in.ResultLimit)
 
+	// a query is used instead of the field and the string
+	if in.Query != "" {
+		if maxLen <= 0 {
+			maxLen = MAXRESULTLEN
+		}
+		q, err := GetScriptProfile(in.Script).ParseQuery(in.Query)
+		if err != nil {
+			return &RootSet{}, err
+		}
+		rs := RootSet{Roots: QuerySearch(q, maxLen)}
+		return &rs, nil
+	}
+
+	if searchField == SearchField_ALL {
+		return searchAllFields(in)
+	}
+
+	if in.Stem {
+		if maxLen <= 0 {
+			maxLen = MAXRESULTLEN
+		}
+
+		var results []*SearchResult
+		switch searchField {
+		case SearchField_AUTO:
+			results = LemmatizeAuto(searchString, maxLen)
+		case SearchField_OTTOMAN:
+			results = LemmatizeUnicode(searchString, maxLen)
+		case SearchField_TURKISH_LATIN:
+			results = LemmatizeTurkishLatin(searchString, maxLen)
+		case SearchField_VISENC:
+			results = LemmatizeVisenc(searchString, maxLen)
+		case SearchField_ABJAD:
+			err = fmt.Errorf("Stemming is not supported for abjad")
+		}
+
+		rs := RootSet{Roots: ResultRoots(results), Results: results}
+		return &rs, err
+	}
+
+	if in.SearchType == SearchType_VARIANT {
+		if maxLen <= 0 {
+			maxLen = MAXRESULTLEN
+		}
+
+		var results []*SearchResult
+		switch searchField {
+		case SearchField_AUTO, SearchField_OTTOMAN:
+			results = VariantSearchUnicode(searchString, maxLen)
+		case SearchField_VISENC:
+			results = VariantSearchVisenc(searchString, maxLen)
+		default:
+			err = fmt.Errorf("Variant search is only supported for Ottoman words")
+		}
+
+		rs := RootSet{Roots: ResultRoots(results), Results: results}
+		return &rs, err
+	}
+
 	switch in.SearchType {
 	case SearchType_FUZZY:
 		switch searchField {
@@ -102,6 +186,46 @@ func (DervazeServerImpl) SearchRoots(ctx context.Context, in *SearchRequest) (*R
 			}
 		}
 
+	case SearchType_PATTERN:
+
+		switch searchField {
+		case SearchField_AUTO, SearchField_OTTOMAN, SearchField_VISENC:
+			if lp, e := GetScriptProfile(in.Script).CompilePattern(searchString); e == nil {
+				rootList = PatternSearch(lp, maxLen)
+			} else {
+				err = e
+			}
+		default:
+			err = fmt.Errorf("Pattern search is only supported for Ottoman words")
+		}
+
+	case SearchType_SUFFIX:
+
+		switch searchField {
+		case SearchField_AUTO:
+			rootList = SuffixSearchAuto(searchString, maxLen)
+		case SearchField_OTTOMAN:
+			rootList = SuffixSearchUnicode(searchString, maxLen)
+		case SearchField_TURKISH_LATIN:
+			rootList = SuffixSearchTurkishLatin(searchString, maxLen)
+		case SearchField_VISENC:
+			rootList = SuffixSearchVisenc(searchString, maxLen)
+		case SearchField_ABJAD:
+			err = fmt.Errorf("Suffix search is not supported for abjad")
+		}
+
+	case SearchType_FAMILY:
+		if maxLen <= 0 {
+			maxLen = MAXRESULTLEN
+		}
+
+		switch searchField {
+		case SearchField_ABJAD:
+			err = fmt.Errorf("Family search is not supported for abjad")
+		default:
+			rootList = FamilySearch(searchString, maxLen)
+		}
+
 	}
 
 	rs := RootSet{Roots: rootList}
@@ -109,7 +233,147 @@ func (DervazeServerImpl) SearchRoots(ctx context.Context, in *SearchRequest) (*R
 
 }
 
-// Translate returns the translation of an Ottoman or Turkish latin sentence
+// searchAllFields searches the string in every field it may be written in, fields with higher ScoreFields scores first.
+// Each result is labelled with the field it's found in, roots found in more than one field are returned once.
+func searchAllFields(in *SearchRequest) (*RootSet, error) {
+	detected := in.SearchString
+	if in.SearchType == SearchType_REGEX {
+		if searchRegex, err := regexp.Compile(in.SearchString); err == nil {
+			detected = RegexLiterals(searchRegex)
+		}
+	}
+
+	results := make([]*SearchResult, 0)
+	seen := make(map[string]bool)
+	var lastErr error
+
+	for _, fs := range ScoreFields(detected) {
+		if fs.Score == 0 {
+			continue
+		}
+
+		req := proto.Clone(in).(*SearchRequest)
+		req.SearchField = fs.Field
+		rs, err := searchRoots(req)
+		// the search type may not be supported for the field, e.g. suffix search for abjad
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		fieldResults := rs.Results
+		if len(fieldResults) == 0 {
+			for _, r := range rs.Roots {
+				fieldResults = append(fieldResults, &SearchResult{Root: r})
+			}
+		}
+
+		for _, sr := range fieldResults {
+			k := sr.Root.TurkishLatin + sr.Root.Ottoman.GetUnicode()
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			results = append(results, &SearchResult{Root: sr.Root, Suffixes: sr.Suffixes, Variants: sr.Variants, Field: fs.Field, Relation: sr.Relation})
+		}
+	}
+
+	if len(results) == 0 && lastErr != nil {
+		return &RootSet{}, lastErr
+	}
+
+	if maxLen := int(in.ResultLimit); maxLen > 0 && maxLen < len(results) {
+		results = results[:maxLen]
+	}
+
+	rs := RootSet{Roots: ResultRoots(results), Results: results}
+	return &rs, nil
+}
+
+// Complete answers the partial inputs sent on a stream with their completions.
+// Inputs arriving while a previous input is being answered replace each other, only the latest is answered.
+func (DervazeServerImpl) Complete(stream Dervaze_CompleteServer) error {
+	return completionStream(stream.Recv, stream.Send)
+}
+
+// ConvertPhonetic returns Ottoman spellings of a word typed in Latin letters as it's pronounced.
+// Dictionary words come first, words not in the dictionary are spelled by phonetic rules.
+func (DervazeServerImpl) ConvertPhonetic(ctx context.Context, in *PhoneticRequest) (*PhoneticResponse, error) {
+	return ConvertPhonetic(in), nil
+}
+
+// CheckSpelling returns the words of an Ottoman text not found in the dictionary with their byte offsets.
+// Suggestions are dictionary words a few letter edits away, with the suffixes of the misspelled word.
+func (DervazeServerImpl) CheckSpelling(ctx context.Context, in *SpellCheckRequest) (*SpellCheckResponse, error) {
+	return CheckSpelling(in), nil
+}
+
+// CorrectOCR returns the dictionary words OCR may have read as each token, scored by the OCR confusion matrix.
+// Character confidences of the tokens make their edits cheaper.
+func (DervazeServerImpl) CorrectOCR(ctx context.Context, in *OCRRequest) (*OCRResponse, error) {
+	return CorrectOCR(in), nil
+}
+
+// AnalyzeWazn returns the Arabic patterns a word follows with the roots it's derived from, the best first.
+// Each form is the word without the article and endings, with the dictionary roots spelled as it.
+func (DervazeServerImpl) AnalyzeWazn(ctx context.Context, in *WaznRequest) (*WaznResponse, error) {
+	return AnalyzeWazn(in), nil
+}
+
+// GenerateWazn writes an Arabic root in a pattern, or in every pattern, and finds the forms in the dictionary
+func (DervazeServerImpl) GenerateWazn(ctx context.Context, in *WaznRequest) (*WaznResponse, error) {
+	return GenerateWazn(in)
+}
+
+// Translate returns the readings of every word in an Ottoman or Turkish latin text.
+// Ottoman readings are ranked by the language model in the context of their sentence.
 func (DervazeServerImpl) Translate(ctx context.Context, in *TranslateRequest) (*TranslateResponse, error) {
-	return nil, fmt.Errorf("Not Implemented")
+	var sentences []*TranslationSentence
+
+	switch r := in.R.(type) {
+	case *TranslateRequest_Ottoman:
+		sentences = TranslateOttoman(r.Ottoman)
+	case *TranslateRequest_Visenc:
+		sentences = TranslateOttoman(VisencToUnicode(r.Visenc))
+	case *TranslateRequest_TurkishLatin:
+		sentences = TranslateTurkishLatin(r.TurkishLatin)
+	default:
+		return nil, fmt.Errorf("Need a text to translate")
+	}
+
+	return &TranslateResponse{Request: in, Sentences: sentences}, nil
+}
+
+// FindRhymes returns roots sharing the ending of the requested word, ranked by the length of the match
+func (DervazeServerImpl) FindRhymes(ctx context.Context, in *RhymeRequest) (*RhymeResponse, error) {
+	if in.Word == "" {
+		return nil, fmt.Errorf("Need a word to find rhymes")
+	}
+	if in.SearchField == SearchField_ABJAD {
+		return nil, fmt.Errorf("Rhymes are not supported for abjad")
+	}
+
+	maxLen := int(in.ResultLimit)
+	if maxLen <= 0 {
+		maxLen = MAXRESULTLEN
+	}
+
+	rhymes := FindRhymes(in.Word, in.SearchField, in.PartsOfSpeech, int(in.SyllableCount), int(in.MinMatchLength), maxLen)
+	return &RhymeResponse{Request: in, Rhymes: rhymes}, nil
+}
+
+// ScanVerse returns the aruz scansion of a verse line with the best fitting meter
+func (DervazeServerImpl) ScanVerse(ctx context.Context, in *VerseScanRequest) (*VerseScan, error) {
+	if in.Line == "" {
+		return nil, fmt.Errorf("Need a verse line to scan")
+	}
+	return ScanVerse(in.Line), nil
+}
+
+// AnalyzePhonology returns syllables, vowel harmony and phonological features of any Turkish Latin word
+func (DervazeServerImpl) AnalyzePhonology(ctx context.Context, in *PhonologyRequest) (*PhonologyAnalysis, error) {
+	if in.Word == "" {
+		return nil, fmt.Errorf("Need a word to analyze")
+	}
+	return AnalyzePhonology(in.Word), nil
 }
@@ -21,6 +21,19 @@ type DervazeClient interface {
 	OttomanToVisenc(ctx context.Context, in *OttomanWord, opts ...grpc.CallOption) (*OttomanWord, error)
 	SearchRoots(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*RootSet, error)
 	Translate(ctx context.Context, in *TranslateRequest, opts ...grpc.CallOption) (*TranslateResponse, error)
+	FindRhymes(ctx context.Context, in *RhymeRequest, opts ...grpc.CallOption) (*RhymeResponse, error)
+	ScanVerse(ctx context.Context, in *VerseScanRequest, opts ...grpc.CallOption) (*VerseScan, error)
+	AnalyzePhonology(ctx context.Context, in *PhonologyRequest, opts ...grpc.CallOption) (*PhonologyAnalysis, error)
+	// Complete answers each partial input with its prefix completions, inputs arriving before the previous is answered are dropped
+	Complete(ctx context.Context, opts ...grpc.CallOption) (Dervaze_CompleteClient, error)
+	// ConvertPhonetic returns Ottoman spellings of a word typed phonetically in Latin letters
+	ConvertPhonetic(ctx context.Context, in *PhoneticRequest, opts ...grpc.CallOption) (*PhoneticResponse, error)
+	// CheckSpelling returns the words of an Ottoman text not found in the dictionary with suggested corrections
+	CheckSpelling(ctx context.Context, in *SpellCheckRequest, opts ...grpc.CallOption) (*SpellCheckResponse, error)
+	CorrectOCR(ctx context.Context, in *OCRRequest, opts ...grpc.CallOption) (*OCRResponse, error)
+	// AnalyzeWazn returns the Arabic patterns a word follows with its root, GenerateWazn applies patterns to a root
+	AnalyzeWazn(ctx context.Context, in *WaznRequest, opts ...grpc.CallOption) (*WaznResponse, error)
+	GenerateWazn(ctx context.Context, in *WaznRequest, opts ...grpc.CallOption) (*WaznResponse, error)
 }
 
 type dervazeClient struct {
@@ -67,6 +80,109 @@ func (c *dervazeClient) Translate(ctx context.Context, in *TranslateRequest, opt
 	return out, nil
 }
 
+func (c *dervazeClient) FindRhymes(ctx context.Context, in *RhymeRequest, opts ...grpc.CallOption) (*RhymeResponse, error) {
+	out := new(RhymeResponse)
+	err := c.cc.Invoke(ctx, "/dervaze.Dervaze/FindRhymes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dervazeClient) ScanVerse(ctx context.Context, in *VerseScanRequest, opts ...grpc.CallOption) (*VerseScan, error) {
+	out := new(VerseScan)
+	err := c.cc.Invoke(ctx, "/dervaze.Dervaze/ScanVerse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dervazeClient) AnalyzePhonology(ctx context.Context, in *PhonologyRequest, opts ...grpc.CallOption) (*PhonologyAnalysis, error) {
+	out := new(PhonologyAnalysis)
+	err := c.cc.Invoke(ctx, "/dervaze.Dervaze/AnalyzePhonology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dervazeClient) Complete(ctx context.Context, opts ...grpc.CallOption) (Dervaze_CompleteClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dervaze_serviceDesc.Streams[0], "/dervaze.Dervaze/Complete", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dervazeCompleteClient{stream}
+	return x, nil
+}
+
+type Dervaze_CompleteClient interface {
+	Send(*CompletionRequest) error
+	Recv() (*CompletionResponse, error)
+	grpc.ClientStream
+}
+
+type dervazeCompleteClient struct {
+	grpc.ClientStream
+}
+
+func (x *dervazeCompleteClient) Send(m *CompletionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dervazeCompleteClient) Recv() (*CompletionResponse, error) {
+	m := new(CompletionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dervazeClient) ConvertPhonetic(ctx context.Context, in *PhoneticRequest, opts ...grpc.CallOption) (*PhoneticResponse, error) {
+	out := new(PhoneticResponse)
+	err := c.cc.Invoke(ctx, "/dervaze.Dervaze/ConvertPhonetic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dervazeClient) CheckSpelling(ctx context.Context, in *SpellCheckRequest, opts ...grpc.CallOption) (*SpellCheckResponse, error) {
+	out := new(SpellCheckResponse)
+	err := c.cc.Invoke(ctx, "/dervaze.Dervaze/CheckSpelling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dervazeClient) CorrectOCR(ctx context.Context, in *OCRRequest, opts ...grpc.CallOption) (*OCRResponse, error) {
+	out := new(OCRResponse)
+	err := c.cc.Invoke(ctx, "/dervaze.Dervaze/CorrectOCR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dervazeClient) AnalyzeWazn(ctx context.Context, in *WaznRequest, opts ...grpc.CallOption) (*WaznResponse, error) {
+	out := new(WaznResponse)
+	err := c.cc.Invoke(ctx, "/dervaze.Dervaze/AnalyzeWazn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dervazeClient) GenerateWazn(ctx context.Context, in *WaznRequest, opts ...grpc.CallOption) (*WaznResponse, error) {
+	out := new(WaznResponse)
+	err := c.cc.Invoke(ctx, "/dervaze.Dervaze/GenerateWazn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DervazeServer is the server API for Dervaze service.
 // All implementations must embed UnimplementedDervazeServer
 // for forward compatibility
@@ -75,6 +191,19 @@ type DervazeServer interface {
 	OttomanToVisenc(context.Context, *OttomanWord) (*OttomanWord, error)
 	SearchRoots(context.Context, *SearchRequest) (*RootSet, error)
 	Translate(context.Context, *TranslateRequest) (*TranslateResponse, error)
+	FindRhymes(context.Context, *RhymeRequest) (*RhymeResponse, error)
+	ScanVerse(context.Context, *VerseScanRequest) (*VerseScan, error)
+	AnalyzePhonology(context.Context, *PhonologyRequest) (*PhonologyAnalysis, error)
+	// Complete answers each partial input with its prefix completions, inputs arriving before the previous is answered are dropped
+	Complete(Dervaze_CompleteServer) error
+	// ConvertPhonetic returns Ottoman spellings of a word typed phonetically in Latin letters
+	ConvertPhonetic(context.Context, *PhoneticRequest) (*PhoneticResponse, error)
+	// CheckSpelling returns the words of an Ottoman text not found in the dictionary with suggested corrections
+	CheckSpelling(context.Context, *SpellCheckRequest) (*SpellCheckResponse, error)
+	CorrectOCR(context.Context, *OCRRequest) (*OCRResponse, error)
+	// AnalyzeWazn returns the Arabic patterns a word follows with its root, GenerateWazn applies patterns to a root
+	AnalyzeWazn(context.Context, *WaznRequest) (*WaznResponse, error)
+	GenerateWazn(context.Context, *WaznRequest) (*WaznResponse, error)
 	mustEmbedUnimplementedDervazeServer()
 }
 
@@ -94,6 +223,33 @@ func (UnimplementedDervazeServer) SearchRoots(context.Context, *SearchRequest) (
 func (UnimplementedDervazeServer) Translate(context.Context, *TranslateRequest) (*TranslateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Translate not implemented")
 }
+func (UnimplementedDervazeServer) FindRhymes(context.Context, *RhymeRequest) (*RhymeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRhymes not implemented")
+}
+func (UnimplementedDervazeServer) ScanVerse(context.Context, *VerseScanRequest) (*VerseScan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanVerse not implemented")
+}
+func (UnimplementedDervazeServer) AnalyzePhonology(context.Context, *PhonologyRequest) (*PhonologyAnalysis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzePhonology not implemented")
+}
+func (UnimplementedDervazeServer) Complete(Dervaze_CompleteServer) error {
+	return status.Errorf(codes.Unimplemented, "method Complete not implemented")
+}
+func (UnimplementedDervazeServer) ConvertPhonetic(context.Context, *PhoneticRequest) (*PhoneticResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertPhonetic not implemented")
+}
+func (UnimplementedDervazeServer) CheckSpelling(context.Context, *SpellCheckRequest) (*SpellCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSpelling not implemented")
+}
+func (UnimplementedDervazeServer) CorrectOCR(context.Context, *OCRRequest) (*OCRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CorrectOCR not implemented")
+}
+func (UnimplementedDervazeServer) AnalyzeWazn(context.Context, *WaznRequest) (*WaznResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeWazn not implemented")
+}
+func (UnimplementedDervazeServer) GenerateWazn(context.Context, *WaznRequest) (*WaznResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateWazn not implemented")
+}
 func (UnimplementedDervazeServer) mustEmbedUnimplementedDervazeServer() {}
 
 // UnsafeDervazeServer may be embedded to opt out of forward compatibility for this service.
@@ -179,6 +335,176 @@ func _Dervaze_Translate_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dervaze_FindRhymes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RhymeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DervazeServer).FindRhymes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dervaze.Dervaze/FindRhymes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DervazeServer).FindRhymes(ctx, req.(*RhymeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dervaze_ScanVerse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerseScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DervazeServer).ScanVerse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dervaze.Dervaze/ScanVerse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DervazeServer).ScanVerse(ctx, req.(*VerseScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dervaze_AnalyzePhonology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PhonologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DervazeServer).AnalyzePhonology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dervaze.Dervaze/AnalyzePhonology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DervazeServer).AnalyzePhonology(ctx, req.(*PhonologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dervaze_Complete_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DervazeServer).Complete(&dervazeCompleteServer{stream})
+}
+
+type Dervaze_CompleteServer interface {
+	Send(*CompletionResponse) error
+	Recv() (*CompletionRequest, error)
+	grpc.ServerStream
+}
+
+type dervazeCompleteServer struct {
+	grpc.ServerStream
+}
+
+func (x *dervazeCompleteServer) Send(m *CompletionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dervazeCompleteServer) Recv() (*CompletionRequest, error) {
+	m := new(CompletionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Dervaze_ConvertPhonetic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PhoneticRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DervazeServer).ConvertPhonetic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dervaze.Dervaze/ConvertPhonetic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DervazeServer).ConvertPhonetic(ctx, req.(*PhoneticRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dervaze_CheckSpelling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpellCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DervazeServer).CheckSpelling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dervaze.Dervaze/CheckSpelling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DervazeServer).CheckSpelling(ctx, req.(*SpellCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dervaze_CorrectOCR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OCRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DervazeServer).CorrectOCR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dervaze.Dervaze/CorrectOCR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DervazeServer).CorrectOCR(ctx, req.(*OCRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dervaze_AnalyzeWazn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaznRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DervazeServer).AnalyzeWazn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dervaze.Dervaze/AnalyzeWazn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DervazeServer).AnalyzeWazn(ctx, req.(*WaznRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dervaze_GenerateWazn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaznRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DervazeServer).GenerateWazn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dervaze.Dervaze/GenerateWazn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DervazeServer).GenerateWazn(ctx, req.(*WaznRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Dervaze_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dervaze.Dervaze",
 	HandlerType: (*DervazeServer)(nil),
@@ -199,7 +525,46 @@ var _Dervaze_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Translate",
 			Handler:    _Dervaze_Translate_Handler,
 		},
+		{
+			MethodName: "FindRhymes",
+			Handler:    _Dervaze_FindRhymes_Handler,
+		},
+		{
+			MethodName: "ScanVerse",
+			Handler:    _Dervaze_ScanVerse_Handler,
+		},
+		{
+			MethodName: "AnalyzePhonology",
+			Handler:    _Dervaze_AnalyzePhonology_Handler,
+		},
+		{
+			MethodName: "ConvertPhonetic",
+			Handler:    _Dervaze_ConvertPhonetic_Handler,
+		},
+		{
+			MethodName: "CheckSpelling",
+			Handler:    _Dervaze_CheckSpelling_Handler,
+		},
+		{
+			MethodName: "CorrectOCR",
+			Handler:    _Dervaze_CorrectOCR_Handler,
+		},
+		{
+			MethodName: "AnalyzeWazn",
+			Handler:    _Dervaze_AnalyzeWazn_Handler,
+		},
+		{
+			MethodName: "GenerateWazn",
+			Handler:    _Dervaze_GenerateWazn_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Complete",
+			Handler:       _Dervaze_Complete_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lang/dervaze.proto",
 }
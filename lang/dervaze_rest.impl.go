@@ -1,6 +1,7 @@
 package lang
 
 import (
+	"io"
 	"os/exec"
 	"strconv"
 	"strings"
@@ -16,12 +17,16 @@ import (
 	// "github.com/golang/protobuf/proto"
 
 	"github.com/gorilla/mux"
+	"golang.org/x/net/websocket"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // MAXRESULTLEN shows the maximum number of elements returned from searches
 const MAXRESULTLEN = 20
 
+// MAXTEXTLEN is the maximum number of bytes read from request bodies
+const MAXTEXTLEN = 1 << 20
+
 func transformRoots(roots []*Root, transformer func(*Root) *Root) *RootSet {
 	out := make([]*Root, len(roots))
 
@@ -35,6 +40,79 @@ func transformRoots(roots []*Root, transformer func(*Root) *Root) *RootSet {
 	return &r
 }
 
+// transformResults transforms roots of lemmatized search results and keeps the written forms of suffixes and matched variants
+func transformResults(results []*SearchResult, transformer func(*Root) *Root) *RootSet {
+	out := make([]*SearchResult, len(results))
+
+	for i, sr := range results {
+		suffixes := make([]*Suffix, len(sr.Suffixes))
+		for j, s := range sr.Suffixes {
+			suffixes[j] = &Suffix{
+				TurkishLatin: s.TurkishLatin,
+				Ottoman: &OttomanWord{
+					Unicode: s.Ottoman.GetUnicode(),
+				},
+			}
+		}
+		out[i] = &SearchResult{Root: transformer(sr.Root), Suffixes: suffixes, Variants: sr.Variants, Field: sr.Field, Match: sr.Match, Relation: sr.Relation}
+	}
+
+	r := transformRoots(ResultRoots(results), transformer)
+	r.Results = out
+	return r
+}
+
+// stemRequested checks whether the request has stem=true to search roots of inflected words
+func stemRequested(r *http.Request) bool {
+	stem, err := strconv.ParseBool(r.URL.Query().Get("stem"))
+	return err == nil && stem
+}
+
+// allRequested checks whether the request has all=true to search every field
+func allRequested(r *http.Request) bool {
+	all, err := strconv.ParseBool(r.URL.Query().Get("all"))
+	return err == nil && all
+}
+
+// explainRequested checks whether the request has explain=true to add match spans and explanations to the results
+func explainRequested(r *http.Request) bool {
+	explain, err := strconv.ParseBool(r.URL.Query().Get("explain"))
+	return err == nil && explain
+}
+
+// explainOutput adds the matches of results to outputRootSet when explain=true is requested.
+// outputRootSet is transformed from results, or from the roots of results in the same order.
+func explainOutput(r *http.Request, outputRootSet *RootSet, in *SearchRequest, results []*SearchResult) {
+	if !explainRequested(r) {
+		return
+	}
+	if len(outputRootSet.Results) == 0 {
+		outputRootSet.Results = RootResults(outputRootSet.Roots)
+	}
+	for i, sr := range results {
+		if i < len(outputRootSet.Results) {
+			outputRootSet.Results[i].Match = ExplainMatch(in, sr)
+		}
+	}
+}
+
+// scriptRequested returns the profile of the script given with script=persian, arabic or urdu.
+// Ottoman is used when no script or an unknown script is requested.
+func scriptRequested(r *http.Request) *ScriptProfile {
+	script, err := ParseScript(r.URL.Query().Get("script"))
+	if err != nil {
+		log.Println(err)
+	}
+	return GetScriptProfile(script)
+}
+
+// localize wraps a transformer to write Ottoman spellings and abjad values in the script of profile
+func localize(profile *ScriptProfile, transformer func(*Root) *Root) func(*Root) *Root {
+	return func(root *Root) *Root {
+		return transformer(profile.Localize(root))
+	}
+}
+
 func marshalRoots(outputRootSet *RootSet) (string, error) {
 	jsonBytes, err := protojson.Marshal(outputRootSet)
 
@@ -46,22 +124,14 @@ func marshalRoots(outputRootSet *RootSet) (string, error) {
 	return "", err
 }
 
-// validSearchCharacters defines all characters accepted for search
-var validSearchCharacters = []rune(AllSearchableArabic + AllSearchableLatin)
-var validSearchCharMap = make(map[rune]rune, len(validSearchCharacters))
-var validSearchMapInit = false
-
+// cleanUpSearchString removes characters not accepted for search. Ottoman characters are read from the visenc table
+// in use, so letters of tables set by SetVisencTable are kept.
 func cleanUpSearchString(s string) string {
-	if !validSearchMapInit {
-		for _, r := range validSearchCharacters {
-			validSearchCharMap[r] = r
-		}
-	}
-
+	profile := GetScriptProfile(Script_OTTOMAN_TURKISH)
 	sb := strings.Builder{}
 
-	for _, r := range []rune(s) {
-		if _, exists := validSearchCharMap[r]; exists {
+	for _, r := range []rune(NormalizeArabic(s)) {
+		if profile.IsSearchable(r) {
 			sb.WriteRune(r)
 		}
 	}
@@ -73,6 +143,8 @@ func cleanUpSearchString(s string) string {
 // JSONPrefixTr makes a prefix search with the word
 // ## `/v1/json/prefix/tr/{word}
 //
+// `?stem=true` returns the roots of an inflected `word` with their suffixes
+//
 // Sends a list of Turkish words starting with `word` sorted by length
 //
 func JSONPrefixTr(w http.ResponseWriter, r *http.Request) {
@@ -84,11 +156,20 @@ func JSONPrefixTr(w http.ResponseWriter, r *http.Request) {
 		return &r
 	}
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonPrefixTr Vars: %s", vars)
-	roots := FuzzySearchTurkishLatin(vars["word"], MAXRESULTLEN)
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
+	var outputRootSet *RootSet
+	var results []*SearchResult
+	if stemRequested(r) {
+		results = LemmatizeTurkishLatin(vars["word"], MAXRESULTLEN)
+		outputRootSet = transformResults(results, localize(profile, transformer))
+	} else {
+		roots := FuzzySearchTurkishLatin(vars["word"], MAXRESULTLEN)
+		log.Printf("roots: %s", roots)
+		results = RootResults(roots)
+		outputRootSet = transformRoots(roots, localize(profile, transformer))
+	}
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_TURKISH_LATIN, SearchType: SearchType_FUZZY, SearchString: vars["word"], Stem: stemRequested(r), Script: profile.Script}, results)
 
 	if m, err := marshalRoots(outputRootSet); err == nil {
 		w.Header().Add("Access-Control-Allow-Origin", "*")
@@ -99,6 +180,8 @@ func JSONPrefixTr(w http.ResponseWriter, r *http.Request) {
 // JSONPrefixOt responds to a prefix search
 // ## `/v1/json/prefix/ot/{word}
 //
+// `?stem=true` returns the roots of an inflected `word` with their suffixes
+//
 // Sends a list of Ottoman words starting with `word`
 //
 // ```
@@ -115,11 +198,20 @@ func JSONPrefixOt(w http.ResponseWriter, r *http.Request) {
 		return &r
 	}
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonPrefixTr Vars: %s", vars)
-	roots := FuzzySearchUnicode(vars["word"], MAXRESULTLEN)
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
+	var outputRootSet *RootSet
+	var results []*SearchResult
+	if stemRequested(r) {
+		results = LemmatizeUnicode(profile.OttomanUnicode(vars["word"]), MAXRESULTLEN)
+		outputRootSet = transformResults(results, localize(profile, transformer))
+	} else {
+		roots := FuzzySearchUnicode(profile.OttomanUnicode(vars["word"]), MAXRESULTLEN)
+		log.Printf("roots: %s", roots)
+		results = RootResults(roots)
+		outputRootSet = transformRoots(roots, localize(profile, transformer))
+	}
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_OTTOMAN, SearchType: SearchType_FUZZY, SearchString: profile.OttomanUnicode(vars["word"]), Stem: stemRequested(r), Script: profile.Script}, results)
 
 	if m, err := marshalRoots(outputRootSet); err == nil {
 		w.Header().Add("Access-Control-Allow-Origin", "*")
@@ -130,6 +222,8 @@ func JSONPrefixOt(w http.ResponseWriter, r *http.Request) {
 // JSONExactTr searches `word` exactly without prefix or regex
 // ## `/v1/json/exact/tr/{word}
 //
+// `?stem=true` returns the roots of an inflected `word` with their suffixes
+//
 // Returns records with Turkish Latin == `word`
 //
 // ```
@@ -157,15 +251,26 @@ func JSONExactTr(w http.ResponseWriter, r *http.Request) {
 			Ottoman: &OttomanWord{
 				Unicode: root.Ottoman.Unicode,
 			},
+			Relations: root.Relations,
 		}
 		return &r
 	}
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonExactTr Vars: %s", vars)
-	roots := PrefixSearchTurkishLatinExact(vars["word"])
-	log.Printf("roots: %s", roots)
+	var outputRootSet *RootSet
+	var results []*SearchResult
+	if stemRequested(r) {
+		results = LemmatizeTurkishLatin(vars["word"], MAXRESULTLEN)
+		outputRootSet = transformResults(results, localize(profile, transformer))
+	} else {
+		roots := PrefixSearchTurkishLatinExact(vars["word"])
+		log.Printf("roots: %s", roots)
+		results = RootResults(roots)
+		outputRootSet = transformRoots(roots, localize(profile, transformer))
+	}
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_TURKISH_LATIN, SearchType: SearchType_PREFIX, SearchString: vars["word"], Stem: stemRequested(r), Script: profile.Script}, results)
 
-	outputRootSet := transformRoots(roots, transformer)
 	if m, err := marshalRoots(outputRootSet); err == nil {
 		w.Header().Add("Access-Control-Allow-Origin", "*")
 		fmt.Fprintln(w, "", m)
@@ -175,6 +280,8 @@ func JSONExactTr(w http.ResponseWriter, r *http.Request) {
 // JSONExactOt searches `word` exactly as written without prefix or regex
 // ## `/v1/json/exact/ot/{word}
 //
+// `?stem=true` returns the roots of an inflected `word` with their suffixes
+//
 // Returns records with Ottoman == `word`
 //
 // ```
@@ -202,15 +309,26 @@ func JSONExactOt(w http.ResponseWriter, r *http.Request) {
 			Ottoman: &OttomanWord{
 				Unicode: root.Ottoman.Unicode,
 			},
+			Relations: root.Relations,
 		}
 		return &r
 	}
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonExactTr Vars: %s", vars)
-	roots := PrefixSearchUnicodeExact(vars["word"])
-	log.Printf("roots: %s", roots)
+	var outputRootSet *RootSet
+	var results []*SearchResult
+	if stemRequested(r) {
+		results = LemmatizeUnicode(profile.OttomanUnicode(vars["word"]), MAXRESULTLEN)
+		outputRootSet = transformResults(results, localize(profile, transformer))
+	} else {
+		roots := PrefixSearchUnicodeExact(profile.OttomanUnicode(vars["word"]))
+		log.Printf("roots: %s", roots)
+		results = RootResults(roots)
+		outputRootSet = transformRoots(roots, localize(profile, transformer))
+	}
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_OTTOMAN, SearchType: SearchType_PREFIX, SearchString: profile.OttomanUnicode(vars["word"]), Stem: stemRequested(r), Script: profile.Script}, results)
 
-	outputRootSet := transformRoots(roots, transformer)
 	if m, err := marshalRoots(outputRootSet); err == nil {
 		w.Header().Add("Access-Control-Allow-Origin", "*")
 		fmt.Fprintln(w, "", m)
@@ -219,6 +337,7 @@ func JSONExactOt(w http.ResponseWriter, r *http.Request) {
 
 // JSONSearchTr makes a regex search by interleaving .? between runes of `word`
 // `/v1/json/search/tr/{word}`
+// `?stem=true` returns the roots of an inflected `word` with their suffixes
 func JSONSearchTr(w http.ResponseWriter, r *http.Request) {
 	transformer := func(root *Root) *Root {
 		r := Root{
@@ -227,15 +346,25 @@ func JSONSearchTr(w http.ResponseWriter, r *http.Request) {
 			Ottoman: &OttomanWord{
 				Unicode: root.Ottoman.Unicode,
 			},
+			Relations: root.Relations,
 		}
 		return &r
 	}
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonExactTr Vars: %s", vars)
-	roots := FuzzySearchTurkishLatin(vars["word"], MAXRESULTLEN)
-	log.Printf("roots: %s", roots)
-
-	outputRootSet := transformRoots(roots, transformer)
+	var outputRootSet *RootSet
+	var results []*SearchResult
+	if stemRequested(r) {
+		results = LemmatizeTurkishLatin(vars["word"], MAXRESULTLEN)
+		outputRootSet = transformResults(results, localize(profile, transformer))
+	} else {
+		roots := FuzzySearchTurkishLatin(vars["word"], MAXRESULTLEN)
+		log.Printf("roots: %s", roots)
+		results = RootResults(roots)
+		outputRootSet = transformRoots(roots, localize(profile, transformer))
+	}
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_TURKISH_LATIN, SearchType: SearchType_FUZZY, SearchString: vars["word"], Stem: stemRequested(r), Script: profile.Script}, results)
 
 	if m, err := marshalRoots(outputRootSet); err == nil {
 		w.Header().Add("Access-Control-Allow-Origin", "*")
@@ -245,6 +374,7 @@ func JSONSearchTr(w http.ResponseWriter, r *http.Request) {
 
 // JSONSearchOt makes a regex search by interleaving .? between runes of `word`
 // `/v1/json/search/ot/{word}`
+// `?stem=true` returns the roots of an inflected `word` with their suffixes
 func JSONSearchOt(w http.ResponseWriter, r *http.Request) {
 	transformer := func(root *Root) *Root {
 		r := Root{
@@ -253,15 +383,26 @@ func JSONSearchOt(w http.ResponseWriter, r *http.Request) {
 			Ottoman: &OttomanWord{
 				Unicode: root.Ottoman.Unicode,
 			},
+			Relations: root.Relations,
 		}
 		return &r
 	}
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonExactTr Vars: %s", vars)
-	roots := FuzzySearchUnicode(vars["word"], MAXRESULTLEN)
-	log.Printf("roots: %s", roots)
+	var outputRootSet *RootSet
+	var results []*SearchResult
+	if stemRequested(r) {
+		results = LemmatizeUnicode(profile.OttomanUnicode(vars["word"]), MAXRESULTLEN)
+		outputRootSet = transformResults(results, localize(profile, transformer))
+	} else {
+		roots := FuzzySearchUnicode(profile.OttomanUnicode(vars["word"]), MAXRESULTLEN)
+		log.Printf("roots: %s", roots)
+		results = RootResults(roots)
+		outputRootSet = transformRoots(roots, localize(profile, transformer))
+	}
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_OTTOMAN, SearchType: SearchType_FUZZY, SearchString: profile.OttomanUnicode(vars["word"]), Stem: stemRequested(r), Script: profile.Script}, results)
 
-	outputRootSet := transformRoots(roots, transformer)
 	if m, err := marshalRoots(outputRootSet); err == nil {
 		w.Header().Add("Access-Control-Allow-Origin", "*")
 		fmt.Fprintln(w, "", m)
@@ -269,11 +410,10 @@ func JSONSearchOt(w http.ResponseWriter, r *http.Request) {
 }
 
 // JSONSearchAuto makes a regex search by interleaving .? between runes of `word`
-// if `word` contains Arabic letters, it makes Arabic search
-// if `word` contains digits only it makes an abjad search
-// if `word` contains characters and digits mixed, it makes a visenc search
-// otherwise it searches as a Turkish latin word
-// `/v1/json/search/ot/{word}`
+// `word` is searched as Ottoman, abjad, visenc or Turkish Latin, whichever DetectField finds
+// `/v1/json/search/any/{word}`
+// `?stem=true` returns the roots of an inflected `word` with their suffixes
+// `?all=true` searches every field `word` may be written in and labels each result with its field
 func JSONSearchAuto(w http.ResponseWriter, r *http.Request) {
 	transformer := func(root *Root) *Root {
 		r := Root{
@@ -282,21 +422,582 @@ func JSONSearchAuto(w http.ResponseWriter, r *http.Request) {
 			Ottoman: &OttomanWord{
 				Unicode: root.Ottoman.Unicode,
 			},
+			Relations: root.Relations,
 		}
 		return &r
 	}
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonExactTr Vars: %s", vars)
-	roots := FuzzySearchAuto(vars["word"], MAXRESULTLEN)
+	var outputRootSet *RootSet
+	var results []*SearchResult
+	if allRequested(r) {
+		req := SearchRequest{
+			SearchField:  SearchField_ALL,
+			SearchType:   SearchType_FUZZY,
+			SearchString: profile.OttomanUnicode(vars["word"]),
+			ResultLimit:  MAXRESULTLEN,
+			Stem:         stemRequested(r),
+		}
+		rs, err := searchRoots(&req)
+		if err != nil {
+			log.Println(err)
+		}
+		results = rs.Results
+		outputRootSet = transformResults(results, localize(profile, transformer))
+	} else if stemRequested(r) {
+		results = LemmatizeAuto(profile.OttomanUnicode(vars["word"]), MAXRESULTLEN)
+		outputRootSet = transformResults(results, localize(profile, transformer))
+	} else {
+		roots := FuzzySearchAuto(profile.OttomanUnicode(vars["word"]), MAXRESULTLEN)
+		log.Printf("roots: %s", roots)
+		results = RootResults(roots)
+		outputRootSet = transformRoots(roots, localize(profile, transformer))
+	}
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_AUTO, SearchType: SearchType_FUZZY, SearchString: profile.OttomanUnicode(vars["word"]), Stem: stemRequested(r), Script: profile.Script}, results)
+
+	if m, err := marshalRoots(outputRootSet); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", m)
+	}
+}
+
+// JSONSuffixTr searches Turkish Latin words ending with `word`
+// ## `/v1/json/suffix/tr/{word}
+//
+// Sends a list of roots whose Turkish Latin ends with `word` sorted by length
+//
+func JSONSuffixTr(w http.ResponseWriter, r *http.Request) {
+	transformer := func(root *Root) *Root {
+		r := Root{
+			TurkishLatin: root.TurkishLatin,
+			Abjad:        root.Abjad,
+			Ottoman: &OttomanWord{
+				Unicode: root.Ottoman.Unicode,
+			},
+		}
+		return &r
+	}
+	vars := mux.Vars(r)
+	profile := scriptRequested(r)
+	log.Printf("JsonSuffixTr Vars: %s", vars)
+	roots := SuffixSearchTurkishLatin(vars["word"], MAXRESULTLEN)
 	log.Printf("roots: %s", roots)
 
-	outputRootSet := transformRoots(roots, transformer)
+	outputRootSet := transformRoots(roots, localize(profile, transformer))
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_TURKISH_LATIN, SearchType: SearchType_SUFFIX, SearchString: vars["word"], Script: profile.Script}, RootResults(roots))
+	if m, err := marshalRoots(outputRootSet); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", m)
+	}
+}
+
+// JSONSuffixOt searches Ottoman words ending with `word`
+// ## `/v1/json/suffix/ot/{word}
+//
+// Sends a list of roots whose Ottoman spelling ends with `word` sorted by length
+//
+func JSONSuffixOt(w http.ResponseWriter, r *http.Request) {
+	transformer := func(root *Root) *Root {
+		r := Root{
+			TurkishLatin: root.TurkishLatin,
+			Abjad:        root.Abjad,
+			Ottoman: &OttomanWord{
+				Unicode: root.Ottoman.Unicode,
+			},
+		}
+		return &r
+	}
+	vars := mux.Vars(r)
+	profile := scriptRequested(r)
+	log.Printf("JsonSuffixOt Vars: %s", vars)
+	roots := SuffixSearchUnicode(profile.OttomanUnicode(vars["word"]), MAXRESULTLEN)
+	log.Printf("roots: %s", roots)
+
+	outputRootSet := transformRoots(roots, localize(profile, transformer))
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_OTTOMAN, SearchType: SearchType_SUFFIX, SearchString: profile.OttomanUnicode(vars["word"]), Script: profile.Script}, RootResults(roots))
+	if m, err := marshalRoots(outputRootSet); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", m)
+	}
+}
+
+// JSONVariantOt searches Ottoman words spelled as `word` or one of its orthographic variants
+// ## `/v1/json/variant/ot/{word}?prefix=true
+//
+// Sends a list of roots whose Ottoman spelling equals `word` after letter equivalences (ي and ی, ڭ and ك)
+// and spelling alternations (final ا and ه, optional و) are applied. Exact spellings come first.
+// `prefix=true` returns roots starting with `word` and applies only letter equivalences.
+//
+// ```
+// { "roots": [...],
+//   "results": [ { "root": { "turkishLatin": "...", "ottoman": { "unicode": "..." } },
+//                  "variants": ["arabic-yeh"] } ] }
+// ```
+//
+func JSONVariantOt(w http.ResponseWriter, r *http.Request) {
+	transformer := func(root *Root) *Root {
+		r := Root{
+			TurkishLatin: root.TurkishLatin,
+			Abjad:        root.Abjad,
+			Ottoman: &OttomanWord{
+				Unicode: root.Ottoman.Unicode,
+			},
+		}
+		return &r
+	}
+	vars := mux.Vars(r)
+	profile := scriptRequested(r)
+	log.Printf("JsonVariantOt Vars: %s", vars)
+	var results []*SearchResult
+	if prefix, err := strconv.ParseBool(r.URL.Query().Get("prefix")); err == nil && prefix {
+		results = VariantPrefixSearchUnicode(profile.OttomanUnicode(vars["word"]), MAXRESULTLEN)
+	} else {
+		results = VariantSearchUnicode(profile.OttomanUnicode(vars["word"]), MAXRESULTLEN)
+	}
+
+	outputRootSet := transformResults(results, localize(profile, transformer))
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_OTTOMAN, SearchType: SearchType_VARIANT, SearchString: profile.OttomanUnicode(vars["word"]), Script: profile.Script}, results)
+	if m, err := marshalRoots(outputRootSet); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", m)
+	}
+}
+
+// JSONPattern searches Ottoman words matching a letter pattern
+// ## `/v1/json/pattern/{pattern}
+//
+// Patterns are written with visenc or Ottoman letters. `?` (sent as %3F) matches any one letter, `*` any sequence,
+// `[bu1 bo2]` one of the letters and `{bowl}` one of the letters of a class. Vowel marks are not compared.
+// Sends a list of roots whose whole spelling matches `pattern` sorted by length
+//
+func JSONPattern(w http.ResponseWriter, r *http.Request) {
+	transformer := func(root *Root) *Root {
+		r := Root{
+			TurkishLatin: root.TurkishLatin,
+			Abjad:        root.Abjad,
+			Ottoman: &OttomanWord{
+				Unicode: root.Ottoman.Unicode,
+				Visenc:  root.Ottoman.Visenc,
+			},
+		}
+		return &r
+	}
+	vars := mux.Vars(r)
+	profile := scriptRequested(r)
+	log.Printf("JsonPattern Vars: %s", vars)
+	var roots []*Root
+	if lp, err := profile.CompilePattern(vars["pattern"]); err == nil {
+		roots = PatternSearch(lp, MAXRESULTLEN)
+	} else {
+		log.Println(err)
+		roots = make([]*Root, 0)
+	}
+
+	outputRootSet := transformRoots(roots, localize(profile, transformer))
+	explainOutput(r, outputRootSet, &SearchRequest{SearchField: SearchField_OTTOMAN, SearchType: SearchType_PATTERN, SearchString: vars["pattern"], Script: profile.Script}, RootResults(roots))
+	if m, err := marshalRoots(outputRootSet); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", m)
+	}
+}
+
+// JSONQuery searches roots with a structured query
+// ## `/v1/json/query/{query}
+//
+// Queries combine terms like `tr:kitab*`, `ot:کتاب`, `v:*ebu1`, `pat:k?ebu1`, `abjad:400..500`, `pos:noun`,
+// `src:redhouse` and `softening:true` with AND, OR, NOT (or -) and parentheses. Terms without AND are joined by AND.
+// Sends a list of roots matching the query sorted by length
+//
+func JSONQuery(w http.ResponseWriter, r *http.Request) {
+	transformer := func(root *Root) *Root {
+		r := Root{
+			TurkishLatin: root.TurkishLatin,
+			Abjad:        root.Abjad,
+			PartOfSpeech: root.PartOfSpeech,
+			Sources:      root.Sources,
+			Ottoman: &OttomanWord{
+				Unicode: root.Ottoman.Unicode,
+				Visenc:  root.Ottoman.Visenc,
+			},
+			Relations: root.Relations,
+		}
+		return &r
+	}
+	vars := mux.Vars(r)
+	profile := scriptRequested(r)
+	log.Printf("JsonQuery Vars: %s", vars)
+	var roots []*Root
+	if q, err := profile.ParseQuery(vars["query"]); err == nil {
+		roots = QuerySearch(q, MAXRESULTLEN)
+	} else {
+		log.Println(err)
+		roots = make([]*Root, 0)
+	}
+
+	outputRootSet := transformRoots(roots, localize(profile, transformer))
+	if m, err := marshalRoots(outputRootSet); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", m)
+	}
+}
+
+// JSONComplete completes a partial word for typeahead
+// ## `/v1/json/complete/{prefix}?limit=10
+//
+// Sends at most `limit` roots beginning with `prefix`, the most frequent first. `prefix` is Turkish Latin, Ottoman or visenc.
+// Clients sending a request at each keystroke should use the WebSocket at `/v1/ws/complete` instead.
+//
+func JSONComplete(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	log.Printf("JsonComplete Vars: %s", vars)
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	out := CompleteRequest(&CompletionRequest{Prefix: vars["prefix"], ResultLimit: int32(limit), Script: scriptRequested(r).Script})
+
+	if m, err := protojson.Marshal(out); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", string(m))
+	} else {
+		log.Printf("Marshal Error: %s", err)
+	}
+}
+
+// JSONPhonetic writes a word typed phonetically in Latin letters with Ottoman letters
+// ## `/v1/json/phonetic/{word}?limit=10
+//
+// Sends at most `limit` Ottoman spellings of `word`, like kitab, hane or sheker. Dictionary words read like `word`
+// come first, then spellings guessed by phonetic rules and dictionary words beginning with `word`.
+// Each candidate has its letters with the Latin letters they're written for.
+//
+func JSONPhonetic(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	log.Printf("JsonPhonetic Vars: %s", vars)
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	out := ConvertPhonetic(&PhoneticRequest{Latin: vars["word"], ResultLimit: int32(limit), Script: scriptRequested(r).Script})
+
+	if m, err := protojson.Marshal(out); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", string(m))
+	} else {
+		log.Printf("Marshal Error: %s", err)
+	}
+}
+
+// JSONSpell checks the spelling of an Ottoman text
+// ## `/v1/json/spell/{text}?limit=5
+//
+// Sends the words of `text` not found in the dictionary, with or without suffixes, and their byte offsets.
+// Each word has at most `limit` suggestions, the closest first. Long texts can be sent as the body of a POST
+// request to `/v1/json/spell`.
+//
+func JSONSpell(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	log.Printf("JsonSpell Vars: %s", vars)
+	text, exists := vars["text"]
+	if !exists {
+		body, err := io.ReadAll(io.LimitReader(r.Body, MAXTEXTLEN))
+		if err != nil {
+			log.Printf("Request Error: %s", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		text = string(body)
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	out := CheckSpelling(&SpellCheckRequest{Text: text, SuggestionLimit: int32(limit), Script: scriptRequested(r).Script})
+
+	if m, err := protojson.Marshal(out); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", string(m))
+	} else {
+		log.Printf("Marshal Error: %s", err)
+	}
+}
+
+// JSONOCR corrects Ottoman OCR output
+// ## `/v1/json/ocr/{text}?limit=5
+//
+// Sends the dictionary words OCR may have read as each word of `text` and the text with unknown words replaced
+// by their most likely readings. Each word has at most `limit` candidates. Tokens with character confidences
+// can be sent as a JSON `OCRRequest` in the body of a POST request to `/v1/json/ocr`.
+//
+func JSONOCR(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	log.Printf("JsonOCR Vars: %s", vars)
+	in := OCRRequest{Script: scriptRequested(r).Script}
+	if text, exists := vars["text"]; exists {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		in.Text = text
+		in.CandidateLimit = int32(limit)
+	} else {
+		body, err := io.ReadAll(io.LimitReader(r.Body, MAXTEXTLEN))
+		if err == nil {
+			err = protojson.Unmarshal(body, &in)
+		}
+		if err != nil {
+			log.Printf("Request Error: %s", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	out := CorrectOCR(&in)
+
+	if m, err := protojson.Marshal(out); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", string(m))
+	} else {
+		log.Printf("Marshal Error: %s", err)
+	}
+}
+
+// JSONFamily searches words derived from the same Arabic root
+// ## `/v1/json/family/{word}
+//
+// `word` is an Arabic root like کتب or ک-ت-ب, or an Ottoman, visenc or Turkish Latin word like مکتوب or mektup.
+// Sends a list of roots whose Arabic roots are the same as `word` or the root of `word`, the roots in more dictionaries first
+//
+func JSONFamily(w http.ResponseWriter, r *http.Request) {
+	transformer := func(root *Root) *Root {
+		r := Root{
+			TurkishLatin: root.TurkishLatin,
+			Abjad:        root.Abjad,
+			PartOfSpeech: root.PartOfSpeech,
+			ArabicRoot:   root.ArabicRoot,
+			Ottoman: &OttomanWord{
+				Unicode: root.Ottoman.Unicode,
+				Visenc:  root.Ottoman.Visenc,
+			},
+			Relations: root.Relations,
+		}
+		return &r
+	}
+	vars := mux.Vars(r)
+	profile := scriptRequested(r)
+	log.Printf("JsonFamily Vars: %s", vars)
+	word := vars["word"]
+	if ContainsArabicChars(word) {
+		word = profile.OttomanUnicode(word)
+	}
+	roots := FamilySearch(word, MAXRESULTLEN)
+
+	outputRootSet := transformRoots(roots, localize(profile, transformer))
+	explainOutput(r, outputRootSet, &SearchRequest{SearchType: SearchType_FAMILY, SearchString: word, Script: profile.Script}, RootResults(roots))
 	if m, err := marshalRoots(outputRootSet); err == nil {
 		w.Header().Add("Access-Control-Allow-Origin", "*")
 		fmt.Fprintln(w, "", m)
 	}
 }
 
+// JSONWazn analyzes the Arabic pattern of a word
+// ## `/v1/json/wazn/{word}
+//
+// Sends the Arabic patterns (wazn) `word` follows with its roots, the best first. Each form is `word` without
+// the article and endings, written in the Latin and Ottoman spellings of the pattern, with the dictionary roots spelled as it.
+//
+// ```
+// { "forms": [ { "pattern": "mef'ûl", "patternOttoman": "مفعول", "arabicRoot": "کتب",
+//                "turkishLatin": "mektûb", "ottoman": { "visenc": "...", "unicode": "مکتوب" },
+//                "roots": [...], "score": 4 } ] }
+// ```
+//
+func JSONWazn(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	log.Printf("JsonWazn Vars: %s", vars)
+	out := scriptRequested(r).AnalyzeWazn(vars["word"])
+
+	if m, err := protojson.Marshal(out); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", string(m))
+	} else {
+		log.Printf("Marshal Error: %s", err)
+	}
+}
+
+// JSONWaznGenerate writes an Arabic root in Arabic patterns
+// ## `/v1/json/wazn/generate/{root}?pattern=mef'ûl
+//
+// `root` is written in Ottoman or Latin letters like کتب or k-t-b. Sends the forms of `root` in `pattern`,
+// or in every pattern if no pattern is given, with the dictionary roots spelled as them. Forms in the dictionary come first.
+//
+func JSONWaznGenerate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	log.Printf("JsonWaznGenerate Vars: %s", vars)
+	out, err := scriptRequested(r).GenerateWazn(vars["root"], r.URL.Query().Get("pattern"))
+	if err != nil {
+		log.Printf("Request Error: %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if m, err := protojson.Marshal(out); err == nil {
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		fmt.Fprintln(w, "", string(m))
+	} else {
+		log.Printf("Marshal Error: %s", err)
+	}
+}
+
+// WebSocketComplete completes partial words sent on a WebSocket
+// ## `/v1/ws/complete`
+//
+// Clients send a JSON `CompletionRequest` at each keystroke and receive a `CompletionResponse` with the same `sequence`.
+// Requests sent while the previous one is being answered are dropped except the latest.
+//
+// ```
+// > { "prefix": "kit", "sequence": 3 }
+// < { "sequence": 3, "prefix": "kit", "roots": [ { "turkishLatin": "kitap", ... } ] }
+// ```
+//
+var WebSocketComplete = websocket.Server{
+	// any origin is accepted as in the JSON endpoints
+	Handshake: func(config *websocket.Config, r *http.Request) error { return nil },
+	Handler: func(ws *websocket.Conn) {
+		defer ws.Close()
+		// read and write timeouts of the server are for JSON requests, the stream is open until the client closes it
+		ws.SetDeadline(time.Time{})
+		recv := func() (*CompletionRequest, error) {
+			for {
+				var text string
+				if err := websocket.Message.Receive(ws, &text); err != nil {
+					return nil, err
+				}
+				var in CompletionRequest
+				if err := protojson.Unmarshal([]byte(text), &in); err != nil {
+					log.Printf("WebSocketComplete: %s", err)
+					continue
+				}
+				return &in, nil
+			}
+		}
+		send := func(out *CompletionResponse) error {
+			m, err := protojson.Marshal(out)
+			if err != nil {
+				return err
+			}
+			return websocket.Message.Send(ws, string(m))
+		}
+		if err := completionStream(recv, send); err != nil {
+			log.Printf("WebSocketComplete: %s", err)
+		}
+	},
+}
+
+// JSONRhyme finds words rhyming with `word` (kafiye)
+// ## `/v1/json/rhyme/{word}?pos=noun,verb&syllables=2&min=2
+//
+// Sends a list of roots ending with the same letters as `word`, ranked by the number of matching trailing letters.
+// Ottoman words are compared by letters, Latin words by sounds.
+// `pos` filters by part of speech (noun, verb, proper) and `syllables` by the number of syllables.
+//
+// ```
+// { "rhymes": [ { "root": { "turkishLatin": "...", "ottoman": { "unicode": "..." } },
+//                 "matchLength": 3 } ] }
+// ```
+//
+func JSONRhyme(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	log.Printf("JsonRhyme Vars: %s", vars)
+
+	query := r.URL.Query()
+	pos := make([]PartOfSpeech, 0)
+	for _, p := range strings.Split(query.Get("pos"), ",") {
+		switch strings.ToLower(strings.TrimSpace(p)) {
+		case "noun", "n":
+			pos = append(pos, PartOfSpeech_NOUN)
+		case "verb", "v":
+			pos = append(pos, PartOfSpeech_VERB)
+		case "proper", "p":
+			pos = append(pos, PartOfSpeech_PROPER_NOUN)
+		}
+	}
+	syllables, _ := strconv.Atoi(query.Get("syllables"))
+	minMatch, _ := strconv.Atoi(query.Get("min"))
+
+	rhymes := FindRhymes(vars["word"], SearchField_AUTO, pos, syllables, minMatch, MAXRESULTLEN)
+
+	for _, rm := range rhymes {
+		rm.Root = &Root{
+			TurkishLatin: rm.Root.TurkishLatin,
+			Abjad:        rm.Root.Abjad,
+			Ottoman: &OttomanWord{
+				Unicode: rm.Root.Ottoman.Unicode,
+			},
+		}
+	}
+
+	jsonBytes, err := protojson.Marshal(&RhymeResponse{Rhymes: rhymes})
+	if err != nil {
+		log.Printf("Marshal Error: %s", err)
+		return
+	}
+	w.Header().Add("Access-Control-Allow-Origin", "*")
+	fmt.Fprintln(w, "", string(jsonBytes))
+}
+
+// JSONAruz scans a verse line with aruz meters
+// ## `/v1/json/aruz/{line}
+//
+// Splits `line` given in Latin or Ottoman script into syllables and returns the best fitting meter.
+// Syllables breaking the meter are listed in `violations` by their index.
+//
+// ```
+// { "line": "Beni candan usandırdı cefâdan yâr usanmaz mı",
+//   "syllables": [ { "text": "be", "word": "Beni", "weight": ".", "reading": "." }, ... ],
+//   "meter": { "name": "hezec-i müsemmen-i sâlim",
+//              "feet": "mefâîlün mefâîlün mefâîlün mefâîlün",
+//              "pattern": ".--- .--- .--- .---" },
+//   "scansion": ".---.---.---.---",
+//   "cost": 2 }
+// ```
+//
+func JSONAruz(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	log.Printf("JsonAruz Vars: %s", vars)
+
+	scan := ScanVerse(vars["line"])
+
+	jsonBytes, err := protojson.Marshal(scan)
+	if err != nil {
+		log.Printf("Marshal Error: %s", err)
+		return
+	}
+	w.Header().Add("Access-Control-Allow-Origin", "*")
+	fmt.Fprintln(w, "", string(jsonBytes))
+}
+
+// JSONPhonology analyzes the phonology of a Turkish Latin word
+// ## `/v1/json/phonology/{word}
+//
+// Returns syllables, vowel harmony class, long vowels, harmony features and hyphenation points of `word`.
+// `word` does not need to be in the dictionary.
+//
+// ```
+// { "word": "kitaplar",
+//   "syllables": [ "ki", "tap", "lar" ],
+//   "harmonyClass": "back-unrounded",
+//   "lastVowel": "a", "lastConsonant": "r", "effectiveLastVowel": "a",
+//   "lastVowelHard": true,
+//   "harmonyConsistent": false,
+//   "harmonyBreaks": [ 1, 2 ],
+//   "hyphenationPoints": [ 2, 5 ],
+//   "hyphenated": "ki-tap-lar" }
+// ```
+//
+func JSONPhonology(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	log.Printf("JsonPhonology Vars: %s", vars)
+
+	analysis := AnalyzePhonology(vars["word"])
+
+	jsonBytes, err := protojson.Marshal(analysis)
+	if err != nil {
+		log.Printf("Marshal Error: %s", err)
+		return
+	}
+	w.Header().Add("Access-Control-Allow-Origin", "*")
+	fmt.Fprintln(w, "", string(jsonBytes))
+}
+
 // JSONExactAbjad searches words with `number` as their abjad counterpart
 // ## `/v1/json/exact/abjad/{number}
 //
@@ -333,6 +1034,7 @@ func JSONExactAbjad(w http.ResponseWriter, r *http.Request) {
 		return &r
 	}
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonExactTr Vars: %s", vars)
 	val, err := strconv.Atoi(vars["word"])
 	var roots []*Root
@@ -344,7 +1046,7 @@ func JSONExactAbjad(w http.ResponseWriter, r *http.Request) {
 	}
 	log.Printf("roots: %s", roots)
 
-	outputRootSet := transformRoots(roots, transformer)
+	outputRootSet := transformRoots(roots, localize(profile, transformer))
 	if m, err := marshalRoots(outputRootSet); err == nil {
 
 		w.Header().Add("Access-Control-Allow-Origin", "*")
@@ -366,8 +1068,9 @@ func JSONExactAbjad(w http.ResponseWriter, r *http.Request) {
 func JSONCalcAbjad(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Access-Control-Allow-Origin", "*")
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonPrefixTr Vars: %s", vars)
-	abjad := UnicodeToAbjad(vars["word"])
+	abjad := profile.UnicodeToAbjad(vars["word"])
 	str := fmt.Sprintf("{ \"ottoman_unicode\": \"%s\", \"abjad\": %d }", vars["word"], abjad)
 	fmt.Fprintln(w, "", str)
 
@@ -386,8 +1089,9 @@ func JSONCalcAbjad(w http.ResponseWriter, r *http.Request) {
 func JSONV2U(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Access-Control-Allow-Origin", "*")
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonV2U Vars: %s", vars)
-	unicode := VisencToUnicode(vars["word"])
+	unicode := profile.VisencToUnicode(vars["word"])
 	str := fmt.Sprintf("{ \"ottoman_unicode\": \"%s\", \"ottoman_unicode\": \"%s\" }", unicode, vars["word"])
 	fmt.Fprintln(w, "", str)
 }
@@ -405,8 +1109,9 @@ func JSONV2U(w http.ResponseWriter, r *http.Request) {
 func JSONU2V(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Access-Control-Allow-Origin", "*")
 	vars := mux.Vars(r)
+	profile := scriptRequested(r)
 	log.Printf("JsonU2V Vars: %s", vars)
-	visenc := UnicodeToVisenc(vars["word"])
+	visenc := profile.UnicodeToVisenc(vars["word"])
 	str := fmt.Sprintf("{ \"ottoman_unicode\": \"%s\", \"ottoman_unicode\": \"%s\" }", vars["word"], visenc)
 	fmt.Fprintln(w, "", str)
 }
@@ -432,6 +1137,24 @@ func restServer(host string, port int) {
 	router.HandleFunc("/v1/json/search/any/{word}", JSONSearchAuto)
 	router.HandleFunc("/v1/json/search/ot/{word}", JSONSearchOt)
 	router.HandleFunc("/v1/json/search/tr/{word}", JSONSearchTr)
+	router.HandleFunc("/v1/json/suffix/tr/{word}", JSONSuffixTr)
+	router.HandleFunc("/v1/json/suffix/ot/{word}", JSONSuffixOt)
+	router.HandleFunc("/v1/json/variant/ot/{word}", JSONVariantOt)
+	router.HandleFunc("/v1/json/pattern/{pattern}", JSONPattern)
+	router.HandleFunc("/v1/json/query/{query}", JSONQuery)
+	router.HandleFunc("/v1/json/complete/{prefix}", JSONComplete)
+	router.Handle("/v1/ws/complete", WebSocketComplete)
+	router.HandleFunc("/v1/json/phonetic/{word}", JSONPhonetic)
+	router.HandleFunc("/v1/json/spell/{text}", JSONSpell)
+	router.HandleFunc("/v1/json/spell", JSONSpell).Methods("POST")
+	router.HandleFunc("/v1/json/ocr/{text}", JSONOCR)
+	router.HandleFunc("/v1/json/ocr", JSONOCR).Methods("POST")
+	router.HandleFunc("/v1/json/family/{word}", JSONFamily)
+	router.HandleFunc("/v1/json/wazn/generate/{root}", JSONWaznGenerate)
+	router.HandleFunc("/v1/json/wazn/{word}", JSONWazn)
+	router.HandleFunc("/v1/json/rhyme/{word}", JSONRhyme)
+	router.HandleFunc("/v1/json/aruz/{line}", JSONAruz)
+	router.HandleFunc("/v1/json/phonology/{word}", JSONPhonology)
 	router.HandleFunc("/v1/json/exact/abjad/{number}", JSONExactAbjad)
 	router.HandleFunc("/v1/json/calc/abjad/{word}", JSONCalcAbjad)
 	router.HandleFunc("/v1/json/v2u/{word}", JSONV2U)
@@ -0,0 +1,144 @@
+package lang
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// turkishLatinLetters are the letters of Turkish alphabet. q, w and x are not Turkish, but they are common in visenc.
+const turkishLatinLetters = "abcçdefgğhıijklmnoöprsştuüvyzâîû"
+
+// FieldScore is the likelihood of a search string to be written in a field, between 0 and 1
+type FieldScore struct {
+	Field SearchField
+	Score float64
+}
+
+// ScoreFields scores word as Ottoman Unicode, abjad, Turkish Latin and visenc, and returns the scores from the highest to the lowest.
+// Fields with equal scores are returned in this order.
+//
+// Ottoman is scored by the share of Arabic script characters, abjad is 1 for numbers.
+// Turkish Latin is scored by the share of letters in Turkish alphabet, halved for words without vowels and 0 for words with digits.
+// Visenc is scored by the share of characters SplitVisenc reads as visenc letters.
+func ScoreFields(word string) []FieldScore {
+	var total, arabic, latin, vowels, digits float64
+
+	for _, r := range word {
+		if unicode.IsSpace(r) || r == '\'' || r == '-' {
+			continue
+		}
+		total++
+		lr := unicode.ToLower(r)
+		switch {
+		case ContainsArabicChars(string(r)):
+			arabic++
+		case strings.ContainsRune(turkishLatinLetters, lr):
+			latin++
+			if strings.ContainsRune(allVowels, lr) {
+				vowels++
+			}
+		case unicode.IsDigit(r):
+			digits++
+		}
+	}
+
+	scores := []FieldScore{
+		{Field: SearchField_OTTOMAN},
+		{Field: SearchField_ABJAD},
+		{Field: SearchField_TURKISH_LATIN},
+		{Field: SearchField_VISENC},
+	}
+	if total == 0 {
+		return scores
+	}
+
+	scores[0].Score = arabic / total
+
+	if _, err := strconv.Atoi(strings.TrimSpace(word)); err == nil {
+		scores[1].Score = 1
+	}
+
+	if digits == 0 {
+		scores[2].Score = latin / total
+		if vowels == 0 {
+			scores[2].Score /= 2
+		}
+	}
+
+	visencLength := 0
+	for _, v := range SplitVisenc(word, false) {
+		if strings.TrimSpace(v) != "" {
+			visencLength += len([]rune(v))
+		}
+	}
+	scores[3].Score = float64(visencLength) / total
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+	return scores
+}
+
+// DetectField returns the field word is most likely written in. Empty words are Turkish Latin.
+// Words scored equally as Turkish Latin and visenc, like e, are in the field whose index has them. If both or none of
+// the indices have them, they are in SearchField_ALL.
+func DetectField(word string) SearchField {
+	scores := ScoreFields(word)
+	if scores[0].Score == 0 {
+		return SearchField_TURKISH_LATIN
+	}
+	if scores[0].Score == scores[1].Score && latinOrVisenc(scores[0].Field) && latinOrVisenc(scores[1].Field) {
+		key := strings.TrimSpace(word)
+		latin := indexHas(turkishLatinIndex, key)
+		visenc := indexHas(visencIndex, visencSearchKey(key))
+		switch {
+		case latin && !visenc:
+			return SearchField_TURKISH_LATIN
+		case visenc && !latin:
+			return SearchField_VISENC
+		}
+		return SearchField_ALL
+	}
+	return scores[0].Field
+}
+
+func latinOrVisenc(field SearchField) bool {
+	return field == SearchField_TURKISH_LATIN || field == SearchField_VISENC
+}
+
+// indexHas checks whether key is a key of index, indices are nil before InitSearch
+func indexHas(index *KeyIndex, key string) bool {
+	return index != nil && len(index.Lookup(key)) > 0
+}
+
+// RegexLiterals returns the literal characters of a regular expression, e.g. kitap for ^ki.?tap$, to detect its field
+func RegexLiterals(regex *regexp.Regexp) string {
+	parsed, err := syntax.Parse(regex.String(), syntax.Perl)
+	if err != nil {
+		return regex.String()
+	}
+
+	var sb strings.Builder
+	var walk func(re *syntax.Regexp)
+	walk = func(re *syntax.Regexp) {
+		if re.Op == syntax.OpLiteral {
+			sb.WriteString(string(re.Rune))
+		}
+		for _, sub := range re.Sub {
+			walk(sub)
+		}
+	}
+	walk(parsed)
+
+	return sb.String()
+}
+
+// SearchAll makes a fuzzy search of word in every field it may be written in and labels each result with its field, see SearchField_ALL
+func SearchAll(word string, maxLen int) []*SearchResult {
+	rs, _ := searchAllFields(&SearchRequest{SearchField: SearchField_ALL, SearchType: SearchType_FUZZY, SearchString: word, ResultLimit: int32(maxLen)})
+	return rs.Results
+}
@@ -0,0 +1,75 @@
+package lang
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+// func DetectField(word string) SearchField {
+func TestDetectField(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	testDict := map[string]SearchField{
+		"kitap":       SearchField_TURKISH_LATIN,
+		"Ağaç":        SearchField_TURKISH_LATIN,
+		"kal'a":       SearchField_TURKISH_LATIN,
+		"e":           SearchField_ALL,
+		"er":          SearchField_ALL,
+		"at":          SearchField_TURKISH_LATIN,
+		"lm":          SearchField_VISENC,
+		"d":           SearchField_VISENC,
+		"kbo2ebu1":    SearchField_VISENC,
+		"xwe":         SearchField_VISENC,
+		"کتاب":        SearchField_OTTOMAN,
+		"ﻗﻠﻌﻪ":        SearchField_OTTOMAN,
+		"423":         SearchField_ABJAD,
+		"kbo2ebu1 ab": SearchField_VISENC,
+		"":            SearchField_TURKISH_LATIN,
+	}
+
+	for i, o := range testDict {
+		if f := DetectField(i); f != o {
+			t.Log(fmt.Sprintf("%s should be detected as %s, got %s: %v", i, o, f, ScoreFields(i)))
+			t.Fail()
+		}
+	}
+}
+
+// func RegexLiterals(regex *regexp.Regexp) string {
+func TestRegexLiterals(t *testing.T) {
+	testDict := map[string]string{
+		"^ki.?tap$":  "kitap",
+		"k(bo2|t)e":  "kbo2te",
+		"[a-z]+lm.*": "lm",
+		"کتا?ب":      "کتاب",
+	}
+
+	for i, o := range testDict {
+		if l := RegexLiterals(regexp.MustCompile(i)); l != o {
+			t.Log(fmt.Sprintf("Literals of %s should be %s, got %s", i, o, l))
+			t.Fail()
+		}
+	}
+}
+
+// func SearchAll(word string, maxLen int) []*SearchResult {
+func TestSearchAll(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	results := SearchAll("kitap", 100)
+	if len(results) == 0 || results[0].Field != SearchField_TURKISH_LATIN {
+		t.Log(fmt.Sprintf("kitap should be found in Turkish Latin first: %v", results))
+		t.FailNow()
+	}
+
+	fields := make(map[SearchField]bool)
+	for _, sr := range results {
+		fields[sr.Field] = true
+	}
+	// kitap is also valid visenc for the letters k, t and a
+	if !fields[SearchField_VISENC] || fields[SearchField_AUTO] {
+		t.Log(fmt.Sprintf("Fields of results: %v", fields))
+		t.Fail()
+	}
+}
@@ -0,0 +1,413 @@
+package lang
+
+import (
+	"regexp"
+	"strings"
+)
+
+// matchUnit is a letter of a root or a search string compared by explanations. Ottoman letters are visenc letter
+// groups with the marks and joiners written after them, Latin letters are runes.
+type matchUnit struct {
+	// letter is the visenc letter group or the rune
+	letter string
+	// key is compared with the letters of the search string, diacritics and letter variants are ignored
+	key string
+	// visenc and unicode are the written forms of the unit with its marks, matched by regular expressions
+	visenc  string
+	unicode string
+	// rune offsets of the unit in the visenc and Unicode spellings, or in the Latin spelling
+	visencStart, visencEnd   int
+	unicodeStart, unicodeEnd int
+}
+
+// latinUnits splits a Latin word into runes
+func latinUnits(s string) []matchUnit {
+	units := make([]matchUnit, 0, len(s))
+	for i, r := range []rune(s) {
+		units = append(units, matchUnit{letter: string(r), key: string(r), visencStart: i, visencEnd: i + 1})
+	}
+	return units
+}
+
+// ottomanUnits splits a visenc word into letters. Marks and joiners are added to the letter before them.
+// Unicode offsets are found by the Unicode letters of the groups, aligned is false if they don't add up to unicode.
+func ottomanUnits(visenc string, unicode string) ([]matchUnit, bool) {
+	units := make([]matchUnit, 0, len(visenc)/2)
+	runes := []rune(visenc)
+	vpos, upos := 0, 0
+	// marks before the first letter are added to it
+	var leading *matchUnit
+
+	for _, g := range SplitVisenc(visenc, false) {
+		// SplitVisenc leaves out invalid letters, so the group is searched after the last one
+		if i := strings.Index(string(runes[vpos:]), g); i >= 0 {
+			vpos += len([]rune(string(runes[vpos:])[:i]))
+		}
+		u := VisencToUnicodeMap[g]
+		unit := matchUnit{letter: g, key: letterKey(g), visenc: g, unicode: u,
+			visencStart: vpos, visencEnd: vpos + len([]rune(g)), unicodeStart: upos, unicodeEnd: upos + len([]rune(u))}
+		vpos, upos = unit.visencEnd, unit.unicodeEnd
+
+		switch {
+		case isLetterGroup(g):
+			if leading != nil {
+				unit.visenc, unit.unicode = leading.visenc+unit.visenc, leading.unicode+unit.unicode
+				unit.visencStart, unit.unicodeStart = leading.visencStart, leading.unicodeStart
+				leading = nil
+			}
+			units = append(units, unit)
+		case len(units) > 0:
+			last := &units[len(units)-1]
+			last.visenc, last.unicode = last.visenc+unit.visenc, last.unicode+unit.unicode
+			last.visencEnd, last.unicodeEnd = unit.visencEnd, unit.unicodeEnd
+		case leading == nil:
+			leading = &unit
+		default:
+			leading.visenc, leading.unicode = leading.visenc+unit.visenc, leading.unicode+unit.unicode
+			leading.visencEnd, leading.unicodeEnd = unit.visencEnd, unit.unicodeEnd
+		}
+	}
+
+	return units, upos == len([]rune(unicode))
+}
+
+// letterKey compares letters without diacritics and with letter equivalences like ي and ی
+func letterKey(g string) string {
+	k := SearchKey(g)
+	if rule, exists := variantTable.letters[k]; exists {
+		return rule.To
+	}
+	return k
+}
+
+func unitKeys(units []matchUnit) []string {
+	keys := make([]string, len(units))
+	for i, u := range units {
+		keys[i] = u.key
+	}
+	return keys
+}
+
+// prefixUnits returns the number of leading units whose keys are the same
+func prefixUnits(query []string, keys []string) int {
+	n := 0
+	for n < len(query) && n < len(keys) && query[n] == keys[n] {
+		n++
+	}
+	return n
+}
+
+// fuzzyUnits returns the indices of the leftmost units matching query as a subsequence, or nil
+func fuzzyUnits(query []string, keys []string) []int {
+	matched := make([]int, 0, len(query))
+	q := 0
+	for i := 0; i < len(keys) && q < len(query); i++ {
+		if keys[i] == query[q] {
+			matched = append(matched, i)
+			q++
+		}
+	}
+	if q < len(query) {
+		return nil
+	}
+	return matched
+}
+
+// regexUnits returns the units overlapping the matches of regex in the written forms of units
+func regexUnits(regex *regexp.Regexp, units []matchUnit, written func(matchUnit) string) []int {
+	var sb strings.Builder
+	owner := make([]int, 0)
+	for i, u := range units {
+		w := written(u)
+		if IgnoreJoiners {
+			w = RemoveJoiners(w)
+		}
+		sb.WriteString(w)
+		for range []byte(w) {
+			owner = append(owner, i)
+		}
+	}
+
+	matched := make([]int, 0)
+	for _, loc := range regex.FindAllStringIndex(sb.String(), -1) {
+		for b := loc[0]; b < loc[1]; b++ {
+			if n := len(matched); n == 0 || matched[n-1] != owner[b] {
+				matched = append(matched, owner[b])
+			}
+		}
+	}
+	return matched
+}
+
+// patternUnitMatches returns the units matched by letters of the pattern, units matched by * and ? are left out
+func (lp *LetterPattern) patternUnitMatches(letters []string) []int {
+	// positions[k] is the letter matched by the kth element, -1 for stars
+	positions := make([]int, len(lp.elements))
+	e, u := 0, 0
+	starE, starU := -1, 0
+
+	for u < len(letters) {
+		switch {
+		case e < len(lp.elements) && lp.elements[e].star:
+			positions[e] = -1
+			starE, starU = e, u
+			e++
+		case e < len(lp.elements) && lp.elements[e].matches(letters[u]):
+			positions[e] = u
+			e++
+			u++
+		case starE >= 0:
+			starU++
+			e, u = starE+1, starU
+		default:
+			return nil
+		}
+	}
+	for e < len(lp.elements) && lp.elements[e].star {
+		positions[e] = -1
+		e++
+	}
+	if e != len(lp.elements) {
+		return nil
+	}
+
+	matched := make([]int, 0, len(positions))
+	for i, p := range positions {
+		if p >= 0 && lp.elements[i].letters != nil {
+			matched = append(matched, p)
+		}
+	}
+	return matched
+}
+
+// unitSpans joins consecutive unit indices into spans of offsets
+func unitSpans(matched []int, units []matchUnit, offsets func(matchUnit) (int, int)) []*MatchSpan {
+	spans := make([]*MatchSpan, 0)
+	for i, m := range matched {
+		start, end := offsets(units[m])
+		if i > 0 && matched[i-1] == m-1 {
+			spans[len(spans)-1].End = int32(end)
+			continue
+		}
+		spans = append(spans, &MatchSpan{Start: int32(start), End: int32(end)})
+	}
+	return spans
+}
+
+func unitRange(from int, to int) []int {
+	r := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		r = append(r, i)
+	}
+	return r
+}
+
+// explainedField returns the field the search string of in is searched in
+func explainedField(in *SearchRequest, sr *SearchResult) SearchField {
+	if sr.Field != SearchField_AUTO {
+		return sr.Field
+	}
+	if in.SearchField != SearchField_AUTO && in.SearchField != SearchField_ALL {
+		return in.SearchField
+	}
+	if in.SearchType == SearchType_PATTERN {
+		return SearchField_OTTOMAN
+	}
+	// roots are found by the letters of their Arabic roots, Latin words are looked up to find them
+	if in.SearchType == SearchType_FAMILY && DetectField(in.SearchString) != SearchField_VISENC {
+		return SearchField_OTTOMAN
+	}
+	field := DetectField(in.SearchString)
+	matches := func(s string) bool { return strings.HasPrefix(s, in.SearchString) }
+	if in.SearchType == SearchType_REGEX {
+		if regex, err := regexp.Compile(in.SearchString); err == nil {
+			field = DetectField(RegexLiterals(regex))
+			matches = regex.MatchString
+		}
+	}
+	// words read as Turkish Latin and visenc are explained in the field the root is found by
+	if field == SearchField_ALL {
+		field = SearchField(TFint(!matches(sr.Root.TurkishLatin) && matches(visencSearchKey(sr.Root.Ottoman.GetVisenc())),
+			int(SearchField_VISENC), int(SearchField_TURKISH_LATIN)))
+	}
+	return field
+}
+
+// explainedIndex returns the name of the index roots are found in
+func explainedIndex(in *SearchRequest, field SearchField) string {
+	switch {
+	case in.Stem && field == SearchField_TURKISH_LATIN:
+		return "effectiveTurkishLatinIndex"
+	case in.Stem:
+		return "effectiveVisencIndex"
+	case field == SearchField_ABJAD:
+		return "abjadIndex"
+	case in.SearchType == SearchType_PATTERN:
+		return "patternLetterIndex"
+	case in.SearchType == SearchType_VARIANT:
+		return "variantIndex"
+	case in.SearchType == SearchType_FAMILY:
+		return "arabicRootIndex"
+	}
+
+	name := map[SearchField]string{
+		SearchField_TURKISH_LATIN: "turkishLatin",
+		SearchField_OTTOMAN:       "unicode",
+		SearchField_VISENC:        "visenc",
+	}[field]
+	if in.SearchType == SearchType_SUFFIX {
+		return name + "ReverseIndex"
+	}
+	return name + "Index"
+}
+
+// ExplainMatch finds the parts of the root of sr that match the search string of in and explains how the root is found.
+// Spans are rune offsets in the Ottoman spellings of the root before they are written in another script.
+func ExplainMatch(in *SearchRequest, sr *SearchResult) *Match {
+	if in.Query != "" {
+		return &Match{Index: "query", Normalizations: []string{}}
+	}
+
+	field := explainedField(in, sr)
+	m := Match{Field: field, Type: in.SearchType, Index: explainedIndex(in, field), Normalizations: make([]string, 0)}
+	root := sr.Root
+	query := in.SearchString
+
+	if field == SearchField_ABJAD {
+		return &m
+	}
+
+	var units, queryUnits []matchUnit
+	aligned := true
+	if field == SearchField_TURKISH_LATIN {
+		units = latinUnits(root.TurkishLatin)
+		queryUnits = latinUnits(query)
+	} else {
+		units, aligned = ottomanUnits(root.Ottoman.GetVisenc(), root.Ottoman.GetUnicode())
+		queryVisenc := query
+		if field == SearchField_OTTOMAN {
+			queryVisenc = ottomanProfile.UnicodeToVisenc(query)
+			if NormalizeArabic(query) != query {
+				m.Normalizations = append(m.Normalizations, "presentation forms")
+			}
+			if in.Script != Script_OTTOMAN_TURKISH {
+				m.Normalizations = append(m.Normalizations, "script")
+			}
+		}
+		queryUnits, _ = ottomanUnits(queryVisenc, "")
+		if IgnoreJoiners && (RemoveJoiners(query) != query || RemoveJoiners(root.Ottoman.GetVisenc()) != root.Ottoman.GetVisenc()) {
+			m.Normalizations = append(m.Normalizations, "joiners")
+		}
+		if (in.SearchType == SearchType_SUFFIX && field == SearchField_VISENC) || in.SearchType == SearchType_PATTERN || in.SearchType == SearchType_VARIANT {
+			m.Normalizations = append(m.Normalizations, "diacritics")
+		}
+	}
+
+	keys := unitKeys(units)
+	queryKeys := unitKeys(queryUnits)
+
+	var matched []int
+	switch {
+	case in.Stem:
+		m.Normalizations = append(m.Normalizations, "stem")
+		matched = unitRange(0, len(units))
+	case in.SearchType == SearchType_FUZZY:
+		matched = fuzzyUnits(queryKeys, keys)
+	case in.SearchType == SearchType_REGEX:
+		if regex, err := regexp.Compile(query); err == nil {
+			written := func(u matchUnit) string { return u.unicode }
+			if field == SearchField_VISENC {
+				written = func(u matchUnit) string { return u.visenc }
+			} else if field == SearchField_TURKISH_LATIN {
+				written = func(u matchUnit) string { return u.letter }
+			}
+			matched = regexUnits(regex, units, written)
+		}
+	case in.SearchType == SearchType_PATTERN:
+		if lp, err := GetScriptProfile(in.Script).CompilePattern(query); err == nil {
+			letters := make([]string, len(units))
+			for i, u := range units {
+				letters[i] = u.letter
+			}
+			matched = lp.patternUnitMatches(letters)
+		}
+	case in.SearchType == SearchType_SUFFIX:
+		reversedQuery := make([]string, len(queryKeys))
+		reversedKeys := make([]string, len(keys))
+		for i := range queryKeys {
+			reversedQuery[len(queryKeys)-1-i] = queryKeys[i]
+		}
+		for i := range keys {
+			reversedKeys[len(keys)-1-i] = keys[i]
+		}
+		matched = unitRange(len(keys)-prefixUnits(reversedQuery, reversedKeys), len(keys))
+	case in.SearchType == SearchType_VARIANT:
+		// the root is the search string or its variant as a whole
+		matched = unitRange(0, len(units))
+	case in.SearchType == SearchType_FAMILY:
+		m.Normalizations = append(m.Normalizations, "arabic root")
+		matched = arabicRootUnits(units)
+	default:
+		matched = unitRange(0, prefixUnits(queryKeys, keys))
+	}
+
+	for _, v := range sr.Variants {
+		m.Normalizations = append(m.Normalizations, "variant:"+v)
+	}
+	// singulars are found through the plurals in the search string
+	if sr.Relation != nil {
+		m.Normalizations = append(m.Normalizations, "relation:"+sr.Relation.Type.String())
+	}
+
+	if field == SearchField_TURKISH_LATIN {
+		m.TurkishLatinSpans = unitSpans(matched, units, func(u matchUnit) (int, int) { return u.visencStart, u.visencEnd })
+	} else {
+		m.VisencSpans = unitSpans(matched, units, func(u matchUnit) (int, int) { return u.visencStart, u.visencEnd })
+		if aligned {
+			m.UnicodeSpans = unitSpans(matched, units, func(u matchUnit) (int, int) { return u.unicodeStart, u.unicodeEnd })
+		}
+	}
+
+	m.Scores = []*ScoreComponent{
+		{Name: "coverage", Value: float64(len(matched)) / float64(TFint(len(units) > 0, len(units), 1))},
+		{Name: "spans", Value: float64(len(m.TurkishLatinSpans) + len(m.VisencSpans))},
+	}
+	// letters of regular expressions and patterns are not letters of words
+	if in.SearchType != SearchType_REGEX && in.SearchType != SearchType_PATTERN && in.SearchType != SearchType_FAMILY {
+		m.Scores = append(m.Scores, &ScoreComponent{Name: "lengthDifference", Value: float64(len(units) - len(queryUnits))})
+	}
+	if in.SearchField == SearchField_AUTO || in.SearchField == SearchField_ALL {
+		for _, fs := range ScoreFields(query) {
+			if fs.Field == field {
+				m.Scores = append(m.Scores, &ScoreComponent{Name: "fieldScore", Value: fs.Score})
+			}
+		}
+	}
+	if len(sr.Suffixes) > 0 {
+		m.Scores = append(m.Scores, &ScoreComponent{Name: "suffixes", Value: float64(len(sr.Suffixes))})
+	}
+	if len(sr.Variants) > 0 {
+		m.Scores = append(m.Scores, &ScoreComponent{Name: "variants", Value: float64(len(sr.Variants))})
+	}
+
+	return &m
+}
+
+// RootResults wraps roots in search results
+func RootResults(roots []*Root) []*SearchResult {
+	results := make([]*SearchResult, len(roots))
+	for i, r := range roots {
+		results[i] = &SearchResult{Root: r}
+	}
+	return results
+}
+
+// ExplainResults sets the Match of each result found for in. Roots without results are wrapped in results.
+func ExplainResults(in *SearchRequest, rs *RootSet) {
+	if len(rs.Results) == 0 {
+		rs.Results = RootResults(rs.Roots)
+	}
+	for _, sr := range rs.Results {
+		sr.Match = ExplainMatch(in, sr)
+	}
+}
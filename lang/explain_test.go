@@ -0,0 +1,90 @@
+package lang
+
+import (
+	"fmt"
+	"testing"
+)
+
+func spansString(spans []*MatchSpan) string {
+	out := ""
+	for _, s := range spans {
+		out += fmt.Sprintf("[%d,%d)", s.Start, s.End)
+	}
+	return out
+}
+
+// func ExplainMatch(in *SearchRequest, sr *SearchResult) *Match {
+func TestExplainMatch(t *testing.T) {
+	kitap := NewRoot("kitap", "kbo2ebu1", PartOfSpeech_NOUN)
+	kitabi := NewRoot("kitabı", "kbo2o4ebu1y", PartOfSpeech_NOUN)
+
+	type testCase struct {
+		in      *SearchRequest
+		root    *Root
+		latin   string
+		unicode string
+		visenc  string
+		index   string
+	}
+
+	testCases := []testCase{
+		{&SearchRequest{SearchField: SearchField_TURKISH_LATIN, SearchType: SearchType_FUZZY, SearchString: "ktp"}, kitap, "[0,1)[2,3)[4,5)", "", "", "turkishLatinIndex"},
+		{&SearchRequest{SearchField: SearchField_TURKISH_LATIN, SearchType: SearchType_SUFFIX, SearchString: "ap"}, kitap, "[3,5)", "", "", "turkishLatinReverseIndex"},
+		{&SearchRequest{SearchField: SearchField_AUTO, SearchType: SearchType_REGEX, SearchString: "t.p$"}, kitap, "[2,5)", "", "", "turkishLatinIndex"},
+		{&SearchRequest{SearchField: SearchField_OTTOMAN, SearchType: SearchType_PREFIX, SearchString: "کت"}, kitap, "", "[0,2)", "[0,4)", "unicodeIndex"},
+		// the vowel mark after t is a part of the matched letter
+		{&SearchRequest{SearchField: SearchField_AUTO, SearchType: SearchType_PREFIX, SearchString: "کت"}, kitabi, "", "[0,3)", "[0,6)", "unicodeIndex"},
+		{&SearchRequest{SearchField: SearchField_VISENC, SearchType: SearchType_FUZZY, SearchString: "kebu1"}, kitap, "", "[0,1)[2,4)", "[0,1)[4,8)", "visencIndex"},
+		{&SearchRequest{SearchField: SearchField_VISENC, SearchType: SearchType_SUFFIX, SearchString: "ebu1y"}, kitabi, "", "[3,6)", "[6,11)", "visencReverseIndex"},
+		{&SearchRequest{SearchField: SearchField_OTTOMAN, SearchType: SearchType_REGEX, SearchString: "ت.ب"}, kitap, "", "[1,4)", "[1,8)", "unicodeIndex"},
+		{&SearchRequest{SearchField: SearchField_AUTO, SearchType: SearchType_PATTERN, SearchString: "k?ebu1"}, kitap, "", "[0,1)[2,4)", "[0,1)[4,8)", "patternLetterIndex"},
+		// ی and ي are the same letter
+		{&SearchRequest{SearchField: SearchField_OTTOMAN, SearchType: SearchType_SUFFIX, SearchString: "بي"}, kitabi, "", "[4,6)", "[7,11)", "unicodeReverseIndex"},
+		{&SearchRequest{SearchField: SearchField_TURKISH_LATIN, SearchType: SearchType_PREFIX, SearchString: "kitap", Stem: true}, kitap, "[0,5)", "", "", "effectiveTurkishLatinIndex"},
+	}
+
+	for _, tc := range testCases {
+		m := ExplainMatch(tc.in, &SearchResult{Root: tc.root})
+		latin, unicode, visenc := spansString(m.TurkishLatinSpans), spansString(m.UnicodeSpans), spansString(m.VisencSpans)
+		if latin != tc.latin || unicode != tc.unicode || visenc != tc.visenc || m.Index != tc.index {
+			t.Log(fmt.Sprintf("%s in %s should match %s %s %s in %s: %s %s %s in %s", tc.in.SearchString, tc.root.Ottoman.Visenc,
+				tc.latin, tc.unicode, tc.visenc, tc.index, latin, unicode, visenc, m.Index))
+			t.Fail()
+		}
+	}
+
+	m := ExplainMatch(&SearchRequest{SearchField: SearchField_OTTOMAN, SearchType: SearchType_VARIANT, SearchString: "كتاب"}, &SearchResult{Root: kitap, Variants: []string{"arabic-kaf"}})
+	if !CompareStringSlices(m.Normalizations, []string{"diacritics", "variant:arabic-kaf"}) {
+		t.Log(fmt.Sprintf("Variant match should be normalized by diacritics and arabic-kaf: %s", m.Normalizations))
+		t.Fail()
+	}
+
+	m = ExplainMatch(&SearchRequest{SearchField: SearchField_ABJAD, SearchString: "423"}, &SearchResult{Root: kitap})
+	if m.Index != "abjadIndex" || len(m.UnicodeSpans) > 0 {
+		t.Log(fmt.Sprintf("Abjad match should have no spans: %s", m))
+		t.Fail()
+	}
+}
+
+// func ottomanUnits(visenc string, unicode string) ([]matchUnit, bool) {
+func TestOttomanUnits(t *testing.T) {
+	testDict := map[string][]string{
+		"kbo2ebu1":      {"k", "bo2", "e", "bu1"},
+		"kbo2o4ebu1y":   {"k", "bo2o4", "e", "bu1", "y"},
+		"fo2lah||":      {"fo2", "l", "a", "h||"},
+		"o4ad r":        {"o4a", "d", " ", "r"},
+		"kbo2o4ebu1y||": {"k", "bo2o4", "e", "bu1", "y||"},
+	}
+
+	for v, o := range testDict {
+		units, aligned := ottomanUnits(v, VisencToUnicode(v))
+		written := make([]string, len(units))
+		for i, u := range units {
+			written[i] = u.visenc
+		}
+		if !aligned || !CompareStringSlices(written, o) {
+			t.Log(fmt.Sprintf("%s should be split into %s: %s %t", v, o, written, aligned))
+			t.Fail()
+		}
+	}
+}
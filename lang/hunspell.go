@@ -0,0 +1,598 @@
+package lang
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// hunspellNeedAffix is the flag of stems that are words only with a suffix, like kitab of kitabı
+const hunspellNeedAffix = 1
+
+// hardConsonants are the letters of a Latin stem ending with a hard consonant in Hunspell conditions
+const hardConsonants = "çfhkpsşt"
+
+// hunspellIgnored are the Arabic diacritics Hunspell ignores in Ottoman words, as most texts don't write them
+const hunspellIgnored = "\u064B\u064C\u064D\u064E\u064F\u0650\u0651\u0652"
+
+// HunspellDictionary is the content of a Hunspell dictionary and affix file pair
+type HunspellDictionary struct {
+	Aff string
+	Dic string
+}
+
+// hunspellClass keeps the suffixes sharing a Hunspell flag. Suffixes of a class attach to stems with the same
+// last vowel and ending, and to the same stem of roots with alternations.
+type hunspellClass struct {
+	flag int
+	// lastVowel is the requiredLastVowel of the suffixes
+	lastVowel string
+	// harmony has the last vowels of stems the suffixes harmonize with
+	harmony string
+	// endings of stems the suffixes attach to, V for a vowel, H for a hard and S for a soft consonant
+	endings string
+	// vowelInitial suffixes attach to the stems of roots with alternations
+	vowelInitial bool
+	// apostrophe suffixes attach only to proper nouns
+	apostrophe bool
+	suffixes   []*Suffix
+}
+
+// accepts checks whether the suffixes of the class attach to a stem with lastVowel and ending.
+// A class attaches to stems with its requiredLastVowel, and to other stems its suffixes harmonize with.
+func (hc *hunspellClass) accepts(lastVowel string, ending string) bool {
+	if !strings.Contains(hc.endings, ending) {
+		return false
+	}
+	return lastVowel == hc.lastVowel || (strings.Contains(hc.harmony, lastVowel) && strings.Contains(hc.harmony, hc.lastVowel))
+}
+
+// stemEnding returns the ending of a Turkish Latin stem compared with suffix requirements
+func stemEnding(latin string) string {
+	switch {
+	case EndsWithVowel(latin):
+		return "V"
+	case LastConsonantHard(latin):
+		return "H"
+	}
+	return "S"
+}
+
+// suffixEndings returns the endings of stems a suffix attaches to. requiresEndsWithVowel and
+// requiresLastConsonantHard are used unless they contradict the first letter of the suffix, which is checked as in
+// lemmatizing: vowels don't follow vowels, d and c follow vowels and soft consonants, t and ç hard consonants.
+func suffixEndings(s *Suffix, surface string) string {
+	required := "VHS"
+	switch s.RequiresEndsWithVowel {
+	case Req_ALWAYS:
+		required = "V"
+	case Req_NEVER:
+		required = "HS"
+	}
+	switch s.RequiresLastConsonantHard {
+	case Req_ALWAYS:
+		required = strings.ReplaceAll(required, "S", "")
+	case Req_NEVER:
+		required = strings.ReplaceAll(required, "H", "")
+	}
+
+	written := "VHS"
+	switch first := []rune(surface)[0]; {
+	case EndsWithVowel(string(first)):
+		written = "HS"
+	case first == 'd' || first == 'c':
+		written = "VS"
+	case first == 't' || first == 'ç':
+		written = "H"
+	}
+
+	endings := ""
+	for _, e := range required {
+		if strings.ContainsRune(written, e) {
+			endings += string(e)
+		}
+	}
+	if endings == "" {
+		return written
+	}
+	return endings
+}
+
+// hunspellCondition returns the condition of a Hunspell suffix entry on the last letter of a Latin stem with endings
+func hunspellCondition(endings string) string {
+	letters := ""
+	if strings.Contains(endings, "V") {
+		letters += allVowels
+	}
+	if strings.Contains(endings, "H") {
+		letters += hardConsonants
+	}
+	switch {
+	case len(endings) == 3:
+		return "."
+	case !strings.Contains(endings, "S"):
+		return "[" + letters + "]"
+	case endings == "S":
+		return "[^" + allVowels + hardConsonants + "]"
+	case strings.Contains(endings, "V"):
+		return "[^" + hardConsonants + "]"
+	}
+	return "[^" + allVowels + "]"
+}
+
+// hunspellClasses groups suffixes by the stems they attach to and numbers the classes as Hunspell flags
+func hunspellClasses(suffixes []*Suffix) []*hunspellClass {
+	classes := make(map[string]*hunspellClass)
+	for _, s := range suffixes {
+		surface := strings.TrimPrefix(s.TurkishLatin, "'")
+		if surface == "" || s.Ottoman == nil || strings.ContainsAny(s.TurkishLatin+s.Ottoman.Unicode, " /") {
+			continue
+		}
+		harmony := ""
+		for _, v := range allVowels {
+			if Harmonizes(string(v), surface) {
+				harmony += string(v)
+			}
+		}
+		first := []rune(s.TurkishLatin)[0]
+		hc := hunspellClass{
+			lastVowel:    s.RequiredLastVowel,
+			harmony:      harmony,
+			endings:      suffixEndings(s, surface),
+			vowelInitial: EndsWithVowel(string(first)),
+			apostrophe:   first == '\'',
+		}
+		key := fmt.Sprintf("%s %s %s %t %t", hc.lastVowel, hc.harmony, hc.endings, hc.vowelInitial, hc.apostrophe)
+		if _, exists := classes[key]; !exists {
+			classes[key] = &hc
+		}
+		classes[key].suffixes = append(classes[key].suffixes, s)
+	}
+
+	keys := make([]string, 0, len(classes))
+	for k := range classes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]*hunspellClass, len(keys))
+	for i, k := range keys {
+		out[i] = classes[k]
+		out[i].flag = hunspellNeedAffix + 1 + i
+	}
+	return out
+}
+
+// hunspellFlags returns the flags of classes attaching to a stem as a Hunspell flag list
+func hunspellFlags(classes []*hunspellClass, accepts func(*hunspellClass) bool, flags ...int) string {
+	for _, hc := range classes {
+		if accepts(hc) {
+			flags = append(flags, hc.flag)
+		}
+	}
+	out := make([]string, len(flags))
+	for i, f := range flags {
+		out[i] = strconv.Itoa(f)
+	}
+	return strings.Join(out, ",")
+}
+
+// ExportHunspell writes roots and the suffixes of a suffix set as a Hunspell dictionary in Ottoman script,
+// or in Turkish Latin transcription if latin is set. Suffixes may follow another suffix as continuation classes.
+// Roots with alternations have their stems before vowel-initial suffixes as entries needing a suffix.
+func ExportHunspell(roots []*Root, suffixes []*Suffix, latin bool) *HunspellDictionary {
+	classes := hunspellClasses(suffixes)
+
+	// words are kept with their flags, the same spelling may be a root and the stem of another root
+	words := make(map[string]map[string]bool)
+	letterCounts := make(map[rune]int)
+	addWord := func(word string, flags string) {
+		if word == "" || strings.ContainsAny(word, " /") {
+			return
+		}
+		entry := word
+		if flags != "" {
+			entry += "/" + flags
+		}
+		if words[word] == nil {
+			words[word] = make(map[string]bool)
+			for _, r := range word {
+				if unicode.IsLetter(r) {
+					letterCounts[r]++
+				}
+			}
+		}
+		words[word][entry] = true
+	}
+
+	for _, r := range roots {
+		if r.Ottoman == nil {
+			continue
+		}
+		// classes attaching to a stem, vowelInitial selects the classes of vowel-initial or other suffixes
+		accepts := func(stem string, vowelInitial ...bool) func(*hunspellClass) bool {
+			lastVowel, ending := EffectiveLastVowel(stem), stemEnding(stem)
+			return func(hc *hunspellClass) bool {
+				return (len(vowelInitial) == 0 || hc.vowelInitial == vowelInitial[0]) &&
+					(!hc.apostrophe || r.PartOfSpeech == PartOfSpeech_PROPER_NOUN) &&
+					hc.accepts(lastVowel, ending)
+			}
+		}
+
+		root, stem := r.Ottoman.Unicode, VisencToUnicode(r.EffectiveVisenc)
+		stemLatin, _ := VowelStem(r)
+		if latin {
+			root, stem = r.TurkishLatin, stemLatin
+		}
+		if stemLatin == r.TurkishLatin || stem == root {
+			addWord(root, hunspellFlags(classes, accepts(r.TurkishLatin)))
+			continue
+		}
+		addWord(root, hunspellFlags(classes, accepts(r.TurkishLatin, false)))
+		addWord(stem, hunspellFlags(classes, accepts(stemLatin, true), hunspellNeedAffix))
+	}
+
+	var aff strings.Builder
+	aff.WriteString("SET UTF-8\nFLAG num\n")
+	if latin {
+		// Turkish casing of i and ı
+		aff.WriteString("LANG tr_TR\nWORDCHARS '\n")
+	} else {
+		aff.WriteString("LANG ota\nWORDCHARS \u200C\u200D\n")
+		aff.WriteString("IGNORE " + hunspellIgnored + "\n")
+	}
+	letters := make([]rune, 0, len(letterCounts))
+	for r := range letterCounts {
+		letters = append(letters, r)
+	}
+	sort.Slice(letters, func(i, j int) bool {
+		if letterCounts[letters[i]] != letterCounts[letters[j]] {
+			return letterCounts[letters[i]] > letterCounts[letters[j]]
+		}
+		return letters[i] < letters[j]
+	})
+	fmt.Fprintf(&aff, "TRY %s\n", string(letters))
+	fmt.Fprintf(&aff, "NEEDAFFIX %d\n", hunspellNeedAffix)
+
+	for _, hc := range classes {
+		entries := make([]string, 0)
+		seen := make(map[string]bool)
+		for _, s := range hc.suffixes {
+			// suffixes may be followed by the classes attaching to the word they make, except apostrophe suffixes
+			latinSurface := strings.TrimPrefix(s.TurkishLatin, "'")
+			lastVowel := s.SetsLastVowelTo
+			if lastVowel == "" {
+				lastVowel = EffectiveLastVowel(latinSurface)
+			}
+			ending := TFstring(s.EndsWithVowel, "V", stemEnding(latinSurface))
+			continuation := make([]int, 0)
+			if s.RequiresContinuationSuffix == Req_ALWAYS {
+				continuation = append(continuation, hunspellNeedAffix)
+			}
+			flags := hunspellFlags(classes, func(next *hunspellClass) bool {
+				return !next.apostrophe && next.accepts(lastVowel, ending)
+			}, continuation...)
+
+			condition := "."
+			forms := []string{s.TurkishLatin}
+			if latin {
+				condition = hunspellCondition(hc.endings)
+			} else {
+				// suffixes after a zero width non-joiner are also written without it
+				forms = []string{s.Ottoman.Unicode, strings.TrimPrefix(s.Ottoman.Unicode, "\u200C")}
+			}
+			for _, form := range forms {
+				entry := fmt.Sprintf("SFX %d 0 %s", hc.flag, form)
+				if flags != "" {
+					entry += "/" + flags
+				}
+				entry += " " + condition
+				if form != "" && !seen[entry] {
+					seen[entry] = true
+					entries = append(entries, entry)
+				}
+			}
+		}
+		fmt.Fprintf(&aff, "\nSFX %d Y %d\n", hc.flag, len(entries))
+		for _, e := range entries {
+			aff.WriteString(e + "\n")
+		}
+	}
+
+	lines := make([]string, 0, len(words))
+	for _, entries := range words {
+		for e := range entries {
+			lines = append(lines, e)
+		}
+	}
+	sort.Strings(lines)
+	dic := strconv.Itoa(len(lines)) + "\n" + strings.Join(lines, "\n") + "\n"
+
+	return &HunspellDictionary{Aff: aff.String(), Dic: dic}
+}
+
+// Save writes the dictionary to filename.aff and filename.dic
+func (hd *HunspellDictionary) Save(filename string) error {
+	if err := os.WriteFile(filename+".aff", []byte(hd.Aff), 0644); err != nil {
+		return err
+	}
+	return os.WriteFile(filename+".dic", []byte(hd.Dic), 0644)
+}
+
+// hunspellConditionSet is a set of letters in a Hunspell condition, conditions have one for each last letter of a stem
+type hunspellConditionSet struct {
+	letters string
+	negated bool
+	any     bool
+}
+
+// hunspellSuffix is a suffix entry of a Hunspell affix file
+type hunspellSuffix struct {
+	flag         int
+	strip        string
+	add          string
+	continuation map[int]bool
+	condition    []hunspellConditionSet
+}
+
+// matches checks whether the condition of the suffix holds for the end of stem
+func (hs *hunspellSuffix) matches(stem string) bool {
+	runes := []rune(stem)
+	if len(runes) < len(hs.condition) {
+		return false
+	}
+	runes = runes[len(runes)-len(hs.condition):]
+	for i, c := range hs.condition {
+		if !c.any && strings.ContainsRune(c.letters, runes[i]) == c.negated {
+			return false
+		}
+	}
+	return true
+}
+
+// HunspellChecker checks words with a Hunspell dictionary. Suffixes are stripped twice at most, prefixes,
+// compounds and suggestions are not supported.
+type HunspellChecker struct {
+	// words keep the flags of each entry of a word
+	words     map[string][]map[int]bool
+	suffixes  map[string][]*hunspellSuffix
+	needAffix int
+	numFlags  bool
+	ignored   string
+}
+
+// parseFlags reads a flag list as numbers separated by commas, or as one character flags
+func (hc *HunspellChecker) parseFlags(s string) (map[int]bool, error) {
+	flags := make(map[int]bool)
+	if s == "" {
+		return flags, nil
+	}
+	if !hc.numFlags {
+		for _, r := range s {
+			flags[int(r)] = true
+		}
+		return flags, nil
+	}
+	for _, f := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, fmt.Errorf("Flag %s is not a number", f)
+		}
+		flags[n] = true
+	}
+	return flags, nil
+}
+
+// parseCondition reads a Hunspell condition like [^aeı]r or .
+func parseCondition(s string) ([]hunspellConditionSet, error) {
+	condition := make([]hunspellConditionSet, 0)
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '.':
+			condition = append(condition, hunspellConditionSet{any: true})
+		case '[':
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("Condition %s has an unclosed [", s)
+			}
+			set := hunspellConditionSet{letters: string(runes[i+1 : end])}
+			if strings.HasPrefix(set.letters, "^") {
+				set.letters, set.negated = set.letters[1:], true
+			}
+			condition = append(condition, set)
+			i = end
+		default:
+			condition = append(condition, hunspellConditionSet{letters: string(runes[i])})
+		}
+	}
+	// a single . matches every stem
+	if len(condition) == 1 && condition[0].any {
+		return nil, nil
+	}
+	return condition, nil
+}
+
+// ParseHunspell reads a Hunspell affix and dictionary file pair
+func ParseHunspell(aff io.Reader, dic io.Reader) (*HunspellChecker, error) {
+	hc := HunspellChecker{words: make(map[string][]map[int]bool), suffixes: make(map[string][]*hunspellSuffix), needAffix: -1}
+	// suffix headers are read before their entries
+	headers := make(map[string]bool)
+
+	scanner := bufio.NewScanner(aff)
+	scanner.Buffer(make([]byte, 1024*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		switch fields[0] {
+		case "FLAG":
+			if fields[1] != "num" {
+				return nil, fmt.Errorf("Line %d: only numeric flags are supported: %s", line, fields[1])
+			}
+			hc.numFlags = true
+		case "IGNORE":
+			hc.ignored = fields[1]
+		case "NEEDAFFIX":
+			flags, err := hc.parseFlags(fields[1])
+			if err != nil || len(flags) != 1 {
+				return nil, fmt.Errorf("Line %d: NEEDAFFIX should have a single flag: %s", line, fields[1])
+			}
+			for f := range flags {
+				hc.needAffix = f
+			}
+		case "PFX":
+			return nil, fmt.Errorf("Line %d: prefixes are not supported", line)
+		case "SFX":
+			if !headers[fields[1]] {
+				headers[fields[1]] = true
+				continue
+			}
+			if len(fields) < 5 {
+				return nil, fmt.Errorf("Line %d: suffix entries should have a flag, strip, affix and condition", line)
+			}
+			flag, err := hc.parseFlags(fields[1])
+			if err != nil || len(flag) != 1 {
+				return nil, fmt.Errorf("Line %d: suffix entries should have a single flag: %s", line, fields[1])
+			}
+			hs := hunspellSuffix{strip: strings.TrimPrefix(fields[2], "0")}
+			for f := range flag {
+				hs.flag = f
+			}
+			add := strings.SplitN(fields[3], "/", 2)
+			hs.add = strings.TrimPrefix(add[0], "0")
+			if len(add) > 1 {
+				if hs.continuation, err = hc.parseFlags(add[1]); err != nil {
+					return nil, fmt.Errorf("Line %d: %s", line, err)
+				}
+			}
+			if hs.condition, err = parseCondition(fields[4]); err != nil {
+				return nil, fmt.Errorf("Line %d: %s", line, err)
+			}
+			hc.suffixes[hs.add] = append(hc.suffixes[hs.add], &hs)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	scanner = bufio.NewScanner(dic)
+	scanner.Buffer(make([]byte, 1024*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		// the first line is the number of words
+		if line == 1 || text == "" {
+			continue
+		}
+		entry := strings.SplitN(strings.Fields(text)[0], "/", 2)
+		flags := make(map[int]bool)
+		if len(entry) > 1 {
+			var err error
+			if flags, err = hc.parseFlags(entry[1]); err != nil {
+				return nil, fmt.Errorf("Dictionary line %d: %s", line, err)
+			}
+		}
+		word := hc.ignore(entry[0])
+		hc.words[word] = append(hc.words[word], flags)
+	}
+
+	return &hc, scanner.Err()
+}
+
+// LoadHunspell reads filename.aff and filename.dic
+func LoadHunspell(filename string) (*HunspellChecker, error) {
+	aff, err := os.Open(filename + ".aff")
+	if err != nil {
+		return nil, err
+	}
+	defer aff.Close()
+	dic, err := os.Open(filename + ".dic")
+	if err != nil {
+		return nil, err
+	}
+	defer dic.Close()
+	return ParseHunspell(aff, dic)
+}
+
+// ignore removes the characters Hunspell ignores from word
+func (hc *HunspellChecker) ignore(word string) string {
+	if hc.ignored == "" {
+		return word
+	}
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(hc.ignored, r) {
+			return -1
+		}
+		return r
+	}, word)
+}
+
+// hasStem checks whether an entry of word has flag. Words needing a suffix are accepted only with a flag.
+func (hc *HunspellChecker) hasStem(word string, flag int) bool {
+	for _, flags := range hc.words[word] {
+		if flag < 0 && !flags[hc.needAffix] || flag >= 0 && flags[flag] {
+			return true
+		}
+	}
+	return false
+}
+
+// strip returns the suffixes whose affix ends word with their stems
+func (hc *HunspellChecker) strip(word string) ([]*hunspellSuffix, []string) {
+	suffixes := make([]*hunspellSuffix, 0)
+	stems := make([]string, 0)
+	for i := range word {
+		// stems are not empty
+		if i == 0 {
+			continue
+		}
+		for _, hs := range hc.suffixes[word[i:]] {
+			if stem := word[:i] + hs.strip; hs.matches(stem) {
+				suffixes = append(suffixes, hs)
+				stems = append(stems, stem)
+			}
+		}
+	}
+	return suffixes, stems
+}
+
+// Check checks whether word is in the dictionary, with at most two suffixes. Capitalized words are also checked
+// in lowercase.
+func (hc *HunspellChecker) Check(word string) bool {
+	word = hc.ignore(word)
+	if hc.check(word) {
+		return true
+	}
+	lower := TurkishLower(word)
+	return lower != word && hc.check(lower)
+}
+
+func (hc *HunspellChecker) check(word string) bool {
+	if hc.hasStem(word, -1) {
+		return true
+	}
+	outer, stems := hc.strip(word)
+	for i, hs := range outer {
+		if hs.continuation[hc.needAffix] {
+			continue
+		}
+		if hc.hasStem(stems[i], hs.flag) {
+			return true
+		}
+		inner, innerStems := hc.strip(stems[i])
+		for j, is := range inner {
+			if is.continuation[hs.flag] && hc.hasStem(innerStems[j], is.flag) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// hunspellTestDictionary exports a few roots with plural, accusative, locative and proper noun suffixes
+func hunspellTestDictionary(t *testing.T, latin bool) *HunspellChecker {
+	roots := []*Root{
+		NewRoot("kitap", "kbo2ebu1", PartOfSpeech_NOUN),
+		NewRoot("kalem", "fo2lm", PartOfSpeech_NOUN),
+		NewRoot("ev", "eo2w", PartOfSpeech_NOUN),
+		NewRoot("Ahmet", "exmdo2", PartOfSpeech_PROPER_NOUN),
+	}
+
+	suffix := func(latin string, visenc string, lastVowel string, endsWithVowel Req, lastConsonantHard Req) *Suffix {
+		ow, _ := MakeOttomanWord(visenc, "")
+		return &Suffix{
+			TurkishLatin:              latin,
+			Ottoman:                   ow,
+			RequiredLastVowel:         lastVowel,
+			RequiresEndsWithVowel:     endsWithVowel,
+			RequiresLastConsonantHard: lastConsonantHard,
+			SetsLastVowelTo:           EffectiveLastVowel(latin),
+			EndsWithVowel:             EndsWithVowel(latin),
+		}
+	}
+	suffixes := []*Suffix{
+		suffix("lar", "lr", "a", Req_MAYBE, Req_MAYBE),
+		suffix("ler", "||lr", "e", Req_MAYBE, Req_MAYBE),
+		suffix("ı", "y", "a", Req_NEVER, Req_MAYBE),
+		suffix("i", "y", "e", Req_NEVER, Req_MAYBE),
+		suffix("da", "dh", "a", Req_MAYBE, Req_NEVER),
+		suffix("ta", "dh", "a", Req_NEVER, Req_ALWAYS),
+		suffix("de", "dh", "e", Req_MAYBE, Req_NEVER),
+		suffix("'e", "h", "e", Req_NEVER, Req_MAYBE),
+	}
+
+	hd := ExportHunspell(roots, suffixes, latin)
+	hc, err := ParseHunspell(strings.NewReader(hd.Aff), strings.NewReader(hd.Dic))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hc
+}
+
+// func ExportHunspell(roots []*Root, suffixes []*Suffix, latin bool) *HunspellDictionary {
+func TestExportHunspell(t *testing.T) {
+	testDict := map[string]bool{
+		"kitap":      true,
+		"kitaplar":   true,
+		"kitapta":    true,
+		"kitabı":     true,
+		"kitaplarda": true,
+		"kalemler":   true,
+		"kalemde":    true,
+		"kalemlerde": true,
+		"Kalemi":     true,
+		"evde":       true,
+		"Ahmet'e":    true,
+		// stems before vowels are words only with a suffix
+		"kitab":           false,
+		"kitapı":          false,
+		"kitapda":         false,
+		"kalemlar":        false,
+		"kalemta":         false,
+		"evda":            false,
+		"kalem'e":         false,
+		"kalemlerdelerde": false,
+	}
+
+	hc := hunspellTestDictionary(t, true)
+	for w, e := range testDict {
+		if hc.Check(w) != e {
+			t.Log(fmt.Sprintf("Latin %s should be %t for Check", w, e))
+			t.Fail()
+		}
+	}
+
+	testDict = map[string]bool{
+		"کتاب":        true,
+		"کتابلر":      true,
+		"کتابی":       true,
+		"کتابلرده":    true,
+		"قلم\u200cلر": true,
+		"قلملر":       true,
+		"اوده":        true,
+		// harakat are ignored
+		"کِتاب":     true,
+		"کتب":       false,
+		"کتابم":     false,
+		"قلملرلرلر": false,
+	}
+
+	hc = hunspellTestDictionary(t, false)
+	for w, e := range testDict {
+		if hc.Check(w) != e {
+			t.Log(fmt.Sprintf("Ottoman %s should be %t for Check", w, e))
+			t.Fail()
+		}
+	}
+}
+
+// func ParseHunspell(aff io.Reader, dic io.Reader) (*HunspellChecker, error) {
+func TestParseHunspell(t *testing.T) {
+	badAffixes := map[string]string{
+		"character flags": "FLAG UTF-8\n",
+		"prefix":          "FLAG num\nPFX 2 Y 1\nPFX 2 0 ön .\n",
+		"unclosed [":      "FLAG num\nSFX 2 Y 1\nSFX 2 0 lar [^e\n",
+		"bad flag":        "FLAG num\nSFX 2 Y 1\nSFX 2 0 lar/x .\n",
+		"no condition":    "FLAG num\nSFX 2 Y 1\nSFX 2 0 lar\n",
+	}
+
+	for i, o := range badAffixes {
+		if _, err := ParseHunspell(strings.NewReader(o), strings.NewReader("0\n")); err == nil {
+			t.Log(fmt.Sprintf("%s should fail ParseHunspell", i))
+			t.Fail()
+		}
+	}
+
+	aff := "FLAG num\nSFX 2 Y 2\nSFX 2 0 ler [^aeıioöuü]\nSFX 2 k ğı k\n"
+	hc, err := ParseHunspell(strings.NewReader(aff), strings.NewReader("2\nev/2\nkök/2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for w, e := range map[string]bool{"evler": true, "köğı": true, "evğı": false, "kökler": true, "ler": false} {
+		if hc.Check(w) != e {
+			t.Log(fmt.Sprintf("%s should be %t for Check", w, e))
+			t.Fail()
+		}
+	}
+}
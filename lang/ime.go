@@ -0,0 +1,423 @@
+package lang
+
+import (
+	_ "embed" // default phonetic rules are embedded
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed data/phonetic.csv
+var defaultPhoneticData string
+
+// MAXCANDIDATES is the default number of Ottoman spellings returned for a phonetic input
+const MAXCANDIDATES = 10
+
+// phoneticGuesses is the number of guesses returned before completions
+const phoneticGuesses = 3
+
+// phoneticBeam is the number of partial guesses kept at each letter of the input
+const phoneticBeam = 32
+
+// costs of letters without rules and of writing doubled consonants twice
+const (
+	unknownLetterCost = 5
+	doubledLetterCost = 0.5
+)
+
+// Positions and contexts of phonetic rules
+const (
+	PhoneticInitial = "initial"
+	PhoneticMedial  = "medial"
+	PhoneticFinal   = "final"
+	PhoneticBack    = "back"
+	PhoneticFront   = "front"
+)
+
+// PhoneticRule writes Latin letters typed phonetically with Ottoman letters
+type PhoneticRule struct {
+	Latin    string
+	Visenc   string
+	Position string
+	Context  string
+	Cost     float64
+}
+
+// PhoneticTable keeps phonetic rules by the first letter of their Latin
+type PhoneticTable struct {
+	rules map[rune][]*PhoneticRule
+}
+
+var phoneticTable = MustParsePhoneticRules(strings.NewReader(defaultPhoneticData))
+
+var phoneticIndex *KeyIndex
+
+// phoneticDigraphs are typed for Turkish letters on keyboards without them
+var phoneticDigraphs = strings.NewReplacer("sh", "ş", "ch", "ç", "kh", "h", "gh", "ğ", "zh", "j")
+
+// phoneticFolds read Turkish letters and circumflexes as the letters typed for them
+var phoneticFolds = strings.NewReplacer("ç", "c", "ş", "s", "ğ", "g", "ı", "i", "ö", "o", "ü", "u", "â", "a", "î", "i", "û", "u", "x", "h", "q", "k", "w", "v")
+
+var circumflexFolds = strings.NewReplacer("â", "a", "î", "i", "û", "u")
+
+// ParsePhoneticRules reads phonetic rules in CSV format. Lines beginning with # are comments.
+func ParsePhoneticRules(reader io.Reader) (*PhoneticTable, error) {
+	csvr := csv.NewReader(reader)
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 5
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	pt := PhoneticTable{rules: make(map[rune][]*PhoneticRule)}
+
+	for i, record := range records {
+		if i == 0 && record[0] == "latin" {
+			continue
+		}
+
+		rule := PhoneticRule{
+			Latin:    TurkishLower(strings.TrimSpace(record[0])),
+			Visenc:   strings.TrimSpace(record[1]),
+			Position: strings.TrimSpace(record[2]),
+			Context:  strings.TrimSpace(record[3]),
+		}
+
+		if rule.Latin == "" {
+			return nil, fmt.Errorf("Phonetic rule %d: empty latin", i)
+		}
+		for _, l := range SplitVisenc(rule.Visenc, true) {
+			if _, exists := VisencToUnicodeMap[l]; !exists {
+				return nil, fmt.Errorf("Phonetic rule for %s: %s is not a visenc letter", rule.Latin, l)
+			}
+		}
+		switch rule.Position {
+		case "", PhoneticInitial, PhoneticMedial, PhoneticFinal:
+		default:
+			return nil, fmt.Errorf("Phonetic rule for %s: unknown position %s", rule.Latin, rule.Position)
+		}
+		switch rule.Context {
+		case "", PhoneticBack, PhoneticFront:
+		default:
+			return nil, fmt.Errorf("Phonetic rule for %s: unknown context %s", rule.Latin, rule.Context)
+		}
+		if rule.Cost, err = strconv.ParseFloat(strings.TrimSpace(record[4]), 64); err != nil {
+			return nil, fmt.Errorf("Phonetic rule for %s: %s", rule.Latin, err)
+		}
+
+		first, _ := utf8.DecodeRuneInString(rule.Latin)
+		pt.rules[first] = append(pt.rules[first], &rule)
+	}
+
+	return &pt, nil
+}
+
+// MustParsePhoneticRules is like ParsePhoneticRules but panics if the rules cannot be parsed
+func MustParsePhoneticRules(reader io.Reader) *PhoneticTable {
+	pt, err := ParsePhoneticRules(reader)
+	if err != nil {
+		panic(err)
+	}
+	return pt
+}
+
+// LoadPhoneticRules reads a phonetic rules file and makes it the table used by the package
+func LoadPhoneticRules(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	pt, err := ParsePhoneticRules(file)
+	if err != nil {
+		return err
+	}
+
+	phoneticTable = pt
+	return nil
+}
+
+// phoneticSpelling returns the Turkish letters of a phonetic input, digraphs like sh are read as ş
+func phoneticSpelling(latin string) string {
+	return phoneticDigraphs.Replace(TurkishLower(latin))
+}
+
+// PhoneticKey returns the key a word is compared with phonetic inputs. Only letters are kept, Turkish letters
+// are read as the letters typed for them on other keyboards, like ş as s, and doubled letters are written once.
+func PhoneticKey(latin string) string {
+	var sb strings.Builder
+	var last rune
+	for _, r := range phoneticFolds.Replace(phoneticSpelling(latin)) {
+		if !unicode.IsLetter(r) || r == last {
+			continue
+		}
+		sb.WriteRune(r)
+		last = r
+	}
+	return sb.String()
+}
+
+// buildPhoneticIndex indexes roots by the phonetic keys of their Turkish Latin
+func buildPhoneticIndex(roots []*Root) {
+	phoneticIndex = BuildKeyIndex(roots, func(r *Root) string { return PhoneticKey(r.TurkishLatin) })
+}
+
+// phoneticGuess is a spelling of the letters read so far
+type phoneticGuess struct {
+	letters []*PhoneticLetter
+	visenc  string
+	cost    float64
+}
+
+// vowelContext returns back or front for the first vowel after end, or the last vowel before start if there is none
+func vowelContext(runes []rune, start int, end int) string {
+	class := func(r rune) string {
+		if strings.ContainsRune(backVowels, r) {
+			return PhoneticBack
+		}
+		return PhoneticFront
+	}
+	for _, r := range runes[end:] {
+		if strings.ContainsRune(allVowels, r) {
+			return class(r)
+		}
+	}
+	for i := start - 1; i >= 0; i-- {
+		if strings.ContainsRune(allVowels, runes[i]) {
+			return class(runes[i])
+		}
+	}
+	return ""
+}
+
+// applies checks whether rule can write the letters of runes between start and end
+func (rule *PhoneticRule) applies(runes []rune, start int, end int) bool {
+	switch rule.Position {
+	case PhoneticInitial:
+		if start > 0 {
+			return false
+		}
+	case PhoneticMedial:
+		if start == 0 || end == len(runes) {
+			return false
+		}
+	case PhoneticFinal:
+		if end < len(runes) {
+			return false
+		}
+	}
+	if rule.Context != "" {
+		if context := vowelContext(runes, start, end); context != "" && context != rule.Context {
+			return false
+		}
+	}
+	return true
+}
+
+// extend returns a copy of g with latin written as visenc
+func (g *phoneticGuess) extend(latin string, visenc string, cost float64) *phoneticGuess {
+	letters := make([]*PhoneticLetter, len(g.letters), len(g.letters)+1)
+	copy(letters, g.letters)
+	letters = append(letters, &PhoneticLetter{Latin: latin, Visenc: visenc, Unicode: VisencToUnicode(visenc)})
+	return &phoneticGuess{letters: letters, visenc: g.visenc + visenc, cost: g.cost + cost}
+}
+
+// prune keeps the n lowest cost guesses with distinct spellings
+func prune(guesses []*phoneticGuess, n int) []*phoneticGuess {
+	sort.SliceStable(guesses, func(i, j int) bool { return guesses[i].cost < guesses[j].cost })
+	seen := make(map[string]bool)
+	out := make([]*phoneticGuess, 0, n)
+	for _, g := range guesses {
+		if len(out) < n && !seen[g.visenc] {
+			seen[g.visenc] = true
+			out = append(out, g)
+		}
+	}
+	return out
+}
+
+// Guess spells a phonetic input with the rules of the table and returns at most k spellings, the lowest cost first.
+// Guesses are read from left to right keeping the best partial spellings at each letter.
+func (pt *PhoneticTable) Guess(latin string, k int) []*PhoneticCandidate {
+	runes := make([]rune, 0, len(latin))
+	for _, r := range TurkishLower(latin) {
+		if unicode.IsLetter(r) || r == '\'' {
+			runes = append(runes, r)
+		}
+	}
+	if len(runes) == 0 {
+		return make([]*PhoneticCandidate, 0)
+	}
+
+	guesses := make([][]*phoneticGuess, len(runes)+1)
+	guesses[0] = []*phoneticGuess{{letters: make([]*PhoneticLetter, 0)}}
+
+	for start := 0; start < len(runes); start++ {
+		guesses[start] = prune(guesses[start], phoneticBeam)
+		matched := false
+		for _, rule := range pt.rules[runes[start]] {
+			end := start + utf8.RuneCountInString(rule.Latin)
+			if end > len(runes) || string(runes[start:end]) != rule.Latin || !rule.applies(runes, start, end) {
+				continue
+			}
+			matched = true
+			// a doubled consonant is written once, as its shadda is not written
+			doubled := end == start+1 && end < len(runes) && runes[end] == runes[start] &&
+				!strings.ContainsRune(allVowels, runes[start]) && rule.applies(runes, start, end+1)
+			cost := rule.Cost
+			if doubled {
+				cost += doubledLetterCost
+			}
+			for _, g := range guesses[start] {
+				guesses[end] = append(guesses[end], g.extend(rule.Latin, rule.Visenc, cost))
+				if doubled {
+					guesses[end+1] = append(guesses[end+1], g.extend(string(runes[start:end+1]), rule.Visenc, rule.Cost))
+				}
+			}
+		}
+		if !matched {
+			for _, g := range guesses[start] {
+				guesses[start+1] = append(guesses[start+1], g.extend(string(runes[start]), "", unknownLetterCost))
+			}
+		}
+	}
+
+	candidates := make([]*PhoneticCandidate, 0, k)
+	for _, g := range prune(guesses[len(runes)], k) {
+		ow, err := MakeOttomanWord(g.visenc, "")
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, &PhoneticCandidate{Ottoman: ow, Source: CandidateSource_GUESS, Letters: g.letters, Cost: g.cost})
+	}
+	return candidates
+}
+
+// GuessSpellings spells a phonetic input with the phonetic rules and returns at most k spellings, the lowest cost first
+func GuessSpellings(latin string, k int) []*PhoneticCandidate {
+	return phoneticTable.Guess(latin, k)
+}
+
+// spellingDistance is 0 if root is spelled as typed, 1 if it's spelled as typed without circumflexes and 2 otherwise
+func spellingDistance(typed string, root *Root) int {
+	latin := TurkishLower(root.TurkishLatin)
+	if latin == typed {
+		return 0
+	}
+	if circumflexFolds.Replace(latin) == circumflexFolds.Replace(typed) {
+		return 1
+	}
+	return 2
+}
+
+// DictionarySpellings returns the roots with the phonetic key of a phonetic input. Roots spelled as typed
+// come first, then the words more frequent in the language model and listed by more dictionaries.
+func DictionarySpellings(latin string) []*Root {
+	if phoneticIndex == nil {
+		return make([]*Root, 0)
+	}
+	roots := filterResults(rootsFromIndices(phoneticIndex.Lookup(PhoneticKey(latin))))
+	typed := phoneticSpelling(latin)
+
+	sort.SliceStable(roots, func(i, j int) bool {
+		if di, dj := spellingDistance(typed, roots[i]), spellingDistance(typed, roots[j]); di != dj {
+			return di < dj
+		}
+		if fi, fj := wordFrequency(roots[i].TurkishLatin), wordFrequency(roots[j].TurkishLatin); fi != fj {
+			return fi > fj
+		}
+		if si, sj := len(roots[i].Sources), len(roots[j].Sources); si != sj {
+			return si > sj
+		}
+		return len(roots[i].TurkishLatin) < len(roots[j].TurkishLatin)
+	})
+	return roots
+}
+
+// rootCandidate makes a candidate of a dictionary root, its letters have no Latin
+func rootCandidate(root *Root, source CandidateSource) *PhoneticCandidate {
+	units, _ := ottomanUnits(root.Ottoman.Visenc, root.Ottoman.Unicode)
+	letters := make([]*PhoneticLetter, len(units))
+	for i, u := range units {
+		letters[i] = &PhoneticLetter{Visenc: u.visenc, Unicode: u.unicode}
+	}
+	return &PhoneticCandidate{Ottoman: root.Ottoman, Source: source, Root: root, Letters: letters}
+}
+
+// PhoneticCandidates returns at most k Ottoman spellings of a phonetic input. Dictionary words read like the input
+// come first, then the best guesses of the phonetic rules and words beginning with the input.
+// Remaining places are filled with guesses.
+func PhoneticCandidates(latin string, k int) []*PhoneticCandidate {
+	if k <= 0 {
+		k = MAXCANDIDATES
+	}
+
+	candidates := make([]*PhoneticCandidate, 0, k)
+	// roots spelled alike, with different diacritics or readings, are a single candidate
+	seen := make(map[string]bool)
+	add := func(c *PhoneticCandidate) {
+		key := visencSearchKey(c.Ottoman.SearchKey)
+		if len(candidates) < k && !seen[key] {
+			seen[key] = true
+			candidates = append(candidates, c)
+		}
+	}
+
+	for _, r := range DictionarySpellings(latin) {
+		add(rootCandidate(r, CandidateSource_DICTIONARY))
+	}
+
+	guesses := GuessSpellings(latin, k)
+	next := 0
+	for ; next < len(guesses) && next < phoneticGuesses; next++ {
+		add(guesses[next])
+	}
+
+	if phoneticCompletionIndex != nil {
+		for _, r := range phoneticCompletionIndex.Complete(PhoneticKey(latin), k) {
+			add(rootCandidate(r, CandidateSource_COMPLETION))
+		}
+	}
+
+	for ; next < len(guesses); next++ {
+		add(guesses[next])
+	}
+
+	return candidates
+}
+
+// localizeCandidate returns a copy of a candidate with its Unicode spellings in the script of p
+func (p *ScriptProfile) localizeCandidate(c *PhoneticCandidate) *PhoneticCandidate {
+	out := PhoneticCandidate{Source: c.Source, Cost: c.Cost, Letters: make([]*PhoneticLetter, len(c.Letters))}
+	for i, l := range c.Letters {
+		out.Letters[i] = &PhoneticLetter{Latin: l.Latin, Visenc: l.Visenc, Unicode: p.VisencToUnicode(l.Visenc)}
+	}
+	if c.Root != nil {
+		out.Root = p.Localize(c.Root)
+		out.Ottoman = out.Root.Ottoman
+	} else {
+		out.Ottoman, _ = p.MakeOttomanWord(c.Ottoman.Visenc, "")
+	}
+	return &out
+}
+
+// ConvertPhonetic answers a phonetic input request, Unicode spellings are written in the requested script
+func ConvertPhonetic(in *PhoneticRequest) *PhoneticResponse {
+	profile := GetScriptProfile(in.Script)
+	candidates := PhoneticCandidates(in.Latin, int(in.ResultLimit))
+	if profile != ottomanProfile {
+		for i, c := range candidates {
+			candidates[i] = profile.localizeCandidate(c)
+		}
+	}
+	return &PhoneticResponse{Latin: in.Latin, Candidates: candidates}
+}
@@ -0,0 +1,122 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// func ParsePhoneticRules(reader io.Reader) (*PhoneticTable, error) {
+func TestParsePhoneticRules(t *testing.T) {
+	badTables := map[string]string{
+		"empty latin":      ",bu1,,,0\n",
+		"not a letter":     "b,qq,,,0\n",
+		"unknown position": "b,bu1,middle,,0\n",
+		"unknown context":  "b,bu1,,round,0\n",
+		"bad cost":         "b,bu1,,,cheap\n",
+		"missing field":    "b,bu1,,\n",
+	}
+
+	for i, o := range badTables {
+		if _, err := ParsePhoneticRules(strings.NewReader(o)); err == nil {
+			t.Log(fmt.Sprintf("%s should fail ParsePhoneticRules", i))
+			t.Fail()
+		}
+	}
+}
+
+// func PhoneticKey(latin string) string {
+func TestPhoneticKey(t *testing.T) {
+	testDict := map[string]string{
+		"şeker":   "seker",
+		"sheker":  "seker",
+		"kitâb":   "kitab",
+		"millet":  "milet",
+		"Işık":    "isik",
+		"kat'iye": "katiye",
+		"chocuk":  "cocuk",
+	}
+
+	for i, o := range testDict {
+		if k := PhoneticKey(i); k != o {
+			t.Log(fmt.Sprintf("Phonetic key of %s should be %s: %s", i, o, k))
+			t.Fail()
+		}
+	}
+}
+
+// func (pt *PhoneticTable) Guess(latin string, k int) []*PhoneticCandidate {
+func TestGuessSpellings(t *testing.T) {
+	testDict := map[string]string{
+		// sh is read as ş, short e is not written
+		"sheker": "so3kr",
+		// k is written with qaf before back vowels, o and u with waw
+		"okul":  "ewfo2wl",
+		"kapı":  "fo2bu3y",
+		"güzel": "ko7wro1l",
+	}
+
+	for i, o := range testDict {
+		guesses := GuessSpellings(i, 5)
+		if len(guesses) == 0 || guesses[0].Ottoman.Visenc != o {
+			t.Log(fmt.Sprintf("First guess for %s should be %s: %v", i, o, guesses))
+			t.Fail()
+			continue
+		}
+		latin := ""
+		for _, l := range guesses[0].Letters {
+			latin += l.Latin
+		}
+		if latin != TurkishLower(i) {
+			t.Log(fmt.Sprintf("Letters of %s should add up to it: %s", i, latin))
+			t.Fail()
+		}
+	}
+}
+
+// func PhoneticCandidates(latin string, k int) []*PhoneticCandidate {
+func TestPhoneticCandidates(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	testDict := map[string]string{
+		"kitab":  "kbo2ebu1",
+		"sheker": "so3kr",
+		"hane":   "xo1ebo1h",
+	}
+
+	for i, o := range testDict {
+		candidates := PhoneticCandidates(i, 5)
+		if len(candidates) == 0 || len(candidates) > 5 {
+			t.Log(fmt.Sprintf("%s should have between 1 and 5 candidates: %d", i, len(candidates)))
+			t.Fail()
+			continue
+		}
+		if c := candidates[0]; c.Source != CandidateSource_DICTIONARY || c.Root == nil || c.Ottoman.Visenc != o {
+			t.Log(fmt.Sprintf("First candidate for %s should be the dictionary word %s: %s %s", i, o, c.Source, c.Ottoman.Visenc))
+			t.Fail()
+		}
+		seen := make(map[string]bool)
+		for _, c := range candidates {
+			key := visencSearchKey(c.Ottoman.SearchKey)
+			if seen[key] {
+				t.Log(fmt.Sprintf("%s is a candidate of %s more than once", c.Ottoman.Visenc, i))
+				t.Fail()
+			}
+			seen[key] = true
+		}
+	}
+
+	// words not in the dictionary are guessed
+	candidates := PhoneticCandidates("bilgisayar", 5)
+	if len(candidates) == 0 || candidates[0].Source != CandidateSource_GUESS {
+		t.Log(fmt.Sprintf("bilgisayar should be guessed: %v", candidates))
+		t.Fail()
+	}
+
+	// Unicode spellings are written in the requested script
+	out := ConvertPhonetic(&PhoneticRequest{Latin: "kitab", ResultLimit: 1, Script: Script_ARABIC})
+	if len(out.Candidates) != 1 || out.Candidates[0].Ottoman.Unicode != "كتاب" {
+		t.Log(fmt.Sprintf("kitab should be written كتاب in Arabic: %v", out.Candidates))
+		t.Fail()
+	}
+}
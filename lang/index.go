@@ -0,0 +1,123 @@
+package lang
+
+import (
+	"sort"
+	"strings"
+)
+
+// KeyIndex is a sorted-array keyspace that maps each distinct key to a posting list of root indices.
+// Keys are kept once in sorted order and refer to the strings already held by the roots, so no key
+// is copied or concatenated with its index. The postings of keys[i] are postings[offsets[i]:offsets[i+1]].
+type KeyIndex struct {
+	keys     []string
+	offsets  []int32
+	postings []int32
+}
+
+type keyPosting struct {
+	key  string
+	root int32
+}
+
+// BuildKeyIndex builds a KeyIndex for roots by the key returned from keyfunc. Empty keys are not indexed.
+func BuildKeyIndex(roots []*Root, keyfunc func(*Root) string) *KeyIndex {
+	pairs := make([]keyPosting, 0, len(roots))
+
+	for i, r := range roots {
+		k := keyfunc(r)
+		if k == "" {
+			continue
+		}
+		pairs = append(pairs, keyPosting{key: k, root: int32(i)})
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].key == pairs[j].key {
+			return pairs[i].root < pairs[j].root
+		}
+		return pairs[i].key < pairs[j].key
+	})
+
+	ki := KeyIndex{
+		keys:     make([]string, 0, len(pairs)),
+		offsets:  make([]int32, 0, len(pairs)+1),
+		postings: make([]int32, len(pairs)),
+	}
+
+	for i, p := range pairs {
+		if i == 0 || p.key != pairs[i-1].key {
+			ki.keys = append(ki.keys, p.key)
+			ki.offsets = append(ki.offsets, int32(i))
+		}
+		ki.postings[i] = p.root
+	}
+	ki.offsets = append(ki.offsets, int32(len(pairs)))
+
+	// the key slice may have been allocated much larger than the number of distinct keys
+	keys := make([]string, len(ki.keys))
+	copy(keys, ki.keys)
+	ki.keys = keys
+
+	return &ki
+}
+
+// Len returns the number of distinct keys in the index
+func (ki *KeyIndex) Len() int {
+	return len(ki.keys)
+}
+
+// Key returns the ith key in sorted order
+func (ki *KeyIndex) Key(i int) string {
+	return ki.keys[i]
+}
+
+// Postings returns root indices for the ith key
+func (ki *KeyIndex) Postings(i int) []int32 {
+	return ki.postings[ki.offsets[i]:ki.offsets[i+1]]
+}
+
+// Lookup returns root indices whose key is exactly `key`
+func (ki *KeyIndex) Lookup(key string) []int32 {
+	i := sort.SearchStrings(ki.keys, key)
+	if i < len(ki.keys) && ki.keys[i] == key {
+		return ki.Postings(i)
+	}
+	return nil
+}
+
+// PrefixRange returns the half open range [start, end) of keys beginning with prefix
+func (ki *KeyIndex) PrefixRange(prefix string) (int, int) {
+	start := sort.SearchStrings(ki.keys, prefix)
+	end := start + sort.Search(len(ki.keys)-start, func(i int) bool {
+		return !strings.HasPrefix(ki.keys[start+i], prefix)
+	})
+	return start, end
+}
+
+// VisitPrefix calls visit for all root indices whose key begins with prefix
+func (ki *KeyIndex) VisitPrefix(prefix string, visit func(int32)) {
+	start, end := ki.PrefixRange(prefix)
+	for _, p := range ki.postings[ki.offsets[start]:ki.offsets[end]] {
+		visit(p)
+	}
+}
+
+// Match returns root indices of all keys for which match returns true
+func (ki *KeyIndex) Match(match func(string) bool) []int32 {
+	out := make([]int32, 0)
+	for i, k := range ki.keys {
+		if match(k) {
+			out = append(out, ki.Postings(i)...)
+		}
+	}
+	return out
+}
+
+// rootsFromIndices converts a list of indices to roots in the current rootSet
+func rootsFromIndices(indices []int32) []*Root {
+	roots := make([]*Root, len(indices))
+	for i, v := range indices {
+		roots[i] = rootSet.Roots[v]
+	}
+	return roots
+}
@@ -0,0 +1,168 @@
+package lang
+
+import (
+	"fmt"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/tchap/go-patricia/patricia"
+)
+
+// func BuildKeyIndex(roots []*Root, keyfunc func(*Root) string) *KeyIndex {
+func TestBuildKeyIndex(t *testing.T) {
+	roots := []*Root{
+		{TurkishLatin: "kitab"},
+		{TurkishLatin: "kitabet"},
+		{TurkishLatin: "kalem"},
+		{TurkishLatin: "kitab"},
+		{TurkishLatin: ""},
+	}
+
+	ki := BuildKeyIndex(roots, func(r *Root) string { return r.TurkishLatin })
+
+	if ki.Len() != 3 {
+		t.Log(fmt.Sprintf("%d keys in index, expected 3", ki.Len()))
+		t.Fail()
+	}
+
+	testDict := map[string][]int32{
+		"kitab":   {0, 3},
+		"kitabet": {1},
+		"kalem":   {2},
+		"kit":     nil,
+		"":        nil,
+	}
+
+	for k, v := range testDict {
+		if got := ki.Lookup(k); !CompareInt32Slices(got, v) {
+			t.Log(fmt.Sprintf("Lookup(%s) = %v, expected %v", k, got, v))
+			t.Fail()
+		}
+	}
+
+	prefixDict := map[string]int{
+		"k":      4,
+		"kit":    3,
+		"kitabe": 1,
+		"m":      0,
+	}
+
+	for p, n := range prefixDict {
+		count := 0
+		ki.VisitPrefix(p, func(int32) { count++ })
+		if count != n {
+			t.Log(fmt.Sprintf("VisitPrefix(%s) visited %d, expected %d", p, count, n))
+			t.Fail()
+		}
+	}
+}
+
+// buildLegacyTrie builds the "key#index" patricia trie used before KeyIndex for comparison
+func buildLegacyTrie(roots []*Root) *patricia.Trie {
+	trie := patricia.NewTrie()
+	for i, r := range roots {
+		trie.Insert(patricia.Prefix(fmt.Sprintf("%s#%d", r.TurkishLatin, i)), i)
+	}
+	return trie
+}
+
+// buildLegacyIndex builds the "key#index" first rune map used before KeyIndex for comparison
+func buildLegacyIndex(roots []*Root) map[rune][]string {
+	m := make(map[rune][]string)
+	for i, r := range roots {
+		s := fmt.Sprintf("%s#%d", r.TurkishLatin, i)
+		runes := []rune(s)
+		m[runes[0]] = append(m[runes[0]], s)
+	}
+	return m
+}
+
+func heapAlloc() uint64 {
+	var m runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&m)
+	return m.HeapAlloc
+}
+
+func BenchmarkHeapKeyIndex(b *testing.B) {
+	InitSearch(PROTOBUFFILE)
+	var ki *KeyIndex
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ki = nil
+		before := heapAlloc()
+		ki = BuildKeyIndex(rootSet.Roots, func(r *Root) string { return r.TurkishLatin })
+		b.ReportMetric(float64(heapAlloc()-before), "heap-bytes")
+	}
+	runtime.KeepAlive(ki)
+}
+
+func BenchmarkHeapLegacyTrieAndIndex(b *testing.B) {
+	InitSearch(PROTOBUFFILE)
+	var trie *patricia.Trie
+	var index map[rune][]string
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		trie, index = nil, nil
+		before := heapAlloc()
+		trie = buildLegacyTrie(rootSet.Roots)
+		index = buildLegacyIndex(rootSet.Roots)
+		b.ReportMetric(float64(heapAlloc()-before), "heap-bytes")
+	}
+	runtime.KeepAlive(trie)
+	runtime.KeepAlive(index)
+}
+
+func BenchmarkPrefixKeyIndex(b *testing.B) {
+	InitSearch(PROTOBUFFILE)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		count := 0
+		turkishLatinIndex.VisitPrefix("kita", func(int32) { count++ })
+	}
+}
+
+func BenchmarkPrefixLegacyTrie(b *testing.B) {
+	InitSearch(PROTOBUFFILE)
+	trie := buildLegacyTrie(rootSet.Roots)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		count := 0
+		trie.VisitSubtree(patricia.Prefix("kita"), func(_ patricia.Prefix, _ patricia.Item) error {
+			count++
+			return nil
+		})
+	}
+}
+
+func BenchmarkRegexKeyIndex(b *testing.B) {
+	InitSearch(PROTOBUFFILE)
+	regex := regexp.MustCompile(".*k.*t.*b.*")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		turkishLatinIndex.Match(regex.MatchString)
+	}
+}
+
+func BenchmarkRegexLegacyIndex(b *testing.B) {
+	InitSearch(PROTOBUFFILE)
+	index := buildLegacyIndex(rootSet.Roots)
+	regex := regexp.MustCompile(".*k.*t.*b.*")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		out := make([]int, 0)
+		for _, keylist := range index {
+			for _, k := range keylist {
+				if regex.MatchString(k) {
+					elements := strings.Split(k, "#")
+					if ri, err := strconv.Atoi(elements[len(elements)-1]); err == nil {
+						out = append(out, ri)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package lang
+
+import (
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// MAXSUFFIXCHAIN is the maximum number of suffix set elements stripped from a word
+const MAXSUFFIXCHAIN = 4
+
+var suffixSet *SuffixSet
+
+// latinSuffixes keeps suffixes by their harmony skeleton, e.g. lar and ler are both kept under lAr
+var latinSuffixes map[string][]*Suffix
+
+// visencSuffixes keeps suffixes by their visenc
+var visencSuffixes map[string][]*Suffix
+
+var effectiveTurkishLatinIndex *KeyIndex
+var effectiveVisencIndex *KeyIndex
+
+// harmonySkeleton replaces letters changing with vowel harmony and consonant assimilation by capitals
+var harmonySkeleton = strings.NewReplacer(
+	"a", "A", "e", "A",
+	"ı", "I", "i", "I", "u", "I", "ü", "I",
+	"d", "D", "t", "D",
+	"c", "C", "ç", "C",
+	"k", "K", "ğ", "K", "g", "K",
+)
+
+// InitLemmatizer loads the suffix set used to strip suffixes from words.
+// If the file cannot be found, lemmatizing searches return only roots matching the whole word.
+func InitLemmatizer(protobuffile string) {
+	latinSuffixes = make(map[string][]*Suffix)
+	visencSuffixes = make(map[string][]*Suffix)
+
+	if protobuffile == "" {
+		return
+	}
+	if _, err := os.Stat(protobuffile); err != nil {
+		log.Printf("Suffix set is not loaded: %s", err)
+		return
+	}
+
+	suffixSet = LoadSuffixSetProtobuf(protobuffile)
+
+	seenLatin := make(map[string]bool)
+	seenVisenc := make(map[string]bool)
+
+	for _, s := range suffixSet.Suffixes {
+		if s.TurkishLatin == "" || s.Ottoman == nil {
+			continue
+		}
+
+		lk := s.TurkishLatin + " " + s.RequiresEndsWithVowel.String()
+		if !seenLatin[lk] {
+			seenLatin[lk] = true
+			skeleton := harmonySkeleton.Replace(s.TurkishLatin)
+			latinSuffixes[skeleton] = append(latinSuffixes[skeleton], s)
+		}
+
+		vk := strings.TrimPrefix(s.Ottoman.Visenc, "||")
+		if vk == "" {
+			continue
+		}
+		if !seenVisenc[vk+" "+s.TurkishLatin] {
+			seenVisenc[vk+" "+s.TurkishLatin] = true
+			visencSuffixes[vk] = append(visencSuffixes[vk], s)
+		}
+	}
+
+	log.Printf("Lemmatizer: %d Latin and %d visenc suffixes", len(latinSuffixes), len(visencSuffixes))
+}
+
+// GetSuffixSet returns the suffix set the lemmatizer uses
+func GetSuffixSet() *SuffixSet {
+	return suffixSet
+}
+
+func buildStemIndices(roots []*Root) {
+	effectiveTurkishLatinIndex = BuildKeyIndex(roots, func(r *Root) string {
+		if r.EffectiveTurkishLatin == r.TurkishLatin {
+			return ""
+		}
+		return r.EffectiveTurkishLatin
+	})
+	effectiveVisencIndex = BuildKeyIndex(roots, func(r *Root) string {
+		if r.Ottoman == nil || r.EffectiveVisenc == r.Ottoman.Visenc {
+			return ""
+		}
+		return r.EffectiveVisenc
+	})
+}
+
+// firstVowel returns the first vowel of s or empty string
+func firstVowel(s string) string {
+	for _, r := range s {
+		if isVowelRune(r) {
+			return string(r)
+		}
+	}
+	return ""
+}
+
+// Harmonizes checks whether a suffix written as suffix follows vowel harmony after a stem with lastVowel.
+// Suffixes with long vowels, or o and ö, do not harmonize and are always accepted.
+func Harmonizes(lastVowel string, suffix string) bool {
+	sv := firstVowel(suffix)
+	if lastVowel == "" || sv == "" {
+		return true
+	}
+	if strings.ContainsAny(sv, LONGVOWELS+"oö") {
+		return true
+	}
+
+	lv := []rune(lastVowel)[0]
+	svr := []rune(sv)[0]
+
+	if strings.ContainsRune(backVowels, lv) != strings.ContainsRune(backVowels, svr) {
+		return false
+	}
+
+	// high vowels also follow rounding of the last vowel
+	if strings.ContainsRune("ıiuü", svr) && strings.ContainsRune(roundedVowels, lv) != strings.ContainsRune(roundedVowels, svr) {
+		return false
+	}
+
+	return true
+}
+
+// suffixFits checks harmony and assimilation of a suffix written as surface after stem
+func suffixFits(stem string, surface string, s *Suffix) bool {
+	if !Harmonizes(EffectiveLastVowel(stem), surface) {
+		return false
+	}
+
+	endsWithVowel := EndsWithVowel(stem)
+	// y, n and s are buffer letters after vowels, e.g. kapıya, kapının, kapısı
+	if s.RequiresEndsWithVowel == Req_ALWAYS && !endsWithVowel && strings.ContainsRune("yns", []rune(surface)[0]) {
+		return false
+	}
+	if endsWithVowel && EndsWithVowel(string([]rune(surface)[:1])) {
+		return false
+	}
+
+	// d and c become t and ç after a hard consonant
+	switch []rune(surface)[0] {
+	case 'd', 'c':
+		return endsWithVowel || !LastConsonantHard(stem)
+	case 't', 'ç':
+		return !endsWithVowel && LastConsonantHard(stem)
+	}
+
+	return true
+}
+
+type lemmatizer struct {
+	rootIndex    *KeyIndex
+	stemIndex    *KeyIndex
+	strip        func(word string) []suffixMatch
+	stemKey      func(latin string, visenc string) string
+	rootAccepts  func(root *Root, chain []*Suffix) bool
+	seen         map[*Root]bool
+	results      []*SearchResult
+	maxChainSize int
+}
+
+type suffixMatch struct {
+	stem   string
+	suffix *Suffix
+}
+
+func (l *lemmatizer) visit(word string, chain []*Suffix) {
+
+	l.collect(word, l.rootIndex.Lookup(word), chain)
+	if len(chain) > 0 && l.stemIndex != nil {
+		l.collect(word, l.stemIndex.Lookup(word), chain)
+	}
+
+	if len(chain) >= l.maxChainSize {
+		return
+	}
+
+	for _, m := range l.strip(word) {
+		newChain := make([]*Suffix, 0, len(chain)+1)
+		newChain = append(newChain, m.suffix)
+		newChain = append(newChain, chain...)
+		l.visit(m.stem, newChain)
+	}
+}
+
+func (l *lemmatizer) collect(word string, indices []int32, chain []*Suffix) {
+	for _, r := range rootsFromIndices(indices) {
+		if l.seen[r] {
+			continue
+		}
+		if len(chain) > 0 {
+			// apostrophes separate suffixes only after proper nouns
+			if strings.HasPrefix(chain[0].TurkishLatin, "'") && r.PartOfSpeech != PartOfSpeech_PROPER_NOUN {
+				continue
+			}
+			// roots with alternations use their stem only before vowel-initial suffixes
+			if l.stemKey(StemForSuffix(r, chain[0])) != word {
+				continue
+			}
+			if l.rootAccepts != nil && !l.rootAccepts(r, chain) {
+				continue
+			}
+		}
+		l.seen[r] = true
+		l.results = append(l.results, &SearchResult{Root: r, Suffixes: chain})
+	}
+}
+
+func (l *lemmatizer) run(word string, maxLen int) []*SearchResult {
+	l.seen = make(map[*Root]bool)
+	l.results = make([]*SearchResult, 0)
+	l.maxChainSize = MAXSUFFIXCHAIN
+
+	l.visit(word, []*Suffix{})
+
+	// roots covering more of the word come first
+	sort.SliceStable(l.results, func(i, j int) bool {
+		return len(l.results[i].Suffixes) < len(l.results[j].Suffixes)
+	})
+
+	l.results = l.addSingulars(l.results)
+
+	if len(l.results) > maxLen {
+		return l.results[:maxLen]
+	}
+	return l.results
+}
+
+// addSingulars adds the singulars of plurals in results after them, with the suffixes of the plurals. Relation of
+// the singular results is the plural found in the word.
+func (l *lemmatizer) addSingulars(results []*SearchResult) []*SearchResult {
+	out := make([]*SearchResult, 0, len(results))
+	for _, sr := range results {
+		out = append(out, sr)
+		for _, rel := range sr.Root.Relations {
+			if rel.Type != RelationType_PLURAL_OF {
+				continue
+			}
+			for _, r := range RelatedRoots(rel) {
+				if l.seen[r] {
+					continue
+				}
+				l.seen[r] = true
+				plural := &Relation{Type: RelationType_PLURAL_OF, TurkishLatin: sr.Root.TurkishLatin, Visenc: sr.Root.Ottoman.GetVisenc(), Source: rel.Source}
+				out = append(out, &SearchResult{Root: r, Suffixes: sr.Suffixes, Relation: plural})
+			}
+		}
+	}
+	return out
+}
+
+// LemmatizeTurkishLatin strips suffixes from a Turkish Latin word and returns matching roots with their suffix chains
+func LemmatizeTurkishLatin(word string, maxLen int) []*SearchResult {
+	word = TurkishLower(word)
+
+	l := lemmatizer{
+		rootIndex: turkishLatinIndex,
+		stemIndex: effectiveTurkishLatinIndex,
+		stemKey:   func(latin string, visenc string) string { return latin },
+		strip: func(w string) []suffixMatch {
+			runes := []rune(w)
+			matches := make([]suffixMatch, 0)
+			// a root has at least two letters
+			for i := 2; i < len(runes); i++ {
+				stem := string(runes[:i])
+				surface := string(runes[i:])
+				for _, s := range latinSuffixes[harmonySkeleton.Replace(surface)] {
+					if !suffixFits(stem, surface, s) {
+						continue
+					}
+					written := proto.Clone(s).(*Suffix)
+					written.TurkishLatin = surface
+					matches = append(matches, suffixMatch{stem: stem, suffix: written})
+					break
+				}
+			}
+			return matches
+		},
+	}
+
+	return l.run(word, maxLen)
+}
+
+// LemmatizeVisenc strips suffixes from a visenc word and returns matching roots with their suffix chains
+func LemmatizeVisenc(word string, maxLen int) []*SearchResult {
+	l := lemmatizer{
+		rootIndex: visencIndex,
+		stemIndex: effectiveVisencIndex,
+		stemKey:   func(latin string, visenc string) string { return visenc },
+		strip: func(w string) []suffixMatch {
+			matches := make([]suffixMatch, 0)
+			groups := SplitVisenc(w, true)
+			for i := 1; i < len(groups); i++ {
+				stem := strings.TrimSuffix(strings.Join(groups[:i], ""), "||")
+				for _, s := range visencSuffixes[strings.Join(groups[i:], "")] {
+					matches = append(matches, suffixMatch{stem: stem, suffix: s})
+				}
+			}
+			return matches
+		},
+		rootAccepts: func(root *Root, chain []*Suffix) bool {
+			latin, _ := StemForSuffix(root, chain[0])
+			for _, suffix := range chain {
+				if !suffixFits(latin, suffix.TurkishLatin, suffix) {
+					return false
+				}
+				latin += suffix.TurkishLatin
+			}
+			return true
+		},
+	}
+
+	return l.run(word, maxLen)
+}
+
+// LemmatizeUnicode strips suffixes from an Ottoman word and returns matching roots with their suffix chains
+func LemmatizeUnicode(word string, maxLen int) []*SearchResult {
+	return LemmatizeVisenc(UnicodeToVisenc(word), maxLen)
+}
+
+// LemmatizeAuto detects whether the word is Ottoman, visenc or Turkish Latin with DetectField and lemmatizes accordingly
+func LemmatizeAuto(word string, maxLen int) []*SearchResult {
+	switch DetectField(word) {
+	case SearchField_OTTOMAN:
+		return LemmatizeUnicode(word, maxLen)
+	case SearchField_VISENC:
+		return LemmatizeVisenc(word, maxLen)
+	}
+	return LemmatizeTurkishLatin(word, maxLen)
+}
+
+// ResultRoots returns the roots of search results
+func ResultRoots(results []*SearchResult) []*Root {
+	roots := make([]*Root, len(results))
+	for i, r := range results {
+		roots[i] = r.Root
+	}
+	return roots
+}
@@ -0,0 +1,65 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const SUFFIXSETFILE = "../assets/dervaze-suffixset.protobuf"
+
+// func Harmonizes(lastVowel string, suffix string) bool {
+func TestHarmonizes(t *testing.T) {
+	testDict := map[[2]string]bool{
+		{"a", "lar"}:  true,
+		{"a", "ler"}:  false,
+		{"ö", "dür"}:  true,
+		{"ö", "dir"}:  false,
+		{"i", "yor"}:  true,
+		{"e", "ı"}:    false,
+		{"u", "ları"}: true,
+	}
+
+	for i, o := range testDict {
+		if got := Harmonizes(i[0], i[1]); got != o {
+			t.Log(fmt.Sprintf("%s + %s fails for Harmonizes: %t", i[0], i[1], got))
+			t.Fail()
+		}
+	}
+}
+
+// func LemmatizeTurkishLatin(word string, maxLen int) []*SearchResult {
+func TestLemmatizeTurkishLatin(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+	InitLemmatizer(SUFFIXSETFILE)
+
+	testDict := map[string]string{
+		"kitablarımızdan": "kitab",
+		"geldiler":        "gel",
+		"evlerinden":      "ev",
+		"ağzı":            "ağız",
+		"kitabı":          "kitap",
+	}
+
+	for w, root := range testDict {
+		results := LemmatizeTurkishLatin(w, MAXRESULTLEN)
+		found := false
+		for _, r := range results {
+			if r.Root.TurkishLatin == root {
+				found = true
+			}
+		}
+		if !found {
+			chains := make([]string, 0)
+			for _, r := range results {
+				suffixes := make([]string, 0)
+				for _, s := range r.Suffixes {
+					suffixes = append(suffixes, s.TurkishLatin)
+				}
+				chains = append(chains, r.Root.TurkishLatin+"+"+strings.Join(suffixes, "+"))
+			}
+			t.Log(fmt.Sprintf("%s -> %s fails for LemmatizeTurkishLatin: %v", w, root, chains))
+			t.Fail()
+		}
+	}
+}
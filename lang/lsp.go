@@ -0,0 +1,563 @@
+package lang
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MAXLSPCOMPLETIONS is the number of completions sent for the word before the cursor
+const MAXLSPCOMPLETIONS = 20
+
+// MAXLSPHOVER is the number of readings shown when hovering over a word
+const MAXLSPHOVER = 5
+
+// JSON-RPC error codes used by the Language Server Protocol
+const (
+	lspParseError           = -32700
+	lspInvalidParams        = -32602
+	lspMethodNotFound       = -32601
+	lspServerNotInitialized = -32002
+)
+
+// Diagnostic severities of the Language Server Protocol
+const (
+	lspWarning     = 2
+	lspInformation = 3
+)
+
+// lspFullSync is the text document sync kind of clients sending the whole document on every change
+const lspFullSync = 1
+
+// lspMessage is a JSON-RPC request, response or notification. Requests and responses have an ID, notifications don't.
+type lspMessage struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+}
+
+type lspResponse struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id"`
+	Result  interface{}      `json:"result"`
+}
+
+type lspError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type lspErrorResponse struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id"`
+	Error   *lspError        `json:"error"`
+}
+
+type lspNotification struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+// lspPosition is a line and a character in it, characters are counted in UTF-16 code units
+type lspPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start lspPosition `json:"start"`
+	End   lspPosition `json:"end"`
+}
+
+type lspTextDocument struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId,omitempty"`
+	Version    int    `json:"version,omitempty"`
+	Text       string `json:"text,omitempty"`
+}
+
+type lspContentChange struct {
+	Range *lspRange `json:"range,omitempty"`
+	Text  string    `json:"text"`
+}
+
+// lspParams has the parameters of all requests and notifications the server answers
+type lspParams struct {
+	TextDocument   lspTextDocument    `json:"textDocument"`
+	ContentChanges []lspContentChange `json:"contentChanges"`
+	Position       lspPosition        `json:"position"`
+	Range          lspRange           `json:"range"`
+}
+
+type lspTextEdit struct {
+	Range   lspRange `json:"range"`
+	NewText string   `json:"newText"`
+}
+
+type lspWorkspaceEdit struct {
+	Changes map[string][]lspTextEdit `json:"changes"`
+}
+
+type lspDiagnostic struct {
+	Range    lspRange `json:"range"`
+	Severity int      `json:"severity"`
+	Code     string   `json:"code"`
+	Source   string   `json:"source"`
+	Message  string   `json:"message"`
+}
+
+type lspCodeAction struct {
+	Title       string           `json:"title"`
+	Kind        string           `json:"kind"`
+	Diagnostics []*lspDiagnostic `json:"diagnostics,omitempty"`
+	Edit        lspWorkspaceEdit `json:"edit"`
+}
+
+type lspCompletionItem struct {
+	Label      string      `json:"label"`
+	Detail     string      `json:"detail"`
+	FilterText string      `json:"filterText"`
+	TextEdit   lspTextEdit `json:"textEdit"`
+}
+
+type lspMarkupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+type lspHover struct {
+	Contents lspMarkupContent `json:"contents"`
+	Range    lspRange         `json:"range"`
+}
+
+// lspDocument is an open document with the misspellings found in its last version
+type lspDocument struct {
+	text         string
+	version      int
+	misspellings []*Misspelling
+}
+
+// LSPServer answers Language Server Protocol requests over a stream for documents mixing Ottoman script and Latin
+// transcription. Requests are answered in order, the documents are kept in memory as the client sends them.
+type LSPServer struct {
+	profile     *ScriptProfile
+	documents   map[string]*lspDocument
+	out         io.Writer
+	initialized bool
+	shutdown    bool
+}
+
+// NewLSPServer returns a server for documents whose Ottoman words are written in script
+func NewLSPServer(script Script) *LSPServer {
+	return &LSPServer{profile: GetScriptProfile(script), documents: make(map[string]*lspDocument)}
+}
+
+// readLSPMessage reads the content of a message after its Content-Length header
+func readLSPMessage(r *bufio.Reader) ([]byte, error) {
+	length := -1
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			break
+		}
+		header := strings.SplitN(line, ":", 2)
+		if len(header) == 2 && strings.EqualFold(header[0], "Content-Length") {
+			if length, err = strconv.Atoi(strings.TrimSpace(header[1])); err != nil {
+				return nil, fmt.Errorf("Invalid Content-Length: %s", header[1])
+			}
+		}
+	}
+	if length < 0 {
+		return nil, fmt.Errorf("Message without Content-Length")
+	}
+	content := make([]byte, length)
+	_, err := io.ReadFull(r, content)
+	return content, err
+}
+
+// write sends a message with its Content-Length header
+func (s *LSPServer) write(v interface{}) error {
+	content, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(content), content)
+	return err
+}
+
+// Serve answers the messages read from in on out until the client sends exit or in ends.
+// It returns an error if the client exits without a shutdown request.
+func (s *LSPServer) Serve(in io.Reader, out io.Writer) error {
+	s.out = out
+	r := bufio.NewReader(in)
+	for {
+		content, err := readLSPMessage(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var m lspMessage
+		if err := json.Unmarshal(content, &m); err != nil {
+			if err := s.write(lspErrorResponse{JSONRPC: "2.0", Error: &lspError{Code: lspParseError, Message: err.Error()}}); err != nil {
+				return err
+			}
+			continue
+		}
+		if m.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("Exit without shutdown")
+			}
+			return nil
+		}
+
+		result, lerr := s.handle(&m)
+		// notifications are not answered
+		if m.ID == nil {
+			if lerr != nil {
+				log.Printf("%s: %s", m.Method, lerr.Message)
+			}
+			continue
+		}
+		if lerr != nil {
+			err = s.write(lspErrorResponse{JSONRPC: "2.0", ID: m.ID, Error: lerr})
+		} else {
+			err = s.write(lspResponse{JSONRPC: "2.0", ID: m.ID, Result: result})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handle answers a request or notification, unknown notifications are ignored
+func (s *LSPServer) handle(m *lspMessage) (interface{}, *lspError) {
+	if m.Method == "initialize" {
+		s.initialized = true
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync":   lspFullSync,
+				"hoverProvider":      true,
+				"completionProvider": map[string]interface{}{},
+				"codeActionProvider": true,
+			},
+			"serverInfo": map[string]string{"name": "dervaze"},
+		}, nil
+	}
+	if !s.initialized {
+		return nil, &lspError{Code: lspServerNotInitialized, Message: "Server is not initialized"}
+	}
+
+	var params lspParams
+	if len(m.Params) > 0 {
+		if err := json.Unmarshal(m.Params, &params); err != nil {
+			return nil, &lspError{Code: lspInvalidParams, Message: err.Error()}
+		}
+	}
+
+	switch m.Method {
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		s.update(params.TextDocument.URI, params.TextDocument.Text, params.TextDocument.Version)
+		return nil, nil
+	case "textDocument/didChange":
+		doc, exists := s.documents[params.TextDocument.URI]
+		if !exists {
+			return nil, &lspError{Code: lspInvalidParams, Message: "Unknown document: " + params.TextDocument.URI}
+		}
+		text := doc.text
+		for _, c := range params.ContentChanges {
+			if c.Range == nil {
+				text = c.Text
+			} else {
+				start, end := lspOffsets(text, *c.Range)
+				text = text[:start] + c.Text + text[end:]
+			}
+		}
+		s.update(params.TextDocument.URI, text, params.TextDocument.Version)
+		return nil, nil
+	case "textDocument/didClose":
+		delete(s.documents, params.TextDocument.URI)
+		// diagnostics of closed documents are cleared
+		s.publishDiagnostics(params.TextDocument.URI, map[string]interface{}{"uri": params.TextDocument.URI, "diagnostics": []*lspDiagnostic{}})
+		return nil, nil
+	case "textDocument/hover", "textDocument/completion", "textDocument/codeAction":
+		doc, exists := s.documents[params.TextDocument.URI]
+		if !exists {
+			return nil, &lspError{Code: lspInvalidParams, Message: "Unknown document: " + params.TextDocument.URI}
+		}
+		switch m.Method {
+		case "textDocument/hover":
+			return s.hover(doc, params.Position), nil
+		case "textDocument/completion":
+			return s.complete(doc, params.Position), nil
+		}
+		return s.codeActions(params.TextDocument.URI, doc, params.Range), nil
+	}
+
+	if m.ID != nil {
+		return nil, &lspError{Code: lspMethodNotFound, Message: "Method not found: " + m.Method}
+	}
+	return nil, nil
+}
+
+// lspOffset returns the byte offset of p in text
+func lspOffset(text string, p lspPosition) int {
+	offset := 0
+	for line := 0; line < p.Line; line++ {
+		i := strings.IndexByte(text[offset:], '\n')
+		if i < 0 {
+			return len(text)
+		}
+		offset += i + 1
+	}
+	units := 0
+	for i, r := range text[offset:] {
+		if units >= p.Character || r == '\n' {
+			return offset + i
+		}
+		units += TFint(r >= 0x10000, 2, 1)
+	}
+	return len(text)
+}
+
+// lspOffsets returns the byte offsets of the start and end of r in text, reversed ranges are read from end to start
+func lspOffsets(text string, r lspRange) (int, int) {
+	start, end := lspOffset(text, r.Start), lspOffset(text, r.End)
+	if start > end {
+		return end, start
+	}
+	return start, end
+}
+
+// lspPositionAt returns the position of a byte offset in text
+func lspPositionAt(text string, offset int) lspPosition {
+	p := lspPosition{}
+	for _, r := range text[:offset] {
+		if r == '\n' {
+			p.Line++
+			p.Character = 0
+		} else {
+			p.Character += TFint(r >= 0x10000, 2, 1)
+		}
+	}
+	return p
+}
+
+// lspRangeOf returns the range of the bytes from start to end in text
+func lspRangeOf(text string, start int, end int) lspRange {
+	return lspRange{Start: lspPositionAt(text, start), End: lspPositionAt(text, end)}
+}
+
+// isLSPWordRune checks whether r is in a word of Ottoman script, visenc or Latin transcription
+func isLSPWordRune(r rune) bool {
+	return isWordRune(r) || unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || r == '\''
+}
+
+// lspWordAt returns the byte offsets of the word around offset
+func lspWordAt(text string, offset int) (int, int) {
+	start := offset
+	for start > 0 {
+		r, size := utf8.DecodeLastRuneInString(text[:start])
+		if !isLSPWordRune(r) {
+			break
+		}
+		start -= size
+	}
+	end := offset
+	for end < len(text) {
+		r, size := utf8.DecodeRuneInString(text[end:])
+		if !isLSPWordRune(r) {
+			break
+		}
+		end += size
+	}
+	return start, end
+}
+
+// update keeps the new text of a document and publishes its diagnostics
+func (s *LSPServer) update(uri string, text string, version int) {
+	doc := lspDocument{text: text, version: version, misspellings: s.profile.CheckSpelling(text, MAXSUGGESTIONS).Misspellings}
+	s.documents[uri] = &doc
+	s.publishDiagnostics(uri, map[string]interface{}{"uri": uri, "version": version, "diagnostics": s.diagnostics(&doc)})
+}
+
+// publishDiagnostics sends the diagnostics of a document, the client isn't told if they can't be sent
+func (s *LSPServer) publishDiagnostics(uri string, params map[string]interface{}) {
+	if err := s.write(lspNotification{JSONRPC: "2.0", Method: "textDocument/publishDiagnostics", Params: params}); err != nil {
+		log.Printf("Diagnostics of %s: %s", uri, err)
+	}
+}
+
+// diagnostic describes a misspelling, words with suggestions are misspelled, others are unknown
+func (s *LSPServer) diagnostic(doc *lspDocument, m *Misspelling) *lspDiagnostic {
+	d := lspDiagnostic{Range: lspRangeOf(doc.text, int(m.Start), int(m.End)), Source: "dervaze"}
+	if len(m.Suggestions) == 0 {
+		d.Severity, d.Code, d.Message = lspInformation, "unknown", fmt.Sprintf("Unknown word %s", m.Word)
+		return &d
+	}
+	spellings := make([]string, len(m.Suggestions))
+	for i, sg := range m.Suggestions {
+		spellings[i] = sg.Ottoman.Unicode
+	}
+	d.Severity, d.Code = lspWarning, "misspelled"
+	d.Message = fmt.Sprintf("Misspelled word %s, did you mean %s?", m.Word, strings.Join(spellings, ", "))
+	return &d
+}
+
+// diagnostics returns the diagnostics of all misspellings in doc
+func (s *LSPServer) diagnostics(doc *lspDocument) []*lspDiagnostic {
+	out := make([]*lspDiagnostic, len(doc.misspellings))
+	for i, m := range doc.misspellings {
+		out[i] = s.diagnostic(doc, m)
+	}
+	return out
+}
+
+// hover shows the Latin readings, abjad values and parts of speech of the roots of the word at p
+func (s *LSPServer) hover(doc *lspDocument, p lspPosition) *lspHover {
+	start, end := lspWordAt(doc.text, lspOffset(doc.text, p))
+	word := strings.Trim(doc.text[start:end], "'")
+	if word == "" {
+		return nil
+	}
+
+	results := s.profile.LocalizeResults(LemmatizeAuto(s.profile.OttomanUnicode(word), MAXLSPHOVER))
+	if len(results) == 0 {
+		return nil
+	}
+	lines := make([]string, len(results))
+	for i, sr := range results {
+		reading := TurkishLatinReading(&TranslationWord{Root: sr.Root, Suffixes: sr.Suffixes})
+		lines[i] = fmt.Sprintf("- **%s** %s `%s`, abjad %d, %s", reading, sr.Root.Ottoman.Unicode, sr.Root.Ottoman.Visenc,
+			sr.Root.Abjad, sr.Root.PartOfSpeech.String())
+		if len(sr.Suffixes) > 0 {
+			suffixes := make([]string, len(sr.Suffixes))
+			for j, sx := range sr.Suffixes {
+				suffixes[j] = strings.TrimPrefix(sx.TurkishLatin, "'")
+			}
+			lines[i] += fmt.Sprintf(", %s+%s", sr.Root.TurkishLatin, strings.Join(suffixes, "+"))
+		}
+		if labels := RelationLabels(sr.Root); len(labels) > 0 {
+			lines[i] += ", " + strings.Join(labels, ", ")
+		}
+	}
+	return &lspHover{Contents: lspMarkupContent{Kind: "markdown", Value: strings.Join(lines, "\n")}, Range: lspRangeOf(doc.text, start, end)}
+}
+
+// complete returns the roots beginning with the word before p, written in the field of the word
+func (s *LSPServer) complete(doc *lspDocument, p lspPosition) map[string]interface{} {
+	offset := lspOffset(doc.text, p)
+	start, _ := lspWordAt(doc.text, offset)
+	prefix := doc.text[start:offset]
+	items := make([]*lspCompletionItem, 0)
+	if prefix == "" {
+		return map[string]interface{}{"isIncomplete": true, "items": items}
+	}
+
+	field := DetectField(prefix)
+	for _, r := range s.profile.LocalizeRoots(CompleteAuto(s.profile.OttomanUnicode(prefix), MAXLSPCOMPLETIONS)) {
+		item := lspCompletionItem{Label: r.TurkishLatin, Detail: r.Ottoman.Unicode}
+		switch field {
+		case SearchField_OTTOMAN:
+			item.Label, item.Detail = r.Ottoman.Unicode, r.TurkishLatin
+		case SearchField_VISENC:
+			item.Label, item.Detail = r.Ottoman.Visenc, r.TurkishLatin+" "+r.Ottoman.Unicode
+		}
+		item.FilterText = item.Label
+		item.TextEdit = lspTextEdit{Range: lspRangeOf(doc.text, start, offset), NewText: item.Label}
+		items = append(items, &item)
+	}
+	// completions are limited, the client asks again as the prefix grows
+	return map[string]interface{}{"isIncomplete": true, "items": items}
+}
+
+// visencText converts the visenc words of text to Unicode and keeps the characters between them
+func (s *LSPServer) visencText(text string) string {
+	var sb strings.Builder
+	start := -1
+	for i, r := range text + " " {
+		switch {
+		case !unicode.IsSpace(r) && start < 0:
+			start = i
+		case unicode.IsSpace(r) && start >= 0:
+			sb.WriteString(s.profile.VisencToUnicode(text[start:i]))
+			start = -1
+		}
+		if unicode.IsSpace(r) && i < len(text) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// latinText replaces the Ottoman words of text with their most likely readings, unknown words are kept
+func (s *LSPServer) latinText(text string) string {
+	words := ottomanWords(text)
+	varieties := make([]*TranslationVariety, len(words))
+	for i, w := range words {
+		varieties[i] = &TranslationVariety{Varieties: ottomanReadings(s.profile.OttomanUnicode(text[w[0]:w[1]]), MAXREADINGS), Direction: TranslationDirection_otm2tr}
+	}
+	RankReadings(languageModel, varieties)
+
+	var sb strings.Builder
+	last := 0
+	for i, w := range words {
+		sb.WriteString(text[last:w[0]])
+		if reading := TurkishLatinReading(varieties[i].Varieties[0]); reading != "" {
+			sb.WriteString(reading)
+		} else {
+			sb.WriteString(text[w[0]:w[1]])
+		}
+		last = w[1]
+	}
+	sb.WriteString(text[last:])
+	return sb.String()
+}
+
+// codeActions returns the suggestions of misspellings in r and the conversions of the selected text
+func (s *LSPServer) codeActions(uri string, doc *lspDocument, r lspRange) []*lspCodeAction {
+	actions := make([]*lspCodeAction, 0)
+	edit := func(editRange lspRange, text string) lspWorkspaceEdit {
+		return lspWorkspaceEdit{Changes: map[string][]lspTextEdit{uri: {{Range: editRange, NewText: text}}}}
+	}
+
+	start, end := lspOffsets(doc.text, r)
+	r = lspRangeOf(doc.text, start, end)
+	for _, m := range doc.misspellings {
+		if int(m.End) < start || int(m.Start) > end {
+			continue
+		}
+		d := s.diagnostic(doc, m)
+		for _, sg := range m.Suggestions {
+			actions = append(actions, &lspCodeAction{Title: "Replace with " + sg.Ottoman.Unicode, Kind: "quickfix",
+				Diagnostics: []*lspDiagnostic{d}, Edit: edit(d.Range, sg.Ottoman.Unicode)})
+		}
+	}
+
+	selected := doc.text[start:end]
+	switch {
+	case strings.TrimSpace(selected) == "":
+	case ContainsArabicChars(selected):
+		actions = append(actions, &lspCodeAction{Title: "Convert Unicode to Latin", Kind: "refactor.rewrite", Edit: edit(r, s.latinText(selected))})
+	default:
+		actions = append(actions, &lspCodeAction{Title: "Convert visenc to Unicode", Kind: "refactor.rewrite", Edit: edit(r, s.visencText(selected))})
+	}
+	return actions
+}
@@ -0,0 +1,189 @@
+package lang
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// lspTestMessage is a response or notification written by the server
+type lspTestMessage struct {
+	ID     *int            `json:"id"`
+	Method string          `json:"method"`
+	Result json.RawMessage `json:"result"`
+	Error  *lspError       `json:"error"`
+	Params json.RawMessage `json:"params"`
+}
+
+// lspSession sends requests, and notifications if their id is 0, to a server and returns the messages it writes
+func lspSession(t *testing.T, requests [][3]interface{}) ([]*lspTestMessage, error) {
+	var in bytes.Buffer
+	for _, r := range requests {
+		m := map[string]interface{}{"jsonrpc": "2.0", "method": r[1], "params": r[2]}
+		if r[0].(int) != 0 {
+			m["id"] = r[0]
+		}
+		content, _ := json.Marshal(m)
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(content), content)
+	}
+
+	var out bytes.Buffer
+	serveErr := NewLSPServer(Script_OTTOMAN_TURKISH).Serve(&in, &out)
+
+	messages := make([]*lspTestMessage, 0)
+	r := bufio.NewReader(&out)
+	for {
+		content, err := readLSPMessage(r)
+		if err != nil {
+			break
+		}
+		var m lspTestMessage
+		if err := json.Unmarshal(content, &m); err != nil {
+			t.Fatal(err)
+		}
+		messages = append(messages, &m)
+	}
+	return messages, serveErr
+}
+
+// func lspOffset(text string, p lspPosition) int {
+func TestLSPOffset(t *testing.T) {
+	text := "kitap\nبو کتاب\n😀 ev"
+	testDict := map[lspPosition]int{
+		{0, 0}:  0,
+		{0, 99}: 5,
+		{1, 3}:  len("kitap\nبو "),
+		{2, 2}:  len("kitap\nبو کتاب\n😀"),
+		{2, 5}:  len(text),
+		{5, 0}:  len(text),
+	}
+
+	for p, e := range testDict {
+		if o := lspOffset(text, p); o != e {
+			t.Log(fmt.Sprintf("%v should be at %d for lspOffset: %d", p, e, o))
+			t.Fail()
+		}
+		if p.Line > 2 || p.Character > 5 {
+			continue
+		}
+		if back := lspPositionAt(text, e); back != p {
+			t.Log(fmt.Sprintf("%d should be at %v for lspPositionAt: %v", e, p, back))
+			t.Fail()
+		}
+	}
+}
+
+// func (s *LSPServer) Serve(in io.Reader, out io.Writer) error {
+func TestLSPServer(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+	InitLemmatizer(SUFFIXSETFILE)
+
+	uri := "file:///tmp/divan.md"
+	doc := map[string]interface{}{"uri": uri}
+	position := func(line int, character int) map[string]interface{} {
+		return map[string]interface{}{"textDocument": doc, "position": lspPosition{line, character}}
+	}
+	selection := func(line int, start int, end int) map[string]interface{} {
+		return map[string]interface{}{"textDocument": doc, "range": lspRange{lspPosition{line, start}, lspPosition{line, end}}}
+	}
+
+	messages, err := lspSession(t, [][3]interface{}{
+		{1, "textDocument/hover", position(0, 0)},
+		{2, "initialize", map[string]interface{}{}},
+		{0, "initialized", map[string]interface{}{}},
+		{0, "textDocument/didOpen", map[string]interface{}{"textDocument": lspTextDocument{URI: uri, Version: 1, Text: "# kitap\nبو کطاب کتابلرده\nkbo2ebu1\n"}}},
+		{3, "textDocument/hover", position(0, 4)},
+		{4, "textDocument/hover", position(1, 10)},
+		{5, "textDocument/completion", position(0, 7)},
+		{6, "textDocument/codeAction", selection(1, 4, 4)},
+		{7, "textDocument/codeAction", selection(2, 0, 8)},
+		{8, "textDocument/codeAction", selection(1, 0, 16)},
+		{9, "textDocument/definition", position(0, 4)},
+		{11, "textDocument/codeAction", selection(2, 8, 0)},
+		{10, "shutdown", nil},
+		{0, "exit", nil},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	responses := make(map[int]*lspTestMessage)
+	var diagnostics []*lspDiagnostic
+	for _, m := range messages {
+		if m.ID != nil {
+			responses[*m.ID] = m
+		}
+		if m.Method == "textDocument/publishDiagnostics" {
+			var params struct{ Diagnostics []*lspDiagnostic }
+			json.Unmarshal(m.Params, &params)
+			diagnostics = params.Diagnostics
+		}
+	}
+
+	if len(responses) != 11 {
+		t.Log(fmt.Sprintf("Every request should be answered: %d", len(responses)))
+		t.FailNow()
+	}
+	if responses[1].Error == nil || responses[1].Error.Code != lspServerNotInitialized {
+		t.Log("Requests before initialize should fail")
+		t.Fail()
+	}
+	if responses[9].Error == nil || responses[9].Error.Code != lspMethodNotFound {
+		t.Log("Unknown requests should fail")
+		t.Fail()
+	}
+
+	if len(diagnostics) != 1 || diagnostics[0].Code != "misspelled" || diagnostics[0].Range != (lspRange{lspPosition{1, 3}, lspPosition{1, 7}}) {
+		t.Log(fmt.Sprintf("Only کطاب should be misspelled: %v", diagnostics))
+		t.Fail()
+	}
+
+	for id, e := range map[int][]string{3: {"**kitap**", "کتاب", "abjad 423", "NOUN"}, 4: {"kitaplarda", "kitap+larda"}} {
+		var hover lspHover
+		json.Unmarshal(responses[id].Result, &hover)
+		for _, s := range e {
+			if !strings.Contains(hover.Contents.Value, s) {
+				t.Log(fmt.Sprintf("Hover %d should contain %s: %s", id, s, hover.Contents.Value))
+				t.Fail()
+			}
+		}
+	}
+
+	var completions struct{ Items []*lspCompletionItem }
+	json.Unmarshal(responses[5].Result, &completions)
+	found := false
+	for _, item := range completions.Items {
+		if item.Label == "kitap" && item.TextEdit.Range.Start == (lspPosition{0, 2}) {
+			found = true
+		}
+	}
+	if !found {
+		t.Log(fmt.Sprintf("kitap should complete kitap: %v", completions.Items))
+		t.Fail()
+	}
+
+	// unknown words are kept in Latin, readings of known words depend on the language model
+	for id, e := range map[int][2]string{6: {"Replace with کتاب", "کتاب"}, 7: {"Convert visenc to Unicode", "کتاب"}, 8: {"Convert Unicode to Latin", "bu کطاب kita"},
+		11: {"Convert visenc to Unicode", "کتاب"}} {
+		var actions []*lspCodeAction
+		json.Unmarshal(responses[id].Result, &actions)
+		found := false
+		for _, a := range actions {
+			if a.Title == e[0] && strings.HasPrefix(a.Edit.Changes[uri][0].NewText, e[1]) {
+				found = true
+			}
+		}
+		if !found {
+			t.Log(fmt.Sprintf("Code action %d should be %s to %s", id, e[0], e[1]))
+			t.Fail()
+		}
+	}
+
+	if _, err := lspSession(t, [][3]interface{}{{1, "initialize", nil}, {0, "exit", nil}}); err == nil {
+		t.Log("Exit without shutdown should fail")
+		t.Fail()
+	}
+}
@@ -0,0 +1,246 @@
+package lang
+
+import (
+	"bufio"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// Sentence boundaries in word n-grams and word boundaries in character n-grams
+const (
+	SentenceStart = "<s>"
+	SentenceEnd   = "</s>"
+	wordStart     = "^"
+	wordEnd       = "$"
+)
+
+// ngramLambda is the weight of a higher order estimate when it's interpolated with lower orders
+const ngramLambda = 0.7
+
+// LanguageModel scores Turkish Latin words and sentences with a trained NGramModel
+type LanguageModel struct {
+	model          *NGramModel
+	charVocabulary int64
+}
+
+var languageModel *LanguageModel
+
+// NewLanguageModel builds a language model from n-gram counts
+func NewLanguageModel(model *NGramModel) *LanguageModel {
+	var vocabulary int64 = 0
+	for k := range model.CharCounts {
+		if len([]rune(k)) == 1 {
+			vocabulary++
+		}
+	}
+	return &LanguageModel{model: model, charVocabulary: vocabulary}
+}
+
+// InitLanguageModel loads the n-gram model used to rank translations.
+// If the file cannot be found, all readings of a word are considered equally likely.
+func InitLanguageModel(protobuffile string) {
+	if protobuffile == "" {
+		return
+	}
+	if _, err := os.Stat(protobuffile); err != nil {
+		log.Printf("Language model is not loaded: %s", err)
+		return
+	}
+	languageModel = NewLanguageModel(LoadNGramModelProtobuf(protobuffile))
+	// completions are ranked by word frequencies
+	buildCompletionIndices()
+}
+
+// GetLanguageModel returns the language model used to rank translations, or nil
+func GetLanguageModel() *LanguageModel {
+	return languageModel
+}
+
+// SetLanguageModel sets the language model used to rank translations
+func SetLanguageModel(lm *LanguageModel) {
+	languageModel = lm
+	buildCompletionIndices()
+}
+
+// Tokenize splits a text into sentences of lowercase words
+func Tokenize(text string) [][]string {
+	sentences := make([][]string, 0)
+	words := make([]string, 0)
+	var sb strings.Builder
+
+	endWord := func() {
+		if sb.Len() > 0 {
+			words = append(words, TurkishLower(sb.String()))
+			sb.Reset()
+		}
+	}
+	endSentence := func() {
+		endWord()
+		if len(words) > 0 {
+			sentences = append(sentences, words)
+			words = make([]string, 0)
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.IsLetter(r) || unicode.Is(unicode.Mn, r) || r == '\'' || r == '\u200c':
+			sb.WriteRune(r)
+		case r == '.' || r == '!' || r == '?' || r == '\n' || r == '؟':
+			endSentence()
+		default:
+			endWord()
+		}
+	}
+	endSentence()
+
+	return sentences
+}
+
+// ngrams returns all n-grams of length 1 to order joined by sep
+func ngrams(tokens []string, order int, sep string) []string {
+	out := make([]string, 0, len(tokens)*order)
+	for i := range tokens {
+		for n := 1; n <= order && i+n <= len(tokens); n++ {
+			out = append(out, strings.Join(tokens[i:i+n], sep))
+		}
+	}
+	return out
+}
+
+func charTokens(word string) []string {
+	tokens := []string{wordStart}
+	for _, r := range word {
+		tokens = append(tokens, string(r))
+	}
+	return append(tokens, wordEnd)
+}
+
+// AddSentence adds the counts of a tokenized sentence to the model
+func (m *NGramModel) AddSentence(words []string) {
+	if m.WordCounts == nil {
+		m.WordCounts = make(map[string]int64)
+	}
+	if m.CharCounts == nil {
+		m.CharCounts = make(map[string]int64)
+	}
+
+	tokens := append(append([]string{SentenceStart}, words...), SentenceEnd)
+	for _, g := range ngrams(tokens, int(m.WordOrder), " ") {
+		m.WordCounts[g]++
+	}
+	m.TotalWords += int64(len(words) + 1)
+
+	for _, w := range words {
+		chars := charTokens(w)
+		for _, g := range ngrams(chars, int(m.CharOrder), "") {
+			m.CharCounts[g]++
+		}
+		m.TotalChars += int64(len(chars))
+	}
+}
+
+// TrainNGramModel reads all .txt files under corpusdir and counts word and character n-grams
+func TrainNGramModel(corpusdir string, wordOrder int, charOrder int) (*NGramModel, error) {
+	model := &NGramModel{
+		WordOrder:  int32(wordOrder),
+		CharOrder:  int32(charOrder),
+		WordCounts: make(map[string]int64),
+		CharCounts: make(map[string]int64),
+		Tag:        corpusdir,
+	}
+
+	err := filepath.Walk(corpusdir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".txt" {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			for _, sentence := range Tokenize(scanner.Text()) {
+				model.AddSentence(sentence)
+			}
+		}
+		log.Printf("%s: %d words so far", path, model.TotalWords)
+		return scanner.Err()
+	})
+
+	return model, err
+}
+
+// CharProbability returns the probability of a word by its character n-grams with add-one smoothing
+func (lm *LanguageModel) CharProbability(word string) float64 {
+	chars := charTokens(word)
+	order := int(lm.model.CharOrder)
+	if order < 1 {
+		return 0
+	}
+
+	p := 1.0
+	for i := 1; i < len(chars); i++ {
+		start := i - order + 1
+		if start < 0 {
+			start = 0
+		}
+		context := strings.Join(chars[start:i], "")
+		gram := context + chars[i]
+		contextCount := lm.model.CharCounts[context]
+		if context == "" {
+			contextCount = lm.model.TotalChars
+		}
+		p *= float64(lm.model.CharCounts[gram]+1) / float64(contextCount+lm.charVocabulary+1)
+	}
+	return p
+}
+
+// WordProbability returns the probability of word after history, interpolating n-gram orders and the character model
+func (lm *LanguageModel) WordProbability(history []string, word string) float64 {
+	p := lm.CharProbability(word)
+
+	order := int(lm.model.WordOrder)
+	if len(history) > order-1 {
+		history = history[len(history)-order+1:]
+	}
+
+	for n := 0; n <= len(history); n++ {
+		h := history[len(history)-n:]
+		var contextCount int64
+		if n == 0 {
+			contextCount = lm.model.TotalWords
+		} else {
+			contextCount = lm.model.WordCounts[strings.Join(h, " ")]
+		}
+		if contextCount == 0 {
+			break
+		}
+		gram := strings.TrimSpace(strings.Join(h, " ") + " " + word)
+		ml := float64(lm.model.WordCounts[gram]) / float64(contextCount)
+		p = ngramLambda*ml + (1-ngramLambda)*p
+	}
+
+	return p
+}
+
+// SentenceLogProbability returns the natural log probability of a sentence of Turkish Latin words
+func (lm *LanguageModel) SentenceLogProbability(words []string) float64 {
+	tokens := append(append([]string{SentenceStart}, words...), SentenceEnd)
+	lp := 0.0
+	for i := 1; i < len(tokens); i++ {
+		lp += math.Log(lm.WordProbability(tokens[:i], tokens[i]))
+	}
+	return lp
+}
@@ -0,0 +1,461 @@
+package lang
+
+import (
+	_ "embed" // default OCR confusions are embedded
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed data/ocr-confusions.csv
+var defaultOCRConfusionsData string
+
+// MAXOCRCANDIDATES is the number of candidates returned for an OCR token if the request doesn't set a limit
+const MAXOCRCANDIDATES = 5
+
+// maxOCRCost is the highest cost of reading a candidate as an OCR token
+const maxOCRCost = 12.0
+
+// ocrAlignmentLimit is the highest distance of aligned words used in training, relative to replacing all letters
+const ocrAlignmentLimit = 0.5
+
+// OCRConfusionMatrix keeps the costs of OCR reading letters as other letters
+type OCRConfusionMatrix struct {
+	// costs are keyed by the truth and OCR letters separated by a space, missing letters are empty
+	costs map[string]float64
+	// editCost is the cost of letter edits not in costs
+	editCost float64
+}
+
+var ocrConfusions = MustParseOCRConfusions(strings.NewReader(defaultOCRConfusionsData))
+
+// ParseOCRConfusions reads an OCR confusion matrix in CSV format. Lines beginning with # are comments.
+func ParseOCRConfusions(reader io.Reader) (*OCRConfusionMatrix, error) {
+	csvr := csv.NewReader(reader)
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 3
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	m := OCRConfusionMatrix{costs: make(map[string]float64), editCost: -1}
+
+	for i, record := range records {
+		if i == 0 && record[0] == "truth" {
+			continue
+		}
+
+		cost, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil || cost < 0 {
+			return nil, fmt.Errorf("OCR confusion %s %s: cost should be a positive number: %s", record[0], record[1], record[2])
+		}
+
+		if strings.TrimSpace(record[0]) == "*" && strings.TrimSpace(record[1]) == "*" {
+			m.editCost = cost
+			continue
+		}
+
+		truth := strings.Fields(record[0])
+		ocr := strings.Fields(record[1])
+		if len(truth) == 0 && len(ocr) == 0 {
+			return nil, fmt.Errorf("OCR confusion %d has no letters", i)
+		}
+		for _, l := range append(truth, ocr...) {
+			if _, exists := VisencToUnicodeMap[l]; !exists {
+				return nil, fmt.Errorf("OCR confusion %s %s: %s is not a visenc letter", record[0], record[1], l)
+			}
+		}
+		// a missing letter is written as an empty letter
+		if len(truth) == 0 {
+			truth = []string{""}
+		}
+		if len(ocr) == 0 {
+			ocr = []string{""}
+		}
+
+		for _, t := range truth {
+			for _, o := range ocr {
+				if c, exists := m.costs[t+" "+o]; t != o && (!exists || cost < c) {
+					m.costs[t+" "+o] = cost
+				}
+			}
+		}
+	}
+
+	if m.editCost < 0 {
+		return nil, fmt.Errorf("OCR confusions should have the cost of other edits in a *,* row")
+	}
+
+	return &m, nil
+}
+
+// MustParseOCRConfusions is like ParseOCRConfusions but panics if the confusions cannot be parsed
+func MustParseOCRConfusions(reader io.Reader) *OCRConfusionMatrix {
+	m, err := ParseOCRConfusions(reader)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// LoadOCRConfusions reads an OCR confusion matrix file and makes it the matrix used by the package
+func LoadOCRConfusions(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	m, err := ParseOCRConfusions(file)
+	if err != nil {
+		return err
+	}
+
+	ocrConfusions = m
+	return nil
+}
+
+// InitOCRConfusions replaces the embedded OCR confusion matrix with a file, if filename is not empty.
+// If the file cannot be loaded, the embedded matrix is used.
+func InitOCRConfusions(filename string) {
+	if filename == "" {
+		return
+	}
+	if err := LoadOCRConfusions(filename); err != nil {
+		log.Printf("OCR confusion matrix is not loaded: %s", err)
+	}
+}
+
+// GetOCRConfusions returns the OCR confusion matrix the package uses
+func GetOCRConfusions() *OCRConfusionMatrix {
+	return ocrConfusions
+}
+
+// WriteCSV writes the matrix in the format read by ParseOCRConfusions, the most likely confusions first
+func (m *OCRConfusionMatrix) WriteCSV(writer io.Writer) error {
+	keys := make([]string, 0, len(m.costs))
+	for k := range m.costs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if m.costs[keys[i]] != m.costs[keys[j]] {
+			return m.costs[keys[i]] < m.costs[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	csvw := csv.NewWriter(writer)
+	csvw.Write([]string{"truth", "ocr", "cost"})
+	csvw.Write([]string{"*", "*", strconv.FormatFloat(m.editCost, 'f', 2, 64)})
+	for _, k := range keys {
+		letters := strings.SplitN(k, " ", 2)
+		csvw.Write([]string{letters[0], letters[1], strconv.FormatFloat(m.costs[k], 'f', 2, 64)})
+	}
+	csvw.Flush()
+	return csvw.Error()
+}
+
+// cost returns the cost of reading truth letter as ocr letter, either of them may be empty
+func (m *OCRConfusionMatrix) cost(truth string, ocr string) float64 {
+	if truth == ocr {
+		return 0
+	}
+	if c, exists := m.costs[truth+" "+ocr]; exists {
+		return c
+	}
+	return m.editCost
+}
+
+// editTable returns the table of costs reading the first letters of truth as the first letters of ocr.
+// Edits of OCR letters cost less by their confidences, confidences may be nil if all letters are certain.
+func (m *OCRConfusionMatrix) editTable(truth []string, ocr []string, confidences []float64) [][]float64 {
+	confidence := func(j int) float64 {
+		if j < len(confidences) {
+			return confidences[j]
+		}
+		return 1
+	}
+
+	d := make([][]float64, len(truth)+1)
+	for i := range d {
+		d[i] = make([]float64, len(ocr)+1)
+	}
+	for i := 1; i <= len(truth); i++ {
+		d[i][0] = d[i-1][0] + m.cost(truth[i-1], "")
+	}
+	for j := 1; j <= len(ocr); j++ {
+		d[0][j] = d[0][j-1] + m.cost("", ocr[j-1])*confidence(j-1)
+	}
+
+	for i := 1; i <= len(truth); i++ {
+		for j := 1; j <= len(ocr); j++ {
+			d[i][j] = math.Min(math.Min(
+				d[i-1][j]+m.cost(truth[i-1], ""),
+				d[i][j-1]+m.cost("", ocr[j-1])*confidence(j-1)),
+				d[i-1][j-1]+m.cost(truth[i-1], ocr[j-1])*confidence(j-1))
+		}
+	}
+
+	return d
+}
+
+// Distance returns the cost of OCR reading truth letters as ocr letters with the confidences of ocr letters
+func (m *OCRConfusionMatrix) Distance(truth []string, ocr []string, confidences []float64) float64 {
+	return m.editTable(truth, ocr, confidences)[len(truth)][len(ocr)]
+}
+
+// align returns the truth and OCR letters read as each other with the least cost, missing letters are empty
+func (m *OCRConfusionMatrix) align(truth []string, ocr []string) [][2]string {
+	d := m.editTable(truth, ocr, nil)
+	pairs := make([][2]string, 0, len(truth))
+	i, j := len(truth), len(ocr)
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && d[i][j] == d[i-1][j-1]+m.cost(truth[i-1], ocr[j-1]):
+			pairs = append(pairs, [2]string{truth[i-1], ocr[j-1]})
+			i, j = i-1, j-1
+		case i > 0 && d[i][j] == d[i-1][j]+m.cost(truth[i-1], ""):
+			pairs = append(pairs, [2]string{truth[i-1], ""})
+			i--
+		default:
+			pairs = append(pairs, [2]string{"", ocr[j-1]})
+			j--
+		}
+	}
+	for l, r := 0, len(pairs)-1; l < r; l, r = l+1, r-1 {
+		pairs[l], pairs[r] = pairs[r], pairs[l]
+	}
+	return pairs
+}
+
+// ocrTrainer counts the letters of ground truth words and how OCR read them
+type ocrTrainer struct {
+	// matrix aligns the letters of words
+	matrix *OCRConfusionMatrix
+	// pairs counts truth letters read as OCR letters, keyed like the costs of the matrix
+	pairs map[string]int
+	// letters counts truth letters, the empty letter counts all letters as OCR may add a letter after any of them
+	letters map[string]int
+}
+
+func newOCRTrainer() *ocrTrainer {
+	return &ocrTrainer{matrix: ocrConfusions, pairs: make(map[string]int), letters: make(map[string]int)}
+}
+
+// addWord counts the letters of a ground truth word read as an OCR word
+func (ot *ocrTrainer) addWord(truth []string, ocr []string) {
+	for _, p := range ot.matrix.align(truth, ocr) {
+		if p[0] != "" {
+			ot.letters[p[0]]++
+			ot.letters[""]++
+		}
+		if p[0] != p[1] {
+			ot.pairs[p[0]+" "+p[1]]++
+		}
+	}
+}
+
+// wordLetters returns the letters of the Ottoman words of a line
+func wordLetters(line string) [][]string {
+	words := make([][]string, 0)
+	for _, w := range ottomanWords(line) {
+		if letters := spellingLetters(ottomanProfile.UnicodeToVisenc(line[w[0]:w[1]])); len(letters) > 0 {
+			words = append(words, letters)
+		}
+	}
+	return words
+}
+
+// addText counts the letters of a ground truth text read as an OCR text. Texts should have the same lines except
+// empty ones, words of a line are aligned and those read too differently to be the same word are skipped.
+func (ot *ocrTrainer) addText(truth string, ocr string) error {
+	nonEmpty := func(text string) []string {
+		lines := make([]string, 0)
+		for _, l := range strings.Split(text, "\n") {
+			if strings.TrimSpace(l) != "" {
+				lines = append(lines, l)
+			}
+		}
+		return lines
+	}
+	truthLines, ocrLines := nonEmpty(truth), nonEmpty(ocr)
+	if len(truthLines) != len(ocrLines) {
+		return fmt.Errorf("Ground truth has %d lines and OCR has %d lines", len(truthLines), len(ocrLines))
+	}
+
+	for l := range truthLines {
+		tw, ow := wordLetters(truthLines[l]), wordLetters(ocrLines[l])
+		// distance of words is relative to replacing all of their letters, adding or removing a word costs 1
+		distance := func(i int, j int) float64 {
+			return ot.matrix.Distance(tw[i], ow[j], nil) / (ot.matrix.editCost * float64(TFint(len(tw[i]) > len(ow[j]), len(tw[i]), len(ow[j]))))
+		}
+
+		d := make([][]float64, len(tw)+1)
+		for i := range d {
+			d[i] = make([]float64, len(ow)+1)
+			d[i][0] = float64(i)
+		}
+		for j := range d[0] {
+			d[0][j] = float64(j)
+		}
+		for i := 1; i <= len(tw); i++ {
+			for j := 1; j <= len(ow); j++ {
+				d[i][j] = math.Min(math.Min(d[i-1][j]+1, d[i][j-1]+1), d[i-1][j-1]+distance(i-1, j-1))
+			}
+		}
+
+		for i, j := len(tw), len(ow); i > 0 && j > 0; {
+			switch dist := distance(i-1, j-1); {
+			case d[i][j] == d[i-1][j-1]+dist:
+				if dist <= ocrAlignmentLimit {
+					ot.addWord(tw[i-1], ow[j-1])
+				}
+				i, j = i-1, j-1
+			case d[i][j] == d[i-1][j]+1:
+				i--
+			default:
+				j--
+			}
+		}
+	}
+
+	return nil
+}
+
+// confusions returns the matrix of costs estimated from the counts, unseen edits are half as likely as those seen once
+func (ot *ocrTrainer) confusions() *OCRConfusionMatrix {
+	m := OCRConfusionMatrix{costs: make(map[string]float64), editCost: -math.Log(0.5 / float64(ot.letters[""]+1))}
+	for k, n := range ot.pairs {
+		truth := strings.SplitN(k, " ", 2)[0]
+		m.costs[k] = -math.Log((float64(n) + 0.5) / float64(ot.letters[truth]+1))
+	}
+	return &m
+}
+
+// TrainOCRConfusions estimates an OCR confusion matrix from ground truth files and the OCR output of the same pages.
+// Files are paired in order and their lines should be the same.
+func TrainOCRConfusions(truthfiles []string, ocrfiles []string) (*OCRConfusionMatrix, error) {
+	if len(truthfiles) != len(ocrfiles) {
+		return nil, fmt.Errorf("%d ground truth files and %d OCR files cannot be paired", len(truthfiles), len(ocrfiles))
+	}
+
+	ot := newOCRTrainer()
+	for i := range truthfiles {
+		truth, err := os.ReadFile(truthfiles[i])
+		if err != nil {
+			return nil, err
+		}
+		ocr, err := os.ReadFile(ocrfiles[i])
+		if err != nil {
+			return nil, err
+		}
+		if err := ot.addText(string(truth), string(ocr)); err != nil {
+			return nil, fmt.Errorf("%s and %s: %s", truthfiles[i], ocrfiles[i], err)
+		}
+	}
+
+	if ot.letters[""] == 0 {
+		return nil, fmt.Errorf("No words could be aligned in the ground truth and OCR files")
+	}
+	return ot.confusions(), nil
+}
+
+// ocrLetters returns the letters of an OCR token written in the script of p and their confidences
+func (p *ScriptProfile) ocrLetters(token string, confidences []float32) ([]string, []float64) {
+	letters := make([]string, 0)
+	letterConfidences := make([]float64, 0)
+	i := 0
+	for _, r := range token {
+		c := 1.0
+		if i < len(confidences) {
+			c = math.Max(0, math.Min(1, float64(confidences[i])))
+		}
+		i++
+		for _, l := range spellingLetters(p.UnicodeToVisenc(string(r))) {
+			letters = append(letters, l)
+			letterConfidences = append(letterConfidences, c)
+		}
+	}
+	return letters, letterConfidences
+}
+
+// CorrectOCRToken returns at most k dictionary words OCR may have read as a token written in the script of p.
+// Candidates have the same or a near dotless skeleton as the token and the least likely readings are returned last.
+func (p *ScriptProfile) CorrectOCRToken(token string, confidences []float32, k int) *OCRCorrection {
+	if k <= 0 {
+		k = MAXOCRCANDIDATES
+	}
+	out := OCRCorrection{Token: token, Candidates: make([]*SpellSuggestion, 0)}
+
+	letters, letterConfidences := p.ocrLetters(token, confidences)
+	if len(letters) == 0 {
+		return &out
+	}
+	visenc := strings.Join(letters, "")
+	out.Known = KnownSpelling(visenc)
+
+	distance := func(word []string, root []string) float64 {
+		// word is the token or its first letters before suffixes
+		return ocrConfusions.Distance(root, word, letterConfidences[:len(word)])
+	}
+	out.Candidates = p.localizeSuggestions(suggestSpellings(visenc, k, distance, maxOCRCost))
+	return &out
+}
+
+// CorrectOCRText corrects the words of an OCR text written in the script of p. The text is returned with unknown
+// words replaced by their first candidates.
+func (p *ScriptProfile) CorrectOCRText(text string, k int) *OCRResponse {
+	out := OCRResponse{Corrections: make([]*OCRCorrection, 0)}
+	// words are corrected once, corrections of a word repeated in the text are shared
+	corrected := make(map[string]*OCRCorrection)
+
+	var sb strings.Builder
+	last := 0
+	for _, w := range ottomanWords(text) {
+		word := text[w[0]:w[1]]
+		c, exists := corrected[word]
+		if !exists {
+			c = p.CorrectOCRToken(word, nil, k)
+			corrected[word] = c
+		}
+
+		out.Corrections = append(out.Corrections, &OCRCorrection{Token: word, Start: int32(w[0]), End: int32(w[1]), Known: c.Known, Candidates: c.Candidates})
+		if !c.Known && len(c.Candidates) > 0 {
+			sb.WriteString(text[last:w[0]])
+			sb.WriteString(c.Candidates[0].Ottoman.Unicode)
+			last = w[1]
+		}
+	}
+	sb.WriteString(text[last:])
+	out.Text = sb.String()
+
+	return &out
+}
+
+// CorrectOCR answers an OCR correction request, tokens and candidates are written in the requested script
+func CorrectOCR(in *OCRRequest) *OCRResponse {
+	p := GetScriptProfile(in.Script)
+	if len(in.Tokens) == 0 {
+		return p.CorrectOCRText(in.Text, int(in.CandidateLimit))
+	}
+
+	out := OCRResponse{Corrections: make([]*OCRCorrection, len(in.Tokens))}
+	words := make([]string, len(in.Tokens))
+	for i, t := range in.Tokens {
+		out.Corrections[i] = p.CorrectOCRToken(t.Text, t.Confidences, int(in.CandidateLimit))
+		words[i] = t.Text
+		if c := out.Corrections[i]; !c.Known && len(c.Candidates) > 0 {
+			words[i] = c.Candidates[0].Ottoman.Unicode
+		}
+	}
+	out.Text = strings.Join(words, " ")
+	return &out
+}
@@ -0,0 +1,139 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// func ParseOCRConfusions(reader io.Reader) (*OCRConfusionMatrix, error) {
+func TestParseOCRConfusions(t *testing.T) {
+	badTables := map[string]string{
+		"no edit cost":  "s,so3,2\n",
+		"not a letter":  "*,*,6\ns,qq,2\n",
+		"no letters":    "*,*,6\n,,2\n",
+		"bad cost":      "*,*,6\ns,so3,likely\n",
+		"negative cost": "*,*,6\ns,so3,-2\n",
+		"missing field": "*,*,6\ns,so3\n",
+	}
+
+	for i, o := range badTables {
+		if _, err := ParseOCRConfusions(strings.NewReader(o)); err == nil {
+			t.Log(fmt.Sprintf("%s should fail ParseOCRConfusions", i))
+			t.Fail()
+		}
+	}
+}
+
+// func (m *OCRConfusionMatrix) Distance(truth []string, ocr []string, confidences []float64) float64 {
+func TestOCRDistance(t *testing.T) {
+	m := MustParseOCRConfusions(strings.NewReader("*,*,6\nso3,s,2\ne,,3\n"))
+
+	testDict := map[string]float64{
+		// truth, OCR and the confidences of OCR letters
+		"so3hr so3hr":            0,
+		"so3hr shr":              2,
+		"so3hr shr 0.5":          1,
+		"so3hr so3hrro1":         6,
+		"so3hr so3hrro1 1,1,1,0": 0,
+		"kbo2ebu1 kbo2bu1":       3,
+		"hr so3hr 0.5":           3,
+	}
+
+	for i, o := range testDict {
+		f := strings.Fields(i)
+		var confidences []float64
+		if len(f) > 2 {
+			for _, c := range strings.Split(f[2], ",") {
+				var v float64
+				fmt.Sscan(c, &v)
+				confidences = append(confidences, v)
+			}
+		}
+		if d := m.Distance(spellingLetters(f[0]), spellingLetters(f[1]), confidences); d != o {
+			t.Log(fmt.Sprintf("Distance of %s should be %.1f: %.1f", i, o, d))
+			t.Fail()
+		}
+	}
+}
+
+// func (ot *ocrTrainer) addText(truth string, ocr string) error {
+func TestTrainOCRConfusions(t *testing.T) {
+	ot := newOCRTrainer()
+	// ش is read as س twice, and a word OCR split in two is not aligned with it
+	truth := "شهر کتاب\n\nشمس قلم کتابلر\n"
+	ocr := "سهر کتاب\nسمس قلم کتا بلر\n"
+	if err := ot.addText(truth, ocr); err != nil {
+		t.Log(fmt.Sprintf("Ground truth and OCR should be aligned: %s", err))
+		t.FailNow()
+	}
+
+	if n := ot.pairs["so3 s"]; n != 2 {
+		t.Log(fmt.Sprintf("ش should be read as س twice: %d", n))
+		t.Fail()
+	}
+	if n := ot.letters["so3"]; n != 2 {
+		t.Log(fmt.Sprintf("ش should be counted twice: %d", n))
+		t.Fail()
+	}
+	m := ot.confusions()
+	if m.cost("so3", "s") >= m.editCost {
+		t.Log(fmt.Sprintf("Reading ش as س should cost less than other edits: %.2f %.2f", m.cost("so3", "s"), m.editCost))
+		t.Fail()
+	}
+
+	// the written matrix is read back with the same costs
+	var sb strings.Builder
+	if err := m.WriteCSV(&sb); err != nil {
+		t.Log(fmt.Sprintf("Matrix should be written: %s", err))
+		t.FailNow()
+	}
+	read, err := ParseOCRConfusions(strings.NewReader(sb.String()))
+	if err != nil || len(read.costs) != len(m.costs) || fmt.Sprintf("%.2f", read.cost("so3", "s")) != fmt.Sprintf("%.2f", m.cost("so3", "s")) {
+		t.Log(fmt.Sprintf("Written matrix should be read back: %s\n%s", err, sb.String()))
+		t.Fail()
+	}
+
+	if err := ot.addText("شهر\nکتاب\n", "سهر\n"); err == nil {
+		t.Log("Texts with different lines should not be aligned")
+		t.Fail()
+	}
+}
+
+// func (p *ScriptProfile) CorrectOCRText(text string, k int) *OCRResponse {
+func TestCorrectOCR(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+	InitLemmatizer(SUFFIXSETFILE)
+
+	// dots of شهر, قلم and کتابلرده are lost or misplaced
+	text := "سهر، فلم کتابلرذه"
+	out := CorrectOCR(&OCRRequest{Text: text, CandidateLimit: 3})
+
+	if out.Text != "شهر، قلم کتابلرده" {
+		t.Log(fmt.Sprintf("%s should be corrected to شهر، قلم کتابلرده: %s", text, out.Text))
+		t.Fail()
+	}
+	if len(out.Corrections) != 3 {
+		t.Log(fmt.Sprintf("%s should have 3 corrections: %v", text, out.Corrections))
+		t.FailNow()
+	}
+	for _, c := range out.Corrections {
+		if text[c.Start:c.End] != c.Token || c.Known || len(c.Candidates) == 0 || len(c.Candidates) > 3 {
+			t.Log(fmt.Sprintf("%s should be an unknown token at its offsets with at most 3 candidates: %v", c.Token, c))
+			t.Fail()
+		}
+	}
+
+	// a doubtful character makes its edits cheaper
+	certain := CorrectOCR(&OCRRequest{Tokens: []*OCRToken{{Text: "فلم"}}})
+	doubtful := CorrectOCR(&OCRRequest{Tokens: []*OCRToken{{Text: "فلم", Confidences: []float32{0.2, 1, 1}}}})
+	if len(certain.Corrections[0].Candidates) == 0 || len(doubtful.Corrections[0].Candidates) == 0 ||
+		doubtful.Corrections[0].Candidates[0].Distance >= certain.Corrections[0].Candidates[0].Distance {
+		t.Log(fmt.Sprintf("Candidates of a doubtful ف should cost less: %v %v", certain.Corrections[0], doubtful.Corrections[0]))
+		t.Fail()
+	}
+	if doubtful.Text != "قلم" {
+		t.Log(fmt.Sprintf("فلم should be corrected to قلم: %s", doubtful.Text))
+		t.Fail()
+	}
+}
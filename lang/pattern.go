@@ -0,0 +1,343 @@
+package lang
+
+import (
+	_ "embed" // default letter classes are embedded
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// Search patterns match words letter by letter. Letters are visenc letter groups like bu1 or Ottoman
+// letters like ب, vowel marks and joiners are not compared.
+//
+//	?        any one letter
+//	*        any sequence of letters, also empty
+//	[bu1 bo2] one of the letters in brackets, [^bu1 bo2] any letter except them
+//	{bowl}   one of the letters in a class, see data/letterclasses.csv
+//
+// A pattern matches the whole word, e.g. k?ebu1 matches کتاب and کباب, but not کتابلر.
+
+//go:embed data/letterclasses.csv
+var defaultLetterClassesData string
+
+// LetterClass is a named set of visenc letters used in search patterns as {name}
+type LetterClass struct {
+	Name        string
+	Letters     []string
+	Description string
+}
+
+var letterClasses = MustParseLetterClasses(strings.NewReader(defaultLetterClassesData))
+
+// patternLetterIndex keeps the letters of each root compared by patterns, in the order of rootSet.Roots
+var patternLetterIndex [][]string
+
+// ParseLetterClasses reads letter classes in CSV format. Lines beginning with # are comments.
+func ParseLetterClasses(reader io.Reader) (map[string]*LetterClass, error) {
+	csvr := csv.NewReader(reader)
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 3
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	classes := make(map[string]*LetterClass)
+	for i, record := range records {
+		if i == 0 && record[0] == "name" {
+			continue
+		}
+
+		class := LetterClass{Name: strings.TrimSpace(record[0]), Letters: strings.Fields(record[1]), Description: strings.TrimSpace(record[2])}
+		if _, exists := classes[class.Name]; exists {
+			return nil, fmt.Errorf("Letter class %s is defined more than once", class.Name)
+		}
+		for _, l := range class.Letters {
+			if _, exists := VisencToUnicodeMap[l]; !exists {
+				return nil, fmt.Errorf("Letter class %s: %s is not a visenc letter", class.Name, l)
+			}
+		}
+		classes[class.Name] = &class
+	}
+
+	return classes, nil
+}
+
+// MustParseLetterClasses is like ParseLetterClasses but panics if the classes cannot be parsed
+func MustParseLetterClasses(reader io.Reader) map[string]*LetterClass {
+	classes, err := ParseLetterClasses(reader)
+	if err != nil {
+		panic(err)
+	}
+	return classes
+}
+
+// GetLetterClasses returns the letter classes patterns can use
+func GetLetterClasses() map[string]*LetterClass {
+	return letterClasses
+}
+
+// patternElement is a single letter, a set of letters, any letter or any sequence in a pattern
+type patternElement struct {
+	// letters is nil for any letter
+	letters map[string]bool
+	negate  bool
+	star    bool
+}
+
+func (e *patternElement) matches(letter string) bool {
+	// only a space matches a space, words of multiword roots are not joined by ? or sets
+	if letter == " " {
+		return e.letters[" "] && !e.negate
+	}
+	if e.letters == nil {
+		return true
+	}
+	return e.letters[letter] != e.negate
+}
+
+// LetterPattern is a compiled search pattern
+type LetterPattern struct {
+	Pattern  string
+	elements []*patternElement
+}
+
+// CompilePattern compiles a search pattern with Ottoman letters
+func CompilePattern(pattern string) (*LetterPattern, error) {
+	return ottomanProfile.CompilePattern(pattern)
+}
+
+// MustCompilePattern is like CompilePattern but panics if the pattern cannot be compiled
+func MustCompilePattern(pattern string) *LetterPattern {
+	lp, err := CompilePattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return lp
+}
+
+// CompilePattern compiles a search pattern whose Unicode letters are written in the script
+func (p *ScriptProfile) CompilePattern(pattern string) (*LetterPattern, error) {
+	lp := LetterPattern{Pattern: pattern, elements: make([]*patternElement, 0)}
+	runes := []rune(pattern)
+
+	for i := 0; i < len(runes); {
+		switch runes[i] {
+		case '?':
+			lp.elements = append(lp.elements, &patternElement{})
+			i++
+		case '*':
+			// consecutive stars are the same as one
+			if n := len(lp.elements); n == 0 || !lp.elements[n-1].star {
+				lp.elements = append(lp.elements, &patternElement{star: true})
+			}
+			i++
+		case ' ':
+			lp.elements = append(lp.elements, &patternElement{letters: map[string]bool{" ": true}})
+			i++
+		case '[':
+			end := indexRune(runes, i, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("Pattern %s: [ is not closed", pattern)
+			}
+			e := patternElement{letters: make(map[string]bool)}
+			content := string(runes[i+1 : end])
+			if strings.HasPrefix(content, "^") {
+				e.negate = true
+				content = content[1:]
+			}
+			for _, field := range strings.Fields(content) {
+				letters, err := p.patternLetters(field)
+				if err != nil {
+					return nil, fmt.Errorf("Pattern %s: %s", pattern, err)
+				}
+				for _, l := range expandClasses(letters) {
+					e.letters[l] = true
+				}
+			}
+			if len(e.letters) == 0 {
+				return nil, fmt.Errorf("Pattern %s: empty set", pattern)
+			}
+			lp.elements = append(lp.elements, &e)
+			i = end + 1
+		case ']', '}':
+			return nil, fmt.Errorf("Pattern %s: %c is not opened", pattern, runes[i])
+		default:
+			end := i
+			for end < len(runes) && !strings.ContainsRune("?* []}", runes[end]) {
+				if runes[end] == '{' {
+					if end = indexRune(runes, end, '}'); end < 0 {
+						return nil, fmt.Errorf("Pattern %s: { is not closed", pattern)
+					}
+				}
+				end++
+			}
+			letters, err := p.patternLetters(string(runes[i:end]))
+			if err != nil {
+				return nil, fmt.Errorf("Pattern %s: %s", pattern, err)
+			}
+			for _, l := range letters {
+				e := patternElement{letters: make(map[string]bool)}
+				for _, cl := range expandClasses([]string{l}) {
+					e.letters[cl] = true
+				}
+				lp.elements = append(lp.elements, &e)
+			}
+			i = end
+		}
+	}
+
+	return &lp, nil
+}
+
+// indexRune returns the index of r in runes after start, or -1
+func indexRune(runes []rune, start int, r rune) int {
+	for i := start + 1; i < len(runes); i++ {
+		if runes[i] == r {
+			return i
+		}
+	}
+	return -1
+}
+
+// patternLetters splits s into visenc letters. Ottoman letters are converted to visenc and {class} names are kept as they are.
+func (p *ScriptProfile) patternLetters(s string) ([]string, error) {
+	letters := make([]string, 0)
+
+	for len(s) > 0 {
+		if strings.HasPrefix(s, "{") {
+			end := strings.Index(s, "}")
+			if end < 0 {
+				return nil, fmt.Errorf("{ is not closed")
+			}
+			name := s[1:end]
+			if _, exists := letterClasses[name]; !exists {
+				return nil, fmt.Errorf("Unknown letter class %s", name)
+			}
+			letters = append(letters, s[:end+1])
+			s = s[end+1:]
+			continue
+		}
+
+		end := strings.Index(s, "{")
+		if end < 0 {
+			end = len(s)
+		}
+		visenc := arabicRunRegex.ReplaceAllStringFunc(s[:end], p.UnicodeToVisenc)
+		for _, v := range SplitVisenc(RemoveJoiners(visenc), true) {
+			if _, exists := VisencToUnicodeMap[v]; !exists {
+				return nil, fmt.Errorf("%s is not a visenc letter", v)
+			}
+			if isLetterGroup(v) {
+				letters = append(letters, v)
+			}
+		}
+		s = s[end:]
+	}
+
+	return letters, nil
+}
+
+// expandClasses replaces {class} names in letters with the letters of the classes
+func expandClasses(letters []string) []string {
+	out := make([]string, 0, len(letters))
+	for _, l := range letters {
+		if class, exists := letterClasses[strings.Trim(l, "{}")]; exists && strings.HasPrefix(l, "{") {
+			out = append(out, class.Letters...)
+		} else {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+// isLetterGroup checks whether a visenc group is a letter or a space, not a vowel mark or a format character
+func isLetterGroup(v string) bool {
+	for _, r := range VisencToUnicodeMap[v] {
+		if !unicode.Is(unicode.Mn, r) && !unicode.Is(unicode.Cf, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// patternUnits returns the letters of a visenc word compared by patterns.
+// Letters are the same strings as the visenc table keys, so indexed words share them.
+func patternUnits(visenc string) []string {
+	units := make([]string, 0, len(visenc)/2)
+	for _, v := range SplitVisenc(RemoveJoiners(visenc), false) {
+		if !isLetterGroup(v) {
+			continue
+		}
+		if l, exists := internedLetters[v]; exists {
+			v = l
+		}
+		units = append(units, v)
+	}
+	return units
+}
+
+var internedLetters = make(map[string]string)
+
+func buildPatternIndex(roots []*Root) {
+	for v := range VisencToUnicodeMap {
+		internedLetters[v] = v
+	}
+	patternLetterIndex = make([][]string, len(roots))
+	for i, r := range roots {
+		patternLetterIndex[i] = patternUnits(r.Ottoman.Visenc)
+	}
+}
+
+// MatchVisenc checks whether the letters of a visenc word match the pattern
+func (lp *LetterPattern) MatchVisenc(visenc string) bool {
+	return lp.matchUnits(patternUnits(visenc))
+}
+
+// matchUnits matches letters to elements, a star is extended one letter at a time when the rest doesn't match
+func (lp *LetterPattern) matchUnits(units []string) bool {
+	e, u := 0, 0
+	starE, starU := -1, 0
+
+	for u < len(units) {
+		switch {
+		case e < len(lp.elements) && lp.elements[e].star:
+			starE, starU = e, u
+			e++
+		case e < len(lp.elements) && lp.elements[e].matches(units[u]):
+			e++
+			u++
+		case starE >= 0:
+			starU++
+			e, u = starE+1, starU
+		default:
+			return false
+		}
+	}
+
+	for e < len(lp.elements) && lp.elements[e].star {
+		e++
+	}
+	return e == len(lp.elements)
+}
+
+// PatternSearch returns at most maxLen roots whose Ottoman spelling matches the pattern
+func PatternSearch(lp *LetterPattern, maxLen int) []*Root {
+	results := make([]*Root, 0)
+	for i, units := range patternLetterIndex {
+		if lp.matchUnits(units) {
+			results = append(results, rootSet.Roots[i])
+		}
+	}
+
+	results = filterResults(results)
+	results = sortByLength(results)
+	if maxLen < len(results) {
+		results = results[:maxLen]
+	}
+
+	return results
+}
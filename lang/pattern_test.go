@@ -0,0 +1,92 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// func (lp *LetterPattern) MatchVisenc(visenc string) bool {
+func TestMatchVisenc(t *testing.T) {
+	testDict := map[string]map[string]bool{
+		"k?ebu1": {"kbo2ebu1": true, "ko4bo2ebu1": true, "kbu1ebu1": true, "kbo2ebu1lr": false, "kebu1": false},
+		// ? is a letter group, b? doesn't match bu1 as b and u
+		"bu1?":          {"bu1r": true, "bu1": false, "bu1bo2r": false},
+		"*ebu1":         {"kbo2ebu1": true, "ebu1": true, "kbo2ebu1lr": false},
+		"k*r":           {"kr": true, "kwr": true, "kbo2ebu1r": true, "k r": true, "kbo2": false},
+		"[bo2 bu1]ebu1": {"bo2ebu1": true, "bu1ebu1": true, "bo3ebu1": false},
+		"[^bo2]ebu1":    {"bo3ebu1": true, "bo2ebu1": false, " ebu1": false},
+		"k{bowl}ebu1":   {"kbo2ebu1": true, "kbo1ebu1": true, "klebu1": false},
+		"[{dal} r]e":    {"do1e": true, "re": true, "se": false},
+		"کت?ب":          {"kbo2ebu1": true, "kbo2bu1": false},
+		"fo2lah":        {"fo2lah||": true, "fo2o4lo0ah": true},
+		"?? ?":          {"ad r": true, "adr": false},
+	}
+
+	for p, words := range testDict {
+		lp := MustCompilePattern(p)
+		for v, o := range words {
+			if lp.MatchVisenc(v) != o {
+				t.Log(fmt.Sprintf("%s should match %s: %t", p, v, o))
+				t.Fail()
+			}
+		}
+	}
+}
+
+// func CompilePattern(pattern string) (*LetterPattern, error) {
+func TestCompilePattern(t *testing.T) {
+	badPatterns := []string{"k[bu1", "kbu1]", "k{bowl", "k{klingon}", "k[]", "kq", "k[{nothing}]"}
+
+	for _, p := range badPatterns {
+		if _, err := CompilePattern(p); err == nil {
+			t.Log(fmt.Sprintf("%s should not compile", p))
+			t.Fail()
+		}
+	}
+
+	if _, err := GetScriptProfile(Script_ARABIC).CompilePattern("كت?ب"); err != nil {
+		t.Log(err)
+		t.Fail()
+	}
+}
+
+// func ParseLetterClasses(reader io.Reader) (map[string]*LetterClass, error) {
+func TestParseLetterClasses(t *testing.T) {
+	for name, class := range GetLetterClasses() {
+		if len(class.Letters) == 0 {
+			t.Log(fmt.Sprintf("Letter class %s is empty", name))
+			t.Fail()
+		}
+	}
+
+	badClasses := map[string]string{
+		"duplicate class": "name,letters,description\nbowl,bu1,beh\nbowl,bo2,teh\n",
+		"unknown letter":  "name,letters,description\nbowl,bu1 bq,beh\n",
+	}
+	for i, o := range badClasses {
+		if _, err := ParseLetterClasses(strings.NewReader(o)); err == nil {
+			t.Log(fmt.Sprintf("%s should fail ParseLetterClasses", i))
+			t.Fail()
+		}
+	}
+}
+
+// func PatternSearch(lp *LetterPattern, maxLen int) []*Root {
+func TestPatternSearch(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	roots := PatternSearch(MustCompilePattern("k?ebu1"), 100)
+	found := false
+	for _, r := range roots {
+		found = found || r.TurkishLatin == "kitap"
+		if !strings.HasPrefix(r.Ottoman.Unicode, "ک") {
+			t.Log(fmt.Sprintf("%s doesn't match k?ebu1", r.Ottoman.Visenc))
+			t.Fail()
+		}
+	}
+	if !found {
+		t.Log(fmt.Sprintf("k?ebu1 should find kitap: %s", PrintRoots(roots)))
+		t.Fail()
+	}
+}
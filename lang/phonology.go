@@ -0,0 +1,136 @@
+package lang
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Vowel classes used for vowel harmony
+const (
+	backVowels    = "aâıouû"
+	frontVowels   = "eiîöü"
+	roundedVowels = "oöuüû"
+)
+
+// HarmonyClass returns the harmony class of a vowel as front or back and rounded or unrounded, e.g. "back-unrounded"
+func HarmonyClass(vowel string) string {
+	if vowel == "" {
+		return ""
+	}
+	v := []rune(vowel)[0]
+
+	frontness := "front"
+	if strings.ContainsRune(backVowels, v) {
+		frontness = "back"
+	}
+
+	rounding := "unrounded"
+	if strings.ContainsRune(roundedVowels, v) {
+		rounding = "rounded"
+	}
+
+	return frontness + "-" + rounding
+}
+
+// HarmonyBreaks returns indices of syllables which break vowel harmony.
+// A syllable breaks major harmony when its vowel differs in frontness from the first syllable,
+// and minor harmony when it has o or ö after the first syllable, or a high vowel differing in rounding from the
+// vowel before it.
+func HarmonyBreaks(syllables []string) []int32 {
+	breaks := make([]int32, 0)
+	firstBack := false
+	var last rune
+
+	for i, syl := range syllables {
+		for _, r := range syl {
+			if !isVowelRune(r) {
+				continue
+			}
+			back := strings.ContainsRune(backVowels, r)
+			rounding := last != 0 && strings.ContainsRune("ıiuü", r) &&
+				strings.ContainsRune(roundedVowels, last) != strings.ContainsRune(roundedVowels, r)
+			if i == 0 {
+				firstBack = back
+			} else if back != firstBack || r == 'o' || r == 'ö' || rounding {
+				breaks = append(breaks, int32(i))
+			}
+			last = r
+			break
+		}
+	}
+
+	return breaks
+}
+
+// HyphenationPoints returns rune offsets in the joined syllables where a word can be broken at the end of a line.
+// Words are broken between syllables but a single letter is never left alone at either end.
+func HyphenationPoints(syllables []string) []int32 {
+	total := 0
+	for _, s := range syllables {
+		total += len([]rune(s))
+	}
+
+	points := make([]int32, 0)
+	offset := 0
+	for i := 0; i < len(syllables)-1; i++ {
+		offset += len([]rune(syllables[i]))
+		if offset > 1 && total-offset > 1 {
+			points = append(points, int32(offset))
+		}
+	}
+	return points
+}
+
+// AnalyzePhonology returns syllables, harmony class and features of an arbitrary Turkish Latin word.
+// The word does not need to exist in the dictionary.
+func AnalyzePhonology(word string) *PhonologyAnalysis {
+	clean := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) {
+			return r
+		}
+		return -1
+	}, TurkishLower(word))
+
+	syllables := Syllabify(clean)
+
+	longVowels := make([]string, 0)
+	for _, r := range clean {
+		if strings.ContainsRune(LONGVOWELS, r) {
+			longVowels = append(longVowels, string(r))
+		}
+	}
+
+	breaks := HarmonyBreaks(syllables)
+	points := HyphenationPoints(syllables)
+
+	var sb strings.Builder
+	runes := []rune(clean)
+	last := 0
+	for _, p := range points {
+		sb.WriteString(string(runes[last:p]))
+		sb.WriteString("-")
+		last = int(p)
+	}
+	sb.WriteString(string(runes[last:]))
+
+	effectiveLastVowel := EffectiveLastVowel(clean)
+
+	return &PhonologyAnalysis{
+		Word:               word,
+		Syllables:          syllables,
+		HarmonyClass:       HarmonyClass(effectiveLastVowel),
+		LongVowels:         longVowels,
+		LastVowel:          LastVowel(clean),
+		LastConsonant:      LastConsonant(clean),
+		EffectiveLastVowel: effectiveLastVowel,
+		EndsWithVowel:      EndsWithVowel(clean),
+		HasSingleVowel:     HasSingleVowel(clean),
+		LastVowelHard:      LastVowelHard(clean),
+		LastConsonantHard:  LastConsonantHard(clean),
+		HarmonyConsistent:  len(breaks) == 0,
+		HarmonyBreaks:      breaks,
+		HyphenationPoints:  points,
+		Hyphenated:         sb.String(),
+		RuleTrace:          phonologyRules.Trace(clean),
+	}
+}
@@ -0,0 +1,57 @@
+package lang
+
+import (
+	"fmt"
+	"testing"
+)
+
+// func AnalyzePhonology(word string) *PhonologyAnalysis {
+func TestAnalyzePhonology(t *testing.T) {
+	hyphenDict := map[string]string{
+		"kitaplar": "ki-tap-lar",
+		"araba":    "ara-ba",
+		"saat":     "sa-at",
+		"Türkçe":   "türk-çe",
+		"ev":       "ev",
+	}
+
+	for i, o := range hyphenDict {
+		if got := AnalyzePhonology(i).Hyphenated; got != o {
+			t.Log(fmt.Sprintf("%s, %s fails for AnalyzePhonology hyphenation: %s", i, o, got))
+			t.Fail()
+		}
+	}
+
+	harmonyDict := map[string]bool{
+		"kapılar":  true,
+		"evler":    true,
+		"kitap":    false,
+		"otobüs":   false,
+		"gözlükçü": true,
+		"okullu":   true,
+		// ı after u and i after ü break only rounding harmony
+		"muhtıra": false,
+		"mümkin":  false,
+	}
+
+	for i, o := range harmonyDict {
+		if got := AnalyzePhonology(i).HarmonyConsistent; got != o {
+			t.Log(fmt.Sprintf("%s, %t fails for AnalyzePhonology harmony", i, o))
+			t.Fail()
+		}
+	}
+
+	classDict := map[string]string{
+		"kitap": "back-unrounded",
+		"gül":   "front-rounded",
+		"hâl":   "front-unrounded",
+		"okul":  "back-rounded",
+	}
+
+	for i, o := range classDict {
+		if got := AnalyzePhonology(i).HarmonyClass; got != o {
+			t.Log(fmt.Sprintf("%s, %s fails for AnalyzePhonology harmony class: %s", i, o, got))
+			t.Fail()
+		}
+	}
+}
@@ -175,3 +175,55 @@ func LoadRootSetProtobuf(filename string) *RootSet {
 
 	return rootSet
 }
+
+// LoadSuffixSetProtobuf loads suffix set from a protobuffer file
+func LoadSuffixSetProtobuf(filename string) *SuffixSet {
+
+	byteSlice, err := ioutil.ReadFile(filename)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	suffixSet := &SuffixSet{}
+
+	err = proto.Unmarshal(byteSlice, suffixSet)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return suffixSet
+}
+
+// SaveNGramModelProtobuf saves an n-gram model to a protobuf file, replacing the file if it exists
+func SaveNGramModelProtobuf(filename string, model *NGramModel) {
+	byteSlice, err := proto.Marshal(model)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filename, byteSlice, 0666); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("%s: Wrote %d bytes.\n", filename, len(byteSlice))
+}
+
+// LoadNGramModelProtobuf loads an n-gram model from a protobuf file
+func LoadNGramModelProtobuf(filename string) *NGramModel {
+
+	byteSlice, err := ioutil.ReadFile(filename)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	model := &NGramModel{}
+
+	err = proto.Unmarshal(byteSlice, model)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return model
+}
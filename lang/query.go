@@ -0,0 +1,677 @@
+package lang
+
+import (
+	"fmt"
+	"math/bits"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Queries combine terms with AND, OR, NOT and parentheses, e.g.
+//
+//	tr:kitab* pos:noun abjad:400..500 src:redhouse -softening:true
+//
+// Terms written one after another are joined by AND, a term beginning with - is negated.
+// A term is field:value, or a value searched in the field DetectField finds for it.
+//
+//	tr, ot, v    Turkish Latin, Ottoman and visenc spellings. kitab* is a prefix, *ab a suffix, k*b matches the whole word.
+//	pat          a search pattern, see CompilePattern
+//	abjad        an abjad value or a range like 400..500
+//
+// Other fields are fields of Root by their JSON names, or the aliases in queryFieldAliases.
+// Booleans are true or false, enums are matched by a unique prefix of their names like proper for PROPER_NOUN,
+// numbers may be ranges and lists like sources match if they contain the value. Values with spaces are quoted.
+
+// queryFieldAliases are short names of Root fields in queries
+var queryFieldAliases = map[string]string{
+	"pos":           "partOfSpeech",
+	"src":           "sources",
+	"source":        "sources",
+	"softening":     "hasConsonantSoftening",
+	"singlevowel":   "hasSingleVowel",
+	"endsvowel":     "endsWithVowel",
+	"hardvowel":     "lastVowelHard",
+	"hardconsonant": "lastConsonantHard",
+	"alt":           "alternation",
+	"rule":          "phonologyRules",
+}
+
+// rootBits is a set of indices of rootSet.Roots
+type rootBits []uint64
+
+func newRootBits() rootBits {
+	return make(rootBits, (len(rootSet.Roots)+63)/64)
+}
+
+func allRootBits() rootBits {
+	b := newRootBits()
+	for i := range rootSet.Roots {
+		b.set(i)
+	}
+	return b
+}
+
+func (b rootBits) set(i int) {
+	b[i/64] |= 1 << uint(i%64)
+}
+
+func (b rootBits) has(i int) bool {
+	return b[i/64]&(1<<uint(i%64)) != 0
+}
+
+// visit calls f with each index in the set in increasing order
+func (b rootBits) visit(f func(int)) {
+	for w, word := range b {
+		for word != 0 {
+			t := bits.TrailingZeros64(word)
+			f(w*64 + t)
+			word &= word - 1
+		}
+	}
+}
+
+// queryNode is a part of a query plan. eval returns the roots among candidates the node matches, candidates are all roots if nil.
+type queryNode interface {
+	eval(candidates rootBits) rootBits
+	// cost is higher for nodes that check roots one by one than those using indices, cheaper nodes are evaluated first in AND
+	cost() int
+	String() string
+}
+
+const (
+	indexCost  = 1
+	scanCost   = 10
+	filterCost = 20
+)
+
+// indexTerm finds roots by an index
+type indexTerm struct {
+	text    string
+	c       int
+	indices func() []int32
+}
+
+func (t *indexTerm) eval(candidates rootBits) rootBits {
+	b := newRootBits()
+	for _, i := range t.indices() {
+		if candidates == nil || candidates.has(int(i)) {
+			b.set(int(i))
+		}
+	}
+	return b
+}
+
+func (t *indexTerm) cost() int      { return t.c }
+func (t *indexTerm) String() string { return t.text }
+
+// filterTerm checks roots one by one
+type filterTerm struct {
+	text  string
+	c     int
+	match func(i int) bool
+}
+
+func (t *filterTerm) eval(candidates rootBits) rootBits {
+	b := newRootBits()
+	if candidates == nil {
+		for i := range rootSet.Roots {
+			if t.match(i) {
+				b.set(i)
+			}
+		}
+		return b
+	}
+	candidates.visit(func(i int) {
+		if t.match(i) {
+			b.set(i)
+		}
+	})
+	return b
+}
+
+func (t *filterTerm) cost() int      { return t.c }
+func (t *filterTerm) String() string { return t.text }
+
+// andNode narrows the candidates by its children in order
+type andNode struct {
+	children []queryNode
+}
+
+func (n *andNode) eval(candidates rootBits) rootBits {
+	for _, c := range n.children {
+		candidates = c.eval(candidates)
+	}
+	return candidates
+}
+
+func (n *andNode) cost() int {
+	return n.children[0].cost()
+}
+
+func (n *andNode) String() string {
+	parts := make([]string, len(n.children))
+	for i, c := range n.children {
+		parts[i] = c.String()
+	}
+	return "(" + strings.Join(parts, " AND ") + ")"
+}
+
+// orNode is the union of its children
+type orNode struct {
+	children []queryNode
+}
+
+func (n *orNode) eval(candidates rootBits) rootBits {
+	b := newRootBits()
+	for _, c := range n.children {
+		for w, word := range c.eval(candidates) {
+			b[w] |= word
+		}
+	}
+	return b
+}
+
+func (n *orNode) cost() int {
+	c := 0
+	for _, child := range n.children {
+		if child.cost() > c {
+			c = child.cost()
+		}
+	}
+	return c
+}
+
+func (n *orNode) String() string {
+	parts := make([]string, len(n.children))
+	for i, c := range n.children {
+		parts[i] = c.String()
+	}
+	return "(" + strings.Join(parts, " OR ") + ")"
+}
+
+// notNode is the candidates its child doesn't match
+type notNode struct {
+	child queryNode
+}
+
+func (n *notNode) eval(candidates rootBits) rootBits {
+	if candidates == nil {
+		candidates = allRootBits()
+	}
+	b := newRootBits()
+	for w, word := range n.child.eval(candidates) {
+		b[w] = candidates[w] &^ word
+	}
+	return b
+}
+
+// a negated term is cheap only when it's evaluated over few candidates, so it's evaluated after the others
+func (n *notNode) cost() int {
+	return n.child.cost() + filterCost
+}
+
+func (n *notNode) String() string {
+	return "NOT " + n.child.String()
+}
+
+// Query is a parsed query. Its plan evaluates terms using indices before terms checking roots one by one.
+type Query struct {
+	Text string
+	root queryNode
+}
+
+// String returns the query plan, e.g. (tr:kitab* AND pos:noun)
+func (q *Query) String() string {
+	return q.root.String()
+}
+
+// ParseQuery parses a query whose Ottoman terms are written in Ottoman letters
+func ParseQuery(query string) (*Query, error) {
+	return ottomanProfile.ParseQuery(query)
+}
+
+// MustParseQuery is like ParseQuery but panics if the query cannot be parsed
+func MustParseQuery(query string) *Query {
+	q, err := ParseQuery(query)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// ParseQuery parses a query whose Ottoman terms are written in the script
+func (p *ScriptProfile) ParseQuery(query string) (*Query, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("Query %s: %s", query, err)
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("Query is empty")
+	}
+
+	qp := queryParser{profile: p, tokens: tokens}
+	root, err := qp.parseOr()
+	if err == nil && qp.pos < len(tokens) {
+		err = fmt.Errorf("unexpected %s", tokens[qp.pos].text)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Query %s: %s", query, err)
+	}
+
+	return &Query{Text: query, root: root}, nil
+}
+
+// QuerySearch returns at most maxLen roots matching the query
+func QuerySearch(q *Query, maxLen int) []*Root {
+	results := make([]*Root, 0)
+	q.root.eval(nil).visit(func(i int) {
+		results = append(results, rootSet.Roots[i])
+	})
+
+	results = filterResults(results)
+	results = sortByLength(results)
+	if maxLen < len(results) {
+		results = results[:maxLen]
+	}
+
+	return results
+}
+
+type queryTokenKind int
+
+const (
+	tokenTerm queryTokenKind = iota
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenOpen
+	tokenClose
+)
+
+type queryToken struct {
+	kind queryTokenKind
+	text string
+}
+
+// tokenizeQuery splits query into terms, operators and parentheses. Quotes are removed from terms.
+func tokenizeQuery(query string) ([]queryToken, error) {
+	tokens := make([]queryToken, 0)
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, queryToken{kind: tokenOpen, text: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, queryToken{kind: tokenClose, text: ")"})
+			i++
+		case r == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]):
+			tokens = append(tokens, queryToken{kind: tokenNot, text: "-"})
+			i++
+		default:
+			var sb strings.Builder
+			quoted := false
+			for ; i < len(runes) && (quoted || !(unicode.IsSpace(runes[i]) || runes[i] == '(' || runes[i] == ')')); i++ {
+				if runes[i] == '"' {
+					quoted = !quoted
+					continue
+				}
+				sb.WriteRune(runes[i])
+			}
+			if quoted {
+				return nil, fmt.Errorf("\" is not closed")
+			}
+			text := sb.String()
+			kind := tokenTerm
+			switch text {
+			case "AND":
+				kind = tokenAnd
+			case "OR":
+				kind = tokenOr
+			case "NOT":
+				kind = tokenNot
+			}
+			tokens = append(tokens, queryToken{kind: kind, text: text})
+		}
+	}
+
+	return tokens, nil
+}
+
+// queryParser parses
+//
+//	or    = and {OR and}
+//	and   = unary {[AND] unary}
+//	unary = (NOT | -) unary | "(" or ")" | term
+type queryParser struct {
+	profile *ScriptProfile
+	tokens  []queryToken
+	pos     int
+}
+
+func (qp *queryParser) peek() (queryToken, bool) {
+	if qp.pos < len(qp.tokens) {
+		return qp.tokens[qp.pos], true
+	}
+	return queryToken{}, false
+}
+
+func (qp *queryParser) parseOr() (queryNode, error) {
+	first, err := qp.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	children := []queryNode{first}
+	for t, ok := qp.peek(); ok && t.kind == tokenOr; t, ok = qp.peek() {
+		qp.pos++
+		n, err := qp.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, n)
+	}
+
+	if len(children) == 1 {
+		return first, nil
+	}
+	return &orNode{children: children}, nil
+}
+
+func (qp *queryParser) parseAnd() (queryNode, error) {
+	children := make([]queryNode, 0)
+	for {
+		t, ok := qp.peek()
+		if !ok || t.kind == tokenOr || t.kind == tokenClose {
+			break
+		}
+		if t.kind == tokenAnd {
+			qp.pos++
+			continue
+		}
+		n, err := qp.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, n)
+	}
+
+	switch len(children) {
+	case 0:
+		if t, ok := qp.peek(); ok {
+			return nil, fmt.Errorf("%s without a term", t.text)
+		}
+		return nil, fmt.Errorf("missing term at the end")
+	case 1:
+		return children[0], nil
+	}
+	sort.SliceStable(children, func(i, j int) bool {
+		return children[i].cost() < children[j].cost()
+	})
+	return &andNode{children: children}, nil
+}
+
+func (qp *queryParser) parseUnary() (queryNode, error) {
+	t, ok := qp.peek()
+	if !ok {
+		return nil, fmt.Errorf("missing term at the end")
+	}
+	qp.pos++
+
+	switch t.kind {
+	case tokenNot:
+		n, err := qp.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{child: n}, nil
+	case tokenOpen:
+		n, err := qp.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t, ok := qp.peek(); !ok || t.kind != tokenClose {
+			return nil, fmt.Errorf("( is not closed")
+		}
+		qp.pos++
+		return n, nil
+	case tokenTerm:
+		return qp.profile.parseTerm(t.text)
+	}
+	return nil, fmt.Errorf("unexpected %s", t.text)
+}
+
+// parseTerm parses field:value. Values without a field are searched in the field DetectField finds.
+func (p *ScriptProfile) parseTerm(term string) (queryNode, error) {
+	field, value := "", term
+	if i := strings.Index(term, ":"); i > 0 {
+		field, value = term[:i], term[i+1:]
+	}
+	if value == "" {
+		return nil, fmt.Errorf("%s has no value", term)
+	}
+
+	if field == "" {
+		switch DetectField(strings.Trim(value, "*")) {
+		case SearchField_OTTOMAN:
+			field = "ot"
+		case SearchField_ABJAD:
+			field = "abjad"
+		case SearchField_VISENC:
+			field = "v"
+		default:
+			field = "tr"
+		}
+	}
+
+	text := field + ":" + quoteQueryValue(value)
+
+	switch strings.ToLower(field) {
+	case "tr", "turkishlatin":
+		return wordTerm(text, value, turkishLatinIndex, turkishLatinReverseIndex, func(s string) string { return s }, ReverseRunes)
+	case "ot", "ottoman", "unicode":
+		value = p.OttomanUnicode(value)
+		return wordTerm(text, value, unicodeIndex, unicodeReverseIndex, unicodeSearchKey, ReverseRunes)
+	case "v", "visenc":
+		return wordTerm(text, value, visencIndex, visencReverseIndex, visencSearchKey, func(s string) string {
+			return ReverseVisenc(SearchKey(s))
+		})
+	case "pat", "pattern":
+		lp, err := p.CompilePattern(value)
+		if err != nil {
+			return nil, err
+		}
+		return &filterTerm{text: text, c: scanCost, match: func(i int) bool { return lp.matchUnits(patternLetterIndex[i]) }}, nil
+	case "abjad":
+		from, to, err := parseRange(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", text, err)
+		}
+		return &indexTerm{text: text, c: indexCost, indices: func() []int32 {
+			indices := make([]int32, 0)
+			for abjad, roots := range *abjadIndex {
+				if int64(abjad) >= from && int64(abjad) <= to {
+					for _, i := range roots {
+						indices = append(indices, int32(i))
+					}
+				}
+			}
+			return indices
+		}}, nil
+	}
+
+	return rootFieldTerm(text, field, value)
+}
+
+// quoteQueryValue quotes values with spaces, so the plan can be parsed again
+func quoteQueryValue(value string) string {
+	if strings.ContainsAny(value, " ()") {
+		return `"` + value + `"`
+	}
+	return value
+}
+
+// wordTerm looks up a spelling in index. Prefixes like kitab* use index, suffixes like *ab use reverse and other wildcards are matched by regular expressions.
+func wordTerm(text string, value string, index *KeyIndex, reverse *KeyIndex, key func(string) string, reverseKey func(string) string) (queryNode, error) {
+	inner := strings.Trim(value, "*")
+	prefix := strings.HasSuffix(value, "*")
+	suffix := strings.HasPrefix(value, "*")
+
+	switch {
+	case inner == "":
+		return nil, fmt.Errorf("%s matches everything", text)
+	case strings.Contains(inner, "*") || (prefix && suffix):
+		parts := strings.Split(key(value), "*")
+		for i := range parts {
+			parts[i] = regexp.QuoteMeta(parts[i])
+		}
+		regex := regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+		return &indexTerm{text: text, c: scanCost, indices: func() []int32 { return index.Match(regex.MatchString) }}, nil
+	case prefix:
+		return &indexTerm{text: text, c: indexCost, indices: func() []int32 {
+			indices := make([]int32, 0)
+			index.VisitPrefix(key(inner), func(i int32) { indices = append(indices, i) })
+			return indices
+		}}, nil
+	case suffix:
+		return &indexTerm{text: text, c: indexCost, indices: func() []int32 {
+			indices := make([]int32, 0)
+			reverse.VisitPrefix(reverseKey(key(inner)), func(i int32) { indices = append(indices, i) })
+			return indices
+		}}, nil
+	}
+	return &indexTerm{text: text, c: indexCost, indices: func() []int32 { return index.Lookup(key(inner)) }}, nil
+}
+
+// parseRange parses a number like 400 or a range like 400..500
+func parseRange(value string) (int64, int64, error) {
+	fromText, toText := value, value
+	if i := strings.Index(value, ".."); i >= 0 {
+		fromText, toText = value[:i], value[i+2:]
+	}
+	from, err := strconv.ParseInt(fromText, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s is not a number or a range like 400..500", value)
+	}
+	to, err := strconv.ParseInt(toText, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s is not a number or a range like 400..500", value)
+	}
+	return from, to, nil
+}
+
+// rootFieldTerm filters roots by a field of Root named by its JSON name, its proto name or an alias
+func rootFieldTerm(text string, field string, value string) (queryNode, error) {
+	if alias, exists := queryFieldAliases[strings.ToLower(field)]; exists {
+		field = alias
+	}
+
+	var fd protoreflect.FieldDescriptor
+	fields := (&Root{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		if strings.EqualFold(f.JSONName(), field) || strings.EqualFold(string(f.Name()), field) {
+			fd = f
+			break
+		}
+	}
+	if fd == nil {
+		return nil, fmt.Errorf("Unknown field %s", field)
+	}
+
+	var match func(v protoreflect.Value) bool
+
+	switch {
+	case fd.IsList() && fd.Kind() == protoreflect.StringKind:
+		match = func(v protoreflect.Value) bool {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				if strings.EqualFold(list.Get(i).String(), value) {
+					return true
+				}
+			}
+			return false
+		}
+	case fd.IsList() || fd.Kind() == protoreflect.MessageKind:
+		return nil, fmt.Errorf("%s: field %s cannot be searched", text, field)
+	case fd.Kind() == protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s is not true or false", text, value)
+		}
+		match = func(v protoreflect.Value) bool { return v.Bool() == b }
+	case fd.Kind() == protoreflect.EnumKind:
+		n, err := matchEnumValue(fd.Enum(), value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", text, err)
+		}
+		match = func(v protoreflect.Value) bool { return v.Enum() == n }
+	case fd.Kind() == protoreflect.Int32Kind:
+		from, to, err := parseRange(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", text, err)
+		}
+		match = func(v protoreflect.Value) bool { return v.Int() >= from && v.Int() <= to }
+	case fd.Kind() == protoreflect.StringKind:
+		parts := strings.Split(value, "*")
+		for i := range parts {
+			parts[i] = regexp.QuoteMeta(parts[i])
+		}
+		regex := regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+		match = func(v protoreflect.Value) bool { return regex.MatchString(v.String()) }
+	default:
+		return nil, fmt.Errorf("%s: field %s cannot be searched", text, field)
+	}
+
+	return &filterTerm{text: text, c: filterCost, match: func(i int) bool {
+		return match(rootSet.Roots[i].ProtoReflect().Get(fd))
+	}}, nil
+}
+
+// matchEnumValue finds an enum value by its name or a unique prefix of it, case insensitive. Underscores may be omitted.
+// If no name begins with value, a unique prefix of a word in the names is used, e.g. softening for CONSONANT_SOFTENING.
+func matchEnumValue(ed protoreflect.EnumDescriptor, value string) (protoreflect.EnumNumber, error) {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+	}
+	v := normalize(value)
+
+	values := ed.Values()
+	found := make([]protoreflect.EnumValueDescriptor, 0)
+	for i := 0; i < values.Len(); i++ {
+		name := normalize(string(values.Get(i).Name()))
+		if name == v {
+			return values.Get(i).Number(), nil
+		}
+		if strings.HasPrefix(name, v) {
+			found = append(found, values.Get(i))
+		}
+	}
+	if len(found) == 0 {
+		for i := 0; i < values.Len(); i++ {
+			for _, word := range strings.Split(string(values.Get(i).Name()), "_") {
+				if strings.HasPrefix(strings.ToLower(word), v) {
+					found = append(found, values.Get(i))
+					break
+				}
+			}
+		}
+	}
+
+	if len(found) != 1 {
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return 0, fmt.Errorf("%s is not one of %s", value, strings.Join(names, ", "))
+	}
+	return found[0].Number(), nil
+}
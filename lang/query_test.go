@@ -0,0 +1,116 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// func ParseQuery(query string) (*Query, error) {
+func TestParseQuery(t *testing.T) {
+	testDict := map[string]string{
+		"tr:kitab* pos:noun":                  "(tr:kitab* AND pos:noun)",
+		"pos:noun tr:kitab*":                  "(tr:kitab* AND pos:noun)",
+		"kitap OR kalem":                      "(tr:kitap OR tr:kalem)",
+		"(tr:kitap OR tr:kalem) AND -src:foo": "((tr:kitap OR tr:kalem) AND NOT src:foo)",
+		"NOT softening:true abjad:423":        "(abjad:423 AND NOT softening:true)",
+		"کتاب":                                "ot:کتاب",
+		"423":                                 "abjad:423",
+		`tr:"ak su"`:                          `tr:"ak su"`,
+	}
+
+	for q, o := range testDict {
+		parsed, err := ParseQuery(q)
+		if err != nil {
+			t.Log(err)
+			t.Fail()
+			continue
+		}
+		if parsed.String() != o {
+			t.Log(fmt.Sprintf("%s should be planned as %s: %s", q, o, parsed.String()))
+			t.Fail()
+		}
+	}
+
+	badQueries := []string{"", "tr:", "(tr:kitap", "tr:kitap)", "OR tr:kitap", "tr:kitap OR", "klingon:kitap", "pos:x", "softening:maybe", "abjad:x..y", `tr:"kitap`, "tr:*", "ottoman:"}
+	for _, q := range badQueries {
+		if _, err := ParseQuery(q); err == nil {
+			t.Log(fmt.Sprintf("%s should not be parsed", q))
+			t.Fail()
+		}
+	}
+}
+
+// func matchEnumValue(ed protoreflect.EnumDescriptor, value string) (protoreflect.EnumNumber, error) {
+func TestMatchEnumValue(t *testing.T) {
+	testDict := map[string]Alternation{
+		"CONSONANT_SOFTENING": Alternation_CONSONANT_SOFTENING,
+		"consonant":           Alternation_CONSONANT_SOFTENING,
+		"vowelelision":        Alternation_VOWEL_ELISION,
+		"softening":           Alternation_CONSONANT_SOFTENING,
+		"hard":                Alternation_HARD_FINAL,
+	}
+
+	ed := Alternation_NO_ALTERNATION.Descriptor()
+	for i, o := range testDict {
+		n, err := matchEnumValue(ed, i)
+		if err != nil || Alternation(n) != o {
+			t.Log(fmt.Sprintf("%s should be %s: %d %v", i, o, n, err))
+			t.Fail()
+		}
+	}
+
+	// PROPER_NOUN is the only part of speech beginning with p
+	if n, err := matchEnumValue(PartOfSpeech_NOUN.Descriptor(), "p"); err != nil || PartOfSpeech(n) != PartOfSpeech_PROPER_NOUN {
+		t.Log(fmt.Sprintf("p should be PROPER_NOUN: %d %v", n, err))
+		t.Fail()
+	}
+	if _, err := matchEnumValue(ed, "klingon"); err == nil {
+		t.Log("klingon should not be an alternation")
+		t.Fail()
+	}
+}
+
+// func QuerySearch(q *Query, maxLen int) []*Root {
+func TestQuerySearch(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	roots := QuerySearch(MustParseQuery("tr:kitab* pos:noun abjad:400..500 -softening:true"), 100)
+	if len(roots) == 0 {
+		t.Log("tr:kitab* pos:noun abjad:400..500 -softening:true should find roots")
+		t.Fail()
+	}
+	for _, r := range roots {
+		if !strings.HasPrefix(r.TurkishLatin, "kitab") || r.PartOfSpeech != PartOfSpeech_NOUN || r.Abjad < 400 || r.Abjad > 500 || r.HasConsonantSoftening {
+			t.Log(fmt.Sprintf("%s doesn't match the query", r))
+			t.Fail()
+		}
+	}
+
+	roots = QuerySearch(MustParseQuery("(tr:kitap OR tr:kalem) softening:true"), 100)
+	found := map[string]bool{}
+	for _, r := range roots {
+		found[r.TurkishLatin] = true
+		if !r.HasConsonantSoftening {
+			t.Log(fmt.Sprintf("%s doesn't have consonant softening", r.TurkishLatin))
+			t.Fail()
+		}
+	}
+	if !found["kitap"] || found["kalem"] {
+		t.Log(fmt.Sprintf("(tr:kitap OR tr:kalem) softening:true should find kitap but not kalem: %s", PrintRoots(roots)))
+		t.Fail()
+	}
+
+	// suffixes are compared as in SuffixSearchVisenc
+	suffixRoots := QuerySearch(MustParseQuery("v:*ebu1"), 100000)
+	if expected := SuffixSearchVisenc("ebu1", 100000); len(suffixRoots) != len(expected) || len(expected) == 0 {
+		t.Log(fmt.Sprintf("v:*ebu1 should find %d roots as SuffixSearchVisenc: %d", len(expected), len(suffixRoots)))
+		t.Fail()
+	}
+	for _, r := range QuerySearch(MustParseQuery("v:kbo2*"), 1000) {
+		if !strings.HasPrefix(r.Ottoman.Visenc, "kbo2") {
+			t.Log(fmt.Sprintf("%s doesn't begin with kbo2", r.Ottoman.Visenc))
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,485 @@
+package lang
+
+import (
+	_ "embed" // default Arabic plural table is embedded
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+//go:embed data/arabic-plurals.csv
+var defaultArabicPluralsData string
+
+// singularEndings are the endings of singulars not written in their patterns, in Ottoman and in Latin after front
+// and back vowels, like hâdise and fazîlet
+var singularEndings = [][3]string{{"", "", ""}, {"ه", "e", "a"}, {"ت", "et", "at"}}
+
+// persianPluralEndings are the endings of Persian plurals and the sources of their links, in the order they are tried
+var persianPluralEndings = [][2]string{{"ان", "-ân"}, {"ها", "-hâ"}}
+
+// ArabicPluralRule pairs the pattern of a singular with the pattern of its broken plural
+type ArabicPluralRule struct {
+	Singular string
+	Plural   string
+	Example  string
+}
+
+// ArabicPluralTable keeps the plural rules in file order
+type ArabicPluralTable struct {
+	rules []*ArabicPluralRule
+}
+
+var arabicPluralTable = MustParseArabicPlurals(strings.NewReader(defaultArabicPluralsData))
+
+// RootRelation is a link between two roots of a root set, Related is the root the link points to
+type RootRelation struct {
+	Root    *Root
+	Related *Root
+	Type    RelationType
+	Source  string
+}
+
+// ParseArabicPlurals reads an Arabic plural table in CSV format. Lines beginning with # are comments.
+// Patterns are named as in the Arabic pattern table.
+func ParseArabicPlurals(reader io.Reader) (*ArabicPluralTable, error) {
+	csvr := csv.NewReader(reader)
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 3
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	pt := ArabicPluralTable{rules: make([]*ArabicPluralRule, 0, len(records))}
+	for i, record := range records {
+		if i == 0 && record[0] == "singular" {
+			continue
+		}
+
+		rule := ArabicPluralRule{Singular: strings.TrimSpace(record[0]), Plural: strings.TrimSpace(record[1]), Example: strings.TrimSpace(record[2])}
+		singular := arabicPatternTable.pattern(rule.Singular)
+		plural := arabicPatternTable.pattern(rule.Plural)
+		if singular == nil || plural == nil {
+			return nil, fmt.Errorf("Plural %s of %s: patterns should be in the Arabic pattern table", rule.Plural, rule.Singular)
+		}
+		if singular.rootLetters != plural.rootLetters {
+			return nil, fmt.Errorf("Plural %s of %s: patterns should have the same number of root letters", rule.Plural, rule.Singular)
+		}
+		pt.rules = append(pt.rules, &rule)
+	}
+
+	return &pt, nil
+}
+
+// MustParseArabicPlurals is like ParseArabicPlurals but panics if the table cannot be parsed
+func MustParseArabicPlurals(reader io.Reader) *ArabicPluralTable {
+	pt, err := ParseArabicPlurals(reader)
+	if err != nil {
+		panic(err)
+	}
+	return pt
+}
+
+// LoadArabicPlurals reads an Arabic plural table file and makes it the table used to infer relations.
+// Patterns are looked up in the Arabic pattern table loaded before it.
+func LoadArabicPlurals(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	pt, err := ParseArabicPlurals(file)
+	if err != nil {
+		return err
+	}
+
+	arabicPluralTable = pt
+	return nil
+}
+
+// InitArabicPlurals replaces the embedded Arabic plural table with a file, if filename is not empty.
+// If the file cannot be loaded, the embedded table is used.
+func InitArabicPlurals(filename string) {
+	if filename == "" {
+		return
+	}
+	if err := LoadArabicPlurals(filename); err != nil {
+		log.Printf("Arabic plural table is not loaded: %s", err)
+	}
+}
+
+// normalizedLatin writes a Latin spelling without circumflexes, apostrophes and voiced final consonants, like kitap
+// for kitâb, to compare dictionary spellings with spellings of patterns
+func normalizedLatin(s string) string {
+	runes := []rune(plainLatin(s))
+	if n := len(runes); n > 0 {
+		if hard, exists := finalDevoicing[runes[n-1]]; exists {
+			runes[n-1] = hard
+		}
+	}
+	return string(runes)
+}
+
+// plainLatin writes a Latin spelling in lowercase without circumflexes and apostrophes
+func plainLatin(s string) string {
+	return latinNormalizer.Replace(TurkishLower(s))
+}
+
+var latinNormalizer = strings.NewReplacer("â", "a", "î", "i", "û", "u", "'", "", "’", "", "\u0307", "")
+
+var finalDevoicing = map[rune]rune{'b': 'p', 'c': 'ç', 'd': 't', 'g': 'k'}
+
+// spellingKey returns an Ottoman spelling without marks and joiners
+func spellingKey(s string) string {
+	var sb strings.Builder
+	for _, r := range NormalizeArabic(s) {
+		if !unicode.IsMark(r) && r != '\u200C' && r != '\u200D' {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// pluralKey returns the letters of an Arabic word compared with singulars and plurals. Final hamza is not compared.
+func pluralKey(letters []rune) string {
+	return strings.TrimRight(string(letters), "ء")
+}
+
+// lettersInPattern returns the normalized letters of root written in the pattern, hamza is not seated
+func (ap *ArabicPattern) lettersInPattern(root []rune) []rune {
+	out := make([]rune, len(ap.letters))
+	for i, r := range ap.letters {
+		out[i] = r
+		if ap.radicals[i] >= 0 {
+			out[i] = root[ap.radicals[i]]
+		}
+	}
+	return out
+}
+
+// similarLatin checks whether two Latin spellings have the same consonants in the same places, vowels may differ like
+// in ilm and alm
+func similarLatin(a, b string) bool {
+	ar, br := []rune(a), []rune(b)
+	if len(ar) != len(br) {
+		return false
+	}
+	for i := range ar {
+		if ar[i] != br[i] && !(strings.ContainsRune(allVowels, ar[i]) && strings.ContainsRune(allVowels, br[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// mostListed returns the root listed by the most dictionaries, the first of them if more than one
+func mostListed(roots []*Root) *Root {
+	var best *Root
+	for _, r := range roots {
+		if best == nil || len(r.Sources) > len(best.Sources) {
+			best = r
+		}
+	}
+	return best
+}
+
+// latinMatches returns the roots whose Latin spellings are one of latin after they are normalized
+func latinMatches(roots []*Root, latin ...string) []*Root {
+	matches := make([]*Root, 0)
+	for _, r := range roots {
+		for _, l := range latin {
+			if normalizedLatin(r.TurkishLatin) == l {
+				matches = append(matches, r)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// brokenPluralSingular returns the singular of plurals, roots spelled the same, if they follow a broken plural pattern
+// and one of them is read as the pattern. byLetters keeps roots by their plural keys. Singulars read as their patterns
+// are preferred, then singulars read with other vowels, then the singular listed by the most dictionaries.
+func brokenPluralSingular(plurals []*Root, byLetters map[string][]*Root) (*Root, string) {
+	letters := arabicLetters(plurals[0].Ottoman.Unicode)
+	if len(letters) == 0 {
+		return nil, ""
+	}
+	spellings := [][]rune{letters}
+	// final hamza isn't written in many plurals
+	if letters[len(letters)-1] == 'ا' {
+		spellings = append(spellings, append(append([]rune{}, letters...), 'ء'))
+	}
+
+	var best [3]*Root
+	var sources [3]string
+	choose := func(tier int, r *Root, source string) {
+		if best[tier] == nil {
+			best[tier], sources[tier] = r, source
+		}
+	}
+
+	for _, rule := range arabicPluralTable.rules {
+		singular := arabicPatternTable.pattern(rule.Singular)
+		plural := arabicPatternTable.pattern(rule.Plural)
+		if singular == nil || plural == nil {
+			continue
+		}
+		for _, spelling := range spellings {
+			positions := plural.rootPositions(spelling)
+			if positions == nil {
+				continue
+			}
+			root := []rune((&arabicRootMatch{positions: positions}).root(spelling))
+			if len(latinMatches(plurals, normalizedLatin(plural.latin(root)))) == 0 {
+				continue
+			}
+			latin := normalizedLatin(singular.latin(root))
+			for _, ending := range singularEndings {
+				key := pluralKey(append(singular.lettersInPattern(root), []rune(ending[0])...))
+				candidates := byLetters[key]
+				if key == pluralKey(letters) || len(candidates) == 0 {
+					continue
+				}
+				if matches := latinMatches(candidates, latin+ending[1], latin+ending[2]); len(matches) > 0 {
+					choose(0, mostListed(matches), rule.Plural)
+				}
+				for _, r := range candidates {
+					if l := normalizedLatin(r.TurkishLatin); similarLatin(l, latin+ending[1]) || similarLatin(l, latin+ending[2]) {
+						choose(1, r, rule.Plural)
+					}
+				}
+				choose(2, mostListed(candidates), rule.Plural)
+			}
+		}
+	}
+
+	for i, r := range best {
+		if r != nil {
+			return r, sources[i]
+		}
+	}
+	return nil, ""
+}
+
+// persianPluralSingular returns the singular of plurals, roots spelled the same, if they end with a Persian plural
+// ending and one of them is read as the singular with the ending. groups keeps roots by their spelling keys.
+func persianPluralSingular(plurals []*Root, groups map[string][]*Root) (*Root, string) {
+	// Turkish words ending with -an, like arayan, are not plurals
+	persian := make([]*Root, 0, len(plurals))
+	for _, p := range plurals {
+		if latin := TurkishLower(p.TurkishLatin); strings.HasSuffix(latin, "ân") || strings.HasSuffix(latin, "hâ") {
+			persian = append(persian, p)
+		}
+	}
+	if len(persian) == 0 {
+		return nil, ""
+	}
+
+	spelling := []rune(spellingKey(plurals[0].Ottoman.Unicode))
+	for _, e := range persianPluralEndings {
+		ending, source := e[0], e[1]
+		if !strings.HasSuffix(string(spelling), ending) || len(spelling) < len([]rune(ending))+3 {
+			continue
+		}
+		stem := spelling[:len(spelling)-len([]rune(ending))]
+		last := stem[len(stem)-1]
+
+		// bende makes bendegân and dânâ dânâyân
+		stems := []string{string(stem)}
+		if last == 'گ' {
+			stems = append(stems, string(stem[:len(stem)-1])+"ه")
+		}
+		if last == 'ی' {
+			stems = append(stems, string(stem[:len(stem)-1]))
+		}
+
+		for _, s := range stems {
+			for _, singular := range groups[s] {
+				sg := plainLatin(singular.TurkishLatin)
+				forms := []string{sg + "an", sg + "yan", sg + "gan", sg + "ha"}
+				if len(latinMatches(persian, forms...)) > 0 {
+					return singular, source
+				}
+			}
+		}
+	}
+	return nil, ""
+}
+
+// InferRelations proposes links from plurals to their singulars. Broken plurals are found by the plural patterns of
+// the Arabic plural table and Persian plurals by their endings ان and ها. Roots spelled the same are linked together,
+// the Latin spelling of one of them should be read as the plural. Singulars read as their patterns, and listed by
+// more dictionaries, are preferred. Links are proposals, words following plural patterns aren't always plurals.
+func InferRelations(roots []*Root) []*RootRelation {
+	groups := make(map[string][]*Root)
+	byLetters := make(map[string][]*Root)
+	for _, r := range roots {
+		if r.Ottoman == nil || r.PartOfSpeech != PartOfSpeech_NOUN {
+			continue
+		}
+		key := spellingKey(r.Ottoman.Unicode)
+		groups[key] = append(groups[key], r)
+		if letters := arabicLetters(r.Ottoman.Unicode); len(letters) > 0 {
+			byLetters[pluralKey(letters)] = append(byLetters[pluralKey(letters)], r)
+		}
+	}
+
+	keys := make([]string, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	links := make([]*RootRelation, 0)
+	for _, k := range keys {
+		plurals := groups[k]
+		singular, source := brokenPluralSingular(plurals, byLetters)
+		if singular == nil {
+			singular, source = persianPluralSingular(plurals, groups)
+		}
+		if singular == nil {
+			continue
+		}
+		for _, p := range plurals {
+			links = append(links, &RootRelation{Root: p, Related: singular, Type: RelationType_PLURAL_OF, Source: source})
+		}
+	}
+	return links
+}
+
+// inverseRelation returns the type of the link back from the related root
+func inverseRelation(t RelationType) RelationType {
+	switch t {
+	case RelationType_PLURAL_OF:
+		return RelationType_SINGULAR_OF
+	case RelationType_SINGULAR_OF:
+		return RelationType_PLURAL_OF
+	}
+	return t
+}
+
+// addRelation links root to related unless it's already linked
+func addRelation(root *Root, t RelationType, related *Root, source string) {
+	for _, rel := range root.Relations {
+		if rel.Type == t && rel.TurkishLatin == related.TurkishLatin && rel.Visenc == related.Ottoman.GetVisenc() {
+			return
+		}
+	}
+	root.Relations = append(root.Relations, &Relation{Type: t, TurkishLatin: related.TurkishLatin, Visenc: related.Ottoman.GetVisenc(), Source: source})
+}
+
+// ApplyRelations adds links to their roots and the links back to the related roots
+func ApplyRelations(links []*RootRelation) {
+	for _, l := range links {
+		addRelation(l.Root, l.Type, l.Related, l.Source)
+		addRelation(l.Related, inverseRelation(l.Type), l.Root, l.Source)
+	}
+}
+
+// WriteRelationsCSV writes links in CSV format, roots are written with their Latin spellings and visenc
+func WriteRelationsCSV(writer io.Writer, links []*RootRelation) error {
+	csvw := csv.NewWriter(writer)
+	csvw.Write([]string{"latin", "visenc", "type", "relatedLatin", "relatedVisenc", "source"})
+	for _, l := range links {
+		csvw.Write([]string{l.Root.TurkishLatin, l.Root.Ottoman.GetVisenc(), l.Type.String(), l.Related.TurkishLatin, l.Related.Ottoman.GetVisenc(), l.Source})
+	}
+	csvw.Flush()
+	return csvw.Error()
+}
+
+// ParseRelations reads links written by WriteRelationsCSV between roots. Links of roots that are not in roots are
+// skipped, as root sets may be built from other data.
+func ParseRelations(reader io.Reader, roots []*Root) ([]*RootRelation, error) {
+	csvr := csv.NewReader(reader)
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 6
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]*Root, len(roots))
+	for _, r := range roots {
+		k := r.TurkishLatin + "," + r.Ottoman.GetVisenc()
+		if _, exists := byKey[k]; !exists {
+			byKey[k] = r
+		}
+	}
+
+	links := make([]*RootRelation, 0, len(records))
+	skipped := 0
+	for i, record := range records {
+		if i == 0 && record[0] == "latin" {
+			continue
+		}
+		t, exists := RelationType_value[record[2]]
+		if !exists {
+			return nil, fmt.Errorf("Line %d: unknown relation type %s", i+1, record[2])
+		}
+		root, related := byKey[record[0]+","+record[1]], byKey[record[3]+","+record[4]]
+		if root == nil || related == nil {
+			skipped++
+			continue
+		}
+		links = append(links, &RootRelation{Root: root, Related: related, Type: RelationType(t), Source: record[5]})
+	}
+	if skipped > 0 {
+		log.Printf("%d relations of roots not in the root set are skipped", skipped)
+	}
+
+	return links, nil
+}
+
+// LoadRelations reads a relations file written by WriteRelationsCSV between roots
+func LoadRelations(filename string, roots []*Root) ([]*RootRelation, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ParseRelations(file, roots)
+}
+
+// RelatedRoots returns the roots rel links to in the root set
+func RelatedRoots(rel *Relation) []*Root {
+	roots := make([]*Root, 0, 1)
+	if visencIndex == nil {
+		return roots
+	}
+	for _, r := range PrefixSearchVisencExact(rel.Visenc) {
+		if r.TurkishLatin == rel.TurkishLatin {
+			roots = append(roots, r)
+		}
+	}
+	return roots
+}
+
+// RelationLabel describes a link of a root, like plural of kitap
+func RelationLabel(rel *Relation) string {
+	switch rel.Type {
+	case RelationType_PLURAL_OF:
+		return "plural of " + rel.TurkishLatin
+	case RelationType_SINGULAR_OF:
+		return "singular of " + rel.TurkishLatin
+	}
+	return "related to " + rel.TurkishLatin
+}
+
+// RelationLabels describes the links of a root
+func RelationLabels(root *Root) []string {
+	labels := make([]string, len(root.Relations))
+	for i, rel := range root.Relations {
+		labels[i] = RelationLabel(rel)
+	}
+	return labels
+}
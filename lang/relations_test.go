@@ -0,0 +1,152 @@
+package lang
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// func ParseArabicPlurals(reader io.Reader) (*ArabicPluralTable, error) {
+func TestParseArabicPlurals(t *testing.T) {
+	// examples follow their patterns
+	for _, rule := range arabicPluralTable.rules {
+		fields := strings.Fields(strings.Replace(rule.Example, ">", " ", 1))
+		singular := arabicPatternTable.pattern(rule.Singular)
+		plural := arabicPatternTable.pattern(rule.Plural)
+		letters := arabicLetters(fields[3])
+		positions := plural.rootPositions(letters)
+		if len(fields) != 4 || positions == nil {
+			t.Log(fmt.Sprintf("%s should follow %s", rule.Example, rule.Plural))
+			t.Fail()
+			continue
+		}
+		root := []rune((&arabicRootMatch{positions: positions}).root(letters))
+		found := false
+		for _, ending := range singularEndings {
+			found = found || string(arabicLetters(fields[1])) == string(singular.lettersInPattern(root))+ending[0]
+		}
+		if !found {
+			t.Log(fmt.Sprintf("%s should follow %s for the root %s", rule.Example, rule.Singular, string(root)))
+			t.Fail()
+		}
+	}
+
+	badTables := []string{
+		"singular,plural,example\nfa'l,fa'lel,x",
+		"singular,plural,example\nfa'l,feâil,x",
+		"singular,plural\nfa'l,ef'âl",
+	}
+	for _, b := range badTables {
+		if _, err := ParseArabicPlurals(strings.NewReader(b)); err == nil {
+			t.Log(fmt.Sprintf("%q should fail ParseArabicPlurals", b))
+			t.Fail()
+		}
+	}
+}
+
+// func InferRelations(roots []*Root) []*RootRelation {
+func TestInferRelations(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+	links := InferRelations(GetRootSet().Roots)
+
+	testDict := map[string][]string{
+		"kütüb":    {"kitâb", "kitap"},
+		"ulemâ":    {"âlim", "alîm"},
+		"havâdis":  {"hâdis", "hâdise"},
+		"eşya":     {"şey", "şey'"},
+		"efkâr":    {"fikr", "fikir"},
+		"ulûm":     {"ilm"},
+		"ahbâr":    {"haber"},
+		"mekâtib":  {"mekteb", "mektep"},
+		"cevâhir":  {"cevher"},
+		"bendegân": {"bende"},
+		"arayan":   {},
+		"kabûl":    {},
+	}
+
+	for plural, singulars := range testDict {
+		found := ""
+		for _, l := range links {
+			if l.Root.TurkishLatin == plural && l.Type == RelationType_PLURAL_OF {
+				found = l.Related.TurkishLatin
+				break
+			}
+		}
+		ok := len(singulars) == 0 && found == ""
+		for _, s := range singulars {
+			ok = ok || s == found
+		}
+		if !ok {
+			t.Log(fmt.Sprintf("%s should be the plural of %v: %s", plural, singulars, found))
+			t.Fail()
+		}
+	}
+}
+
+// func WriteRelationsCSV(writer io.Writer, links []*RootRelation) error {
+// func ParseRelations(reader io.Reader, roots []*Root) ([]*RootRelation, error) {
+func TestWriteRelationsCSV(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+	links := InferRelations(GetRootSet().Roots)
+
+	var buf bytes.Buffer
+	if err := WriteRelationsCSV(&buf, links); err != nil {
+		t.Log(err)
+		t.Fail()
+	}
+	parsed, err := ParseRelations(&buf, GetRootSet().Roots)
+	if err != nil || len(parsed) != len(links) {
+		t.Log(fmt.Sprintf("%d relations should be read back: %d %v", len(links), len(parsed), err))
+		t.FailNow()
+	}
+	for i, l := range links {
+		p := parsed[i]
+		if p.Type != l.Type || p.Source != l.Source || p.Root.TurkishLatin != l.Root.TurkishLatin || p.Related.Ottoman.Visenc != l.Related.Ottoman.Visenc {
+			t.Log(fmt.Sprintf("%v should be read back: %v", l, p))
+			t.Fail()
+			break
+		}
+	}
+
+	if _, err := ParseRelations(strings.NewReader("kütüb,kbo2bu1,PLURALOF,kitâb,kbo2ebu1,fu'ul"), GetRootSet().Roots); err == nil {
+		t.Log("Unknown relation types should fail ParseRelations")
+		t.Fail()
+	}
+}
+
+// func ApplyRelations(links []*RootRelation) {
+// func RelationLabel(rel *Relation) string {
+func TestLemmatizeRelations(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+	InitLemmatizer(SUFFIXSETFILE)
+	ApplyRelations(InferRelations(GetRootSet().Roots))
+
+	testDict := map[string][]string{
+		"kütüb":      {"kitâb", "kitap"},
+		"کتب":        {"kitâb", "kitap"},
+		"efkârımız":  {"fikr", "fikir"},
+		"bendegânı":  {"bende"},
+		"havâdisten": {"hâdis", "hâdise"},
+	}
+
+	for w, e := range testDict {
+		found := false
+		for _, sr := range LemmatizeAuto(w, MAXRESULTLEN) {
+			for _, s := range e {
+				found = found || (sr.Relation != nil && sr.Relation.Type == RelationType_PLURAL_OF && sr.Root.TurkishLatin == s)
+			}
+		}
+		if !found {
+			t.Log(fmt.Sprintf("%s should be found through its plural %s", e, w))
+			t.Fail()
+		}
+	}
+
+	for _, r := range PrefixSearchVisencExact("kbo2bu1") {
+		if r.TurkishLatin == "kütüb" && !strings.Contains(PrintRoots([]*Root{r}), "plural of kitâb") {
+			t.Log(fmt.Sprintf("kütüb should be shown as the plural of kitâb: %s", PrintRoots([]*Root{r})))
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package lang
+
+import (
+	"sort"
+	"strings"
+)
+
+// visencReverseSeparator is written after each visenc letter group in reversed keys, so that a
+// prefix search in the reversed index never matches a part of a letter group
+const visencReverseSeparator = "."
+
+var turkishLatinReverseIndex *KeyIndex
+var visencReverseIndex *KeyIndex
+var unicodeReverseIndex *KeyIndex
+
+func buildReverseIndices(roots []*Root) {
+	turkishLatinReverseIndex = BuildKeyIndex(roots, func(r *Root) string { return ReverseRunes(r.TurkishLatin) })
+	visencReverseIndex = BuildKeyIndex(roots, func(r *Root) string { return ReverseVisenc(visencSearchKey(r.Ottoman.SearchKey)) })
+	unicodeReverseIndex = BuildKeyIndex(roots, func(r *Root) string { return ReverseRunes(unicodeSearchKey(r.Ottoman.Unicode)) })
+}
+
+// ReverseRunes returns s with its runes in reverse order
+func ReverseRunes(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
+
+// ReverseVisenc reverses the letter groups of a visenc string (not its bytes) and terminates each group with visencReverseSeparator
+func ReverseVisenc(s string) string {
+	return reverseVisencGroups(SplitVisenc(s, false))
+}
+
+func reverseVisencGroups(groups []string) string {
+	var sb strings.Builder
+	for i := len(groups) - 1; i >= 0; i-- {
+		sb.WriteString(groups[i])
+		sb.WriteString(visencReverseSeparator)
+	}
+	return sb.String()
+}
+
+// SuffixSearchTurkishLatin returns list of roots whose TurkishLatin ends with `turkishLatin`
+func SuffixSearchTurkishLatin(turkishLatin string, maxLen int) []*Root {
+	return prefixSearch(turkishLatinReverseIndex, ReverseRunes(turkishLatin), maxLen)
+}
+
+// SuffixSearchVisenc returns list of roots whose Visenc ends with the letter groups of `visenc`. Diacritics are not compared.
+func SuffixSearchVisenc(visenc string, maxLen int) []*Root {
+	return prefixSearch(visencReverseIndex, ReverseVisenc(visencSearchKey(SearchKey(visenc))), maxLen)
+}
+
+// SuffixSearchUnicode returns list of roots whose Unicode ends with `unicode`
+func SuffixSearchUnicode(unicode string, maxLen int) []*Root {
+	return prefixSearch(unicodeReverseIndex, ReverseRunes(unicodeSearchKey(unicode)), maxLen)
+}
+
+// SuffixSearchAuto searches word in either of SuffixSearchUnicode, SuffixSearchTurkishLatin and SuffixSearchVisenc
+func SuffixSearchAuto(word string, maxLen int) []*Root {
+
+	switch DetectField(word) {
+	case SearchField_OTTOMAN:
+		return SuffixSearchUnicode(word, maxLen)
+	case SearchField_VISENC, SearchField_ABJAD:
+		return SuffixSearchVisenc(word, maxLen)
+	}
+	return SuffixSearchTurkishLatin(word, maxLen)
+}
+
+// rhymeUnits splits word into the units compared for rhymes and returns them with the reverse index to search, a function
+// to write reversed units as a key of the index and a function to split its keys into reversed units.
+// Latin words are compared by letters, Ottoman words by visenc letter groups without diacritics. Abjad values have no
+// rhymes, their index is nil.
+func rhymeUnits(word string, field SearchField) ([]string, *KeyIndex, func([]string) string, func(string) []string) {
+	if field == SearchField_AUTO || field == SearchField_ALL {
+		field = DetectField(word)
+	}
+
+	splitVisencKey := func(key string) []string {
+		groups := strings.SplitAfter(key, visencReverseSeparator)
+		return groups[:len(groups)-1]
+	}
+
+	switch field {
+	case SearchField_OTTOMAN:
+		return SplitVisenc(visencSearchKey(SearchKey(UnicodeToVisenc(word))), false), visencReverseIndex, reverseVisencGroups, splitVisencKey
+	case SearchField_VISENC:
+		return SplitVisenc(visencSearchKey(SearchKey(word)), false), visencReverseIndex, reverseVisencGroups, splitVisencKey
+	case SearchField_ABJAD:
+		return nil, nil, nil, nil
+	default:
+		units := make([]string, 0, len(word))
+		for _, r := range word {
+			units = append(units, string(r))
+		}
+		splitRunes := func(key string) []string { return strings.Split(key, "") }
+		return units, turkishLatinReverseIndex, func(u []string) string { return ReverseRunes(strings.Join(u, "")) }, splitRunes
+	}
+}
+
+// FindRhymes returns roots ending with the same letters as word, ranked by the number of trailing letters that match.
+// Roots can be filtered by their part of speech and syllable count. Empty pos list or syllableCount <= 0 disables the filter.
+// Abjad values have no rhymes.
+func FindRhymes(word string, field SearchField, pos []PartOfSpeech, syllableCount int, minMatchLength int, maxLen int) []*RhymeMatch {
+
+	units, index, reverse, keyUnits := rhymeUnits(word, field)
+	results := make([]*RhymeMatch, 0)
+
+	if minMatchLength < 1 {
+		minMatchLength = 1
+	}
+	if index == nil || minMatchLength > len(units) {
+		return results
+	}
+
+	posSet := make(map[PartOfSpeech]bool, len(pos))
+	for _, p := range pos {
+		posSet[p] = true
+	}
+
+	accept := func(r *Root) bool {
+		if len(posSet) > 0 && !posSet[r.PartOfSpeech] {
+			return false
+		}
+		if syllableCount > 0 && SyllableCount(r.TurkishLatin) != syllableCount {
+			return false
+		}
+		return true
+	}
+
+	// all rhymes end with the shortest suffix, their match length is read from their keys
+	reversed := keyUnits(reverse(units))
+	seen := make(map[string]bool)
+	start, end := index.PrefixRange(reverse(units[len(units)-minMatchLength:]))
+	for i := start; i < end; i++ {
+		l := 0
+		for _, u := range keyUnits(index.Key(i)) {
+			if l >= len(reversed) || u != reversed[l] {
+				break
+			}
+			l++
+		}
+		for _, ri := range index.Postings(i) {
+			r := rootSet.Roots[ri]
+			k := r.TurkishLatin + r.Ottoman.Unicode
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			if r.TurkishLatin != word && r.Ottoman.Unicode != word && r.Ottoman.Visenc != word && accept(r) {
+				results = append(results, &RhymeMatch{Root: r, MatchLength: int32(l)})
+			}
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].MatchLength != results[j].MatchLength {
+			return results[i].MatchLength > results[j].MatchLength
+		}
+		return len(results[i].Root.TurkishLatin) < len(results[j].Root.TurkishLatin)
+	})
+
+	if len(results) > maxLen {
+		results = results[:maxLen]
+	}
+	return results
+}
@@ -0,0 +1,72 @@
+package lang
+
+import (
+	"fmt"
+	"testing"
+)
+
+// func ReverseRunes(s string) string {
+func TestReverseRunes(t *testing.T) {
+	testDict := map[string]string{
+		"gönül": "lünög",
+		"hâne":  "enâh",
+		"خانه":  "هناخ",
+		"":      "",
+	}
+
+	for i, o := range testDict {
+		if ReverseRunes(i) != o {
+			t.Log(fmt.Sprintf("%s, %s fails for ReverseRunes", i, o))
+			t.Fail()
+		}
+	}
+}
+
+// func ReverseVisenc(s string) string {
+func TestReverseVisenc(t *testing.T) {
+	testDict := map[string]string{
+		"xo1ebo1h": "h.bo1.e.xo1.",
+		"efo2bu2x": "x.bu2.fo2.e.",
+		"emrh":     "h.r.m.e.",
+		"":         "",
+	}
+
+	for i, o := range testDict {
+		if ReverseVisenc(i) != o {
+			t.Log(fmt.Sprintf("%s, %s fails for ReverseVisenc", i, o))
+			t.Fail()
+		}
+	}
+}
+
+// func FindRhymes(word string, field SearchField, pos []PartOfSpeech, syllableCount int, minMatchLength int, maxLen int) []*RhymeMatch {
+func TestFindRhymes(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	testDict := map[string]string{
+		"کتاب":     "tab",
+		"kbo2ebu1": "tab",
+		"kitap":    "hitap",
+	}
+
+	for i, o := range testDict {
+		rhymes := FindRhymes(i, SearchField_AUTO, nil, 0, 2, 100)
+		found := false
+		for j, rm := range rhymes {
+			if j > 0 && rm.MatchLength > rhymes[j-1].MatchLength {
+				t.Log(fmt.Sprintf("%s rhymes should be ranked by their match length: %v", i, rhymes))
+				t.Fail()
+			}
+			found = found || rm.Root.TurkishLatin == o
+		}
+		if !found {
+			t.Log(fmt.Sprintf("%s should rhyme with %s: %v", i, o, rhymes))
+			t.Fail()
+		}
+	}
+
+	if rhymes := FindRhymes("423", SearchField_ABJAD, nil, 0, 1, 10); len(rhymes) > 0 {
+		t.Log(fmt.Sprintf("Abjad values should have no rhymes: %v", rhymes))
+		t.Fail()
+	}
+}
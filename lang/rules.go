@@ -0,0 +1,174 @@
+package lang
+
+import (
+	_ "embed" // default phonology rules are embedded
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Kinds of phonology rules
+const (
+	RuleEffectiveLastVowel = "effective_last_vowel"
+	RuleLastConsonantHard  = "last_consonant_hard"
+	RuleSoftening          = "softening"
+	RuleGemination         = "gemination"
+)
+
+//go:embed data/phonology-rules.csv
+var defaultPhonologyRulesData string
+
+// PhonologyRule is a single declarative rule loaded from a rules file
+type PhonologyRule struct {
+	Priority     int
+	Kind         string
+	Name         string
+	Latin        *regexp.Regexp
+	Visenc       *regexp.Regexp
+	LatinResult  string
+	VisencResult string
+}
+
+// Matches returns true if both the latin and visenc patterns of the rule match. Nil patterns match anything.
+func (pr *PhonologyRule) Matches(latin string, visenc string) bool {
+	if pr.Latin != nil && !pr.Latin.MatchString(latin) {
+		return false
+	}
+	if pr.Visenc != nil && !pr.Visenc.MatchString(visenc) {
+		return false
+	}
+	return true
+}
+
+// PhonologyRuleSet keeps rules grouped by kind in the order they are tried
+type PhonologyRuleSet struct {
+	rules map[string][]*PhonologyRule
+}
+
+var phonologyRules = MustParsePhonologyRules(strings.NewReader(defaultPhonologyRulesData))
+
+// ParsePhonologyRules reads rules in CSV format. Lines beginning with # are comments.
+func ParsePhonologyRules(reader io.Reader) (*PhonologyRuleSet, error) {
+	csvr := csv.NewReader(reader)
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 7
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	rs := PhonologyRuleSet{rules: make(map[string][]*PhonologyRule)}
+	names := make(map[string]bool)
+
+	for i, record := range records {
+		if i == 0 && record[0] == "priority" {
+			continue
+		}
+
+		priority, err := strconv.Atoi(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("Rule %d: priority %s is not a number", i, record[0])
+		}
+
+		rule := PhonologyRule{
+			Priority:     priority,
+			Kind:         strings.TrimSpace(record[1]),
+			Name:         strings.TrimSpace(record[2]),
+			LatinResult:  record[5],
+			VisencResult: record[6],
+		}
+
+		switch rule.Kind {
+		case RuleEffectiveLastVowel, RuleLastConsonantHard, RuleSoftening, RuleGemination:
+		default:
+			return nil, fmt.Errorf("Rule %s: unknown kind %s", rule.Name, rule.Kind)
+		}
+
+		if names[rule.Name] {
+			return nil, fmt.Errorf("Rule %s is defined more than once", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if record[3] != "" {
+			if rule.Latin, err = regexp.Compile(record[3]); err != nil {
+				return nil, fmt.Errorf("Rule %s: %s", rule.Name, err)
+			}
+		}
+		if record[4] != "" {
+			if rule.Visenc, err = regexp.Compile(record[4]); err != nil {
+				return nil, fmt.Errorf("Rule %s: %s", rule.Name, err)
+			}
+		}
+
+		rs.rules[rule.Kind] = append(rs.rules[rule.Kind], &rule)
+	}
+
+	for _, rules := range rs.rules {
+		sort.SliceStable(rules, func(i, j int) bool { return rules[i].Priority < rules[j].Priority })
+	}
+
+	return &rs, nil
+}
+
+// MustParsePhonologyRules is like ParsePhonologyRules but panics if the rules cannot be parsed
+func MustParsePhonologyRules(reader io.Reader) *PhonologyRuleSet {
+	rs, err := ParsePhonologyRules(reader)
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
+
+// LoadPhonologyRules reads a rules file and makes it the rule set used by the package
+func LoadPhonologyRules(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	rs, err := ParsePhonologyRules(file)
+	if err != nil {
+		return err
+	}
+
+	phonologyRules = rs
+	return nil
+}
+
+// GetPhonologyRules returns the rule set used by the package
+func GetPhonologyRules() *PhonologyRuleSet {
+	return phonologyRules
+}
+
+// Rules returns the rules of a kind in the order they are tried
+func (rs *PhonologyRuleSet) Rules(kind string) []*PhonologyRule {
+	return rs.rules[kind]
+}
+
+// FirstMatch returns the first rule of kind that matches latin and visenc, or nil
+func (rs *PhonologyRuleSet) FirstMatch(kind string, latin string, visenc string) *PhonologyRule {
+	for _, r := range rs.rules[kind] {
+		if r.Matches(latin, visenc) {
+			return r
+		}
+	}
+	return nil
+}
+
+// Trace returns the names of the effective_last_vowel and last_consonant_hard rules that fire for a word
+func (rs *PhonologyRuleSet) Trace(latin string) []string {
+	trace := make([]string, 0)
+	for _, kind := range []string{RuleEffectiveLastVowel, RuleLastConsonantHard} {
+		if rule := rs.FirstMatch(kind, latin, ""); rule != nil {
+			trace = append(trace, rule.Name)
+		}
+	}
+	return trace
+}
@@ -0,0 +1,62 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// func ParsePhonologyRules(reader io.Reader) (*PhonologyRuleSet, error) {
+func TestParsePhonologyRules(t *testing.T) {
+	rules := `# comment
+priority,kind,name,latin,visenc,latin_result,visenc_result
+20,effective_last_vowel,late,a$,,a,
+10,effective_last_vowel,early,a$,,e,
+`
+	rs, err := ParsePhonologyRules(strings.NewReader(rules))
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+
+	if r := rs.FirstMatch(RuleEffectiveLastVowel, "baba", ""); r == nil || r.Name != "early" {
+		t.Log(fmt.Sprintf("Lower priority rule should fire first: %v", r))
+		t.Fail()
+	}
+
+	badRules := map[string]string{
+		"unknown kind":   "10,no_such_kind,x,a,,a,\n",
+		"duplicate name": "10,softening,x,a,,a,\n10,softening,x,b,,b,\n",
+		"bad regex":      "10,softening,x,(a,,a,\n",
+		"bad priority":   "first,softening,x,a,,a,\n",
+	}
+
+	for i, o := range badRules {
+		if _, err := ParsePhonologyRules(strings.NewReader(o)); err == nil {
+			t.Log(fmt.Sprintf("%s should fail ParsePhonologyRules", i))
+			t.Fail()
+		}
+	}
+}
+
+// func NewRoot(latin string, visenc string, pos PartOfSpeech) *Root {
+func TestPhonologyRuleTrace(t *testing.T) {
+	// both â before l and a match the word, the result must not depend on map order
+	for i := 0; i < 20; i++ {
+		if ev := EffectiveLastVowel("ahvâl"); ev != "i" {
+			t.Log(fmt.Sprintf("ahvâl effective last vowel: %s", ev))
+			t.Fail()
+		}
+	}
+
+	r := NewRoot("kitap", "kbo2ebu1", PartOfSpeech_NOUN)
+	expected := []string{"a", "hard-final", "p-be"}
+	if strings.Join(r.PhonologyRules, ",") != strings.Join(expected, ",") {
+		t.Log(fmt.Sprintf("kitap trace: %v", r.PhonologyRules))
+		t.Fail()
+	}
+	if r.EffectiveTurkishLatin != "kitab" {
+		t.Log(fmt.Sprintf("kitap effective latin: %s", r.EffectiveTurkishLatin))
+		t.Fail()
+	}
+}
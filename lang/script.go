@@ -0,0 +1,199 @@
+package lang
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/protobuf/proto"
+)
+
+// ScriptProfile converts visenc to the letters of a language written in Arabic script.
+// Visenc encodes the strokes of letters, so the same visenc is written with different Unicode
+// characters in Ottoman, Persian, Arabic and Urdu, e.g. k is ک in Persian and ك in Arabic.
+type ScriptProfile struct {
+	Script          Script
+	visencToUnicode map[string]string
+	unicodeToVisenc map[string]string
+	visencToAbjad   map[string]int32
+	// Searchable lists the Unicode characters accepted for search
+	Searchable string
+}
+
+// GetScriptProfile returns the profile of a script in the visenc table. Unknown scripts use the Ottoman profile.
+func GetScriptProfile(script Script) *ScriptProfile {
+	return visencTable.Profile(script)
+}
+
+// ParseScript returns the script named by s, e.g. persian or URDU. Empty string is Ottoman.
+func ParseScript(s string) (Script, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	switch name {
+	case "", "OTTOMAN":
+		return Script_OTTOMAN_TURKISH, nil
+	}
+	if v, exists := Script_value[name]; exists {
+		return Script(v), nil
+	}
+	return Script_OTTOMAN_TURKISH, fmt.Errorf("Unknown script: %s", s)
+}
+
+// VisencToUnicode converts a visenc string to the Unicode letters of the script
+func (p *ScriptProfile) VisencToUnicode(s string) string {
+	visenc := SplitVisenc(s, false)
+
+	out := ""
+	for _, v := range visenc {
+		out += p.visencToUnicode[v]
+	}
+	return out
+}
+
+// UnicodeToVisenc converts a string written in the script to visenc. Presentation forms and tatweel are normalized first.
+func (p *ScriptProfile) UnicodeToVisenc(s string) string {
+	out := ""
+
+	for _, u := range NormalizeArabic(s) {
+		v, exists := p.unicodeToVisenc[string(u)]
+		if exists {
+			out += v
+		}
+	}
+
+	return out
+}
+
+// VisencToAbjad calculates the abjad value of a visenc word with the abjad table of the script
+func (p *ScriptProfile) VisencToAbjad(s string) int32 {
+	cleaned := SearchKey(s)
+	visencLetters := SplitVisenc(cleaned, false)
+	var out int32 = 0
+	for _, v := range visencLetters {
+		value, exists := p.visencToAbjad[v]
+		if exists {
+			out += value
+		}
+	}
+	return out
+}
+
+// UnicodeToAbjad calculates the abjad value of a word written in the script
+func (p *ScriptProfile) UnicodeToAbjad(s string) int32 {
+	return p.VisencToAbjad(p.UnicodeToVisenc(s))
+}
+
+// IsSearchable checks whether r is accepted for search in the script
+func (p *ScriptProfile) IsSearchable(r rune) bool {
+	return strings.ContainsRune(p.Searchable, r) || strings.ContainsRune(AllSearchableLatin, r)
+}
+
+// OttomanUnicode rewrites a word written in the script with the Ottoman letters used in the dictionary
+func (p *ScriptProfile) OttomanUnicode(s string) string {
+	if p == ottomanProfile || !ContainsArabicChars(s) {
+		return s
+	}
+	return ottomanProfile.VisencToUnicode(p.UnicodeToVisenc(s))
+}
+
+// Localize returns a copy of root with its Unicode spelling and abjad in the script.
+// Roots are shared by all searches, so they are not modified.
+func (p *ScriptProfile) Localize(root *Root) *Root {
+	if p == ottomanProfile || root == nil || root.Ottoman == nil {
+		return root
+	}
+	out := proto.Clone(root).(*Root)
+	out.Ottoman.Unicode = norm.NFKC.String(p.VisencToUnicode(root.Ottoman.Visenc))
+	out.Ottoman.Abjad = p.VisencToAbjad(root.Ottoman.Visenc)
+	out.Ottoman.Script = p.Script
+	out.Abjad = out.Ottoman.Abjad
+	out.ArabicRoot = p.localizeArabic(root.ArabicRoot)
+	return out
+}
+
+// localizeArabic writes Ottoman letters that aren't a word of the dictionary, like Arabic roots, in the script
+func (p *ScriptProfile) localizeArabic(s string) string {
+	if p == ottomanProfile || s == "" {
+		return s
+	}
+	return norm.NFKC.String(p.VisencToUnicode(UnicodeToVisenc(s)))
+}
+
+// LocalizeRoots returns copies of roots with their Unicode spellings and abjad in the script
+func (p *ScriptProfile) LocalizeRoots(roots []*Root) []*Root {
+	if p == ottomanProfile {
+		return roots
+	}
+	out := make([]*Root, len(roots))
+	for i, r := range roots {
+		out[i] = p.Localize(r)
+	}
+	return out
+}
+
+// LocalizeResults returns copies of search results with their roots in the script
+func (p *ScriptProfile) LocalizeResults(results []*SearchResult) []*SearchResult {
+	if p == ottomanProfile {
+		return results
+	}
+	out := make([]*SearchResult, len(results))
+	for i, sr := range results {
+		out[i] = &SearchResult{Root: p.Localize(sr.Root), Suffixes: sr.Suffixes, Variants: sr.Variants, Field: sr.Field, Match: sr.Match, Relation: sr.Relation}
+	}
+	return out
+}
+
+var arabicRunRegex = regexp.MustCompile(`[\x{0600}-\x{06FF}\x{FB50}-\x{FDFF}\x{FE70}-\x{FEFE}]+`)
+
+// MakeOttomanWord builds an OttomanWord written in the script from either visenc or unicode
+func (p *ScriptProfile) MakeOttomanWord(visenc string, unicode string) (*OttomanWord, error) {
+	if visenc == "" && unicode == "" {
+		return nil, errors.New("Need either visenc or ottoman")
+	}
+
+	var cleanVisenc string
+	if len(visenc) == 0 {
+		cleanVisenc = p.UnicodeToVisenc(unicode)
+	} else {
+		// Arabic letters and marks in visenc, like the superscript alef in eٰdo4ebu1, are read as their codes
+		converted := arabicRunRegex.ReplaceAllStringFunc(visenc, p.UnicodeToVisenc)
+		cleanVisenc = regexp.MustCompile("[^a-z0-9 |||]+").ReplaceAllLiteralString(converted, "")
+		if cleanVisenc != visenc {
+			log.Printf("Cleaned Visenc %s -> %s", visenc, cleanVisenc)
+		}
+	}
+
+	var normalized string
+
+	if len(unicode) == 0 {
+		normalized = norm.NFKC.String(p.VisencToUnicode(cleanVisenc))
+	} else {
+		normalized = norm.NFKC.String(NormalizeArabic(unicode))
+	}
+
+	if !utf8.ValidString(normalized) {
+		log.Printf("Invalid UTF-8 for Unicode: %s", normalized)
+	}
+
+	if !utf8.ValidString(cleanVisenc) {
+		log.Printf("Invalid UTF-8 for Visenc: %s", cleanVisenc)
+	}
+
+	abjad := p.VisencToAbjad(cleanVisenc)
+
+	searchKey := SearchKey(cleanVisenc)
+
+	dotlessSearchKey := DotlessSearchKey(cleanVisenc)
+
+	return &OttomanWord{
+		Visenc:           cleanVisenc,
+		Unicode:          normalized,
+		Abjad:            abjad,
+		SearchKey:        searchKey,
+		DotlessSearchKey: dotlessSearchKey,
+		Script:           p.Script,
+	}, nil
+}
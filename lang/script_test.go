@@ -0,0 +1,113 @@
+package lang
+
+import (
+	"fmt"
+	"testing"
+)
+
+// func (p *ScriptProfile) VisencToUnicode(s string) string {
+func TestScriptVisencToUnicode(t *testing.T) {
+	testDict := map[Script]map[string]string{
+		Script_OTTOMAN_TURKISH: {"kbo2ebu1": "کتاب", "bu2kbu3erh": "يکپاره"},
+		Script_PERSIAN:         {"kbo2ebu1": "کتاب", "bu2kbu3erh": "یکپاره"},
+		Script_ARABIC:          {"kbo2ebu1": "كتاب", "my": "مى", "n1n2": "١٢"},
+		Script_URDU:            {"kbo2ebu1": "کتاب", "xo1eboth": "خاٹہ", "kyob": "کے", "mbog": "مں"},
+	}
+
+	for script, words := range testDict {
+		p := GetScriptProfile(script)
+		for v, u := range words {
+			if o := p.VisencToUnicode(v); o != u {
+				t.Log(fmt.Sprintf("%s: %s should be %s, got %s", script, v, u, o))
+				t.Fail()
+			}
+		}
+	}
+}
+
+// func (p *ScriptProfile) UnicodeToVisenc(s string) string {
+func TestScriptUnicodeToVisenc(t *testing.T) {
+	testDict := map[Script]map[string]string{
+		// both yeh letters are written as ی in Persian
+		Script_PERSIAN: {"یکپاره": "ykbu3erh", "كتاب": "kbo2ebu1"},
+		Script_ARABIC:  {"١٢": "n1n2", "مى": "my"},
+		Script_URDU:    {"خاٹہ": "xo1eboth", "کے": "kyob", "مں": "mbog", "ڈھ": "dothod"},
+	}
+
+	for script, words := range testDict {
+		p := GetScriptProfile(script)
+		for u, v := range words {
+			if o := p.UnicodeToVisenc(u); o != v {
+				t.Log(fmt.Sprintf("%s: %s should be %s, got %s", script, u, v, o))
+				t.Fail()
+			}
+		}
+	}
+}
+
+// func (p *ScriptProfile) UnicodeToAbjad(s string) int32 {
+func TestScriptUnicodeToAbjad(t *testing.T) {
+	testDict := map[Script]map[string]int32{
+		Script_OTTOMAN_TURKISH: {"کتاب": 423, "ٹوپی": 18},
+		Script_ARABIC:          {"كتاب": 423},
+		Script_URDU:            {"ٹوپی": 418, "بڑے": 212},
+	}
+
+	for script, words := range testDict {
+		p := GetScriptProfile(script)
+		for u, a := range words {
+			if o := p.UnicodeToAbjad(u); o != a {
+				t.Log(fmt.Sprintf("%s: abjad of %s should be %d, got %d", script, u, a, o))
+				t.Fail()
+			}
+		}
+	}
+}
+
+// func ParseScript(s string) (Script, error) {
+func TestParseScript(t *testing.T) {
+	testDict := map[string]Script{
+		"":        Script_OTTOMAN_TURKISH,
+		"ottoman": Script_OTTOMAN_TURKISH,
+		"persian": Script_PERSIAN,
+		"ARABIC":  Script_ARABIC,
+		" urdu ":  Script_URDU,
+	}
+
+	for i, o := range testDict {
+		if s, err := ParseScript(i); err != nil || s != o {
+			t.Log(fmt.Sprintf("%s should be parsed as %s: %s %v", i, o, s, err))
+			t.Fail()
+		}
+	}
+
+	if _, err := ParseScript("klingon"); err == nil {
+		t.Log("Unknown scripts should return an error")
+		t.Fail()
+	}
+}
+
+// func (p *ScriptProfile) Localize(root *Root) *Root {
+func TestScriptLocalize(t *testing.T) {
+	root := NewRoot("kitap", "kbo2ebu1", PartOfSpeech_NOUN)
+	arabic := GetScriptProfile(Script_ARABIC).Localize(root)
+
+	if arabic.Ottoman.Unicode != "كتاب" || arabic.Ottoman.Script != Script_ARABIC {
+		t.Log(fmt.Sprintf("Arabic spelling of kitap: %s", arabic.Ottoman.Unicode))
+		t.Fail()
+	}
+	if root.Ottoman.Unicode != "کتاب" {
+		t.Log(fmt.Sprintf("Localize should not change the root: %s", root.Ottoman.Unicode))
+		t.Fail()
+	}
+
+	// a Persian query finds the Ottoman spelling
+	if o := GetScriptProfile(Script_PERSIAN).OttomanUnicode("یکپاره"); o != "یکپاره" {
+		t.Log(fmt.Sprintf("Ottoman spelling of یکپاره: %s", o))
+		t.Fail()
+	}
+	if o := GetScriptProfile(Script_ARABIC).OttomanUnicode("كتاب"); o != "کتاب" {
+		t.Log(fmt.Sprintf("Ottoman spelling of كتاب: %s", o))
+		t.Fail()
+	}
+}
@@ -1,60 +1,42 @@
 package lang
 
 import (
-	"errors"
 	"fmt"
 	"log"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
-
-	"github.com/tchap/go-patricia/patricia"
 )
 
 var rootSet *RootSet
-var turkishLatinTrie *patricia.Trie
-var visencTrie *patricia.Trie
-var unicodeTrie *patricia.Trie
 
-var turkishLatinIndex *map[rune][]string
-var visencIndex *map[rune][]string
-var unicodeIndex *map[rune][]string
+var turkishLatinIndex *KeyIndex
+var visencIndex *KeyIndex
+var unicodeIndex *KeyIndex
 
 var abjadIndex *map[int32][]int
 
-func buildTrie(roots []*Root, keyfunc func(*Root, int) string) *patricia.Trie {
-	trie := patricia.NewTrie()
+// IgnoreJoiners removes zero width non-joiners and joiners (|| and >< in visenc) from Ottoman keys and searched words,
+// so words are found whether they are written with them or not. It should be set before InitSearch.
+var IgnoreJoiners = true
 
-	for i, r := range roots {
-		trie.Insert(patricia.Prefix(keyfunc(r, i)), i)
+// visencSearchKey returns the visenc key indexed and searched for s
+func visencSearchKey(s string) string {
+	if IgnoreJoiners {
+		return RemoveJoiners(s)
 	}
-
-	return trie
-
+	return s
 }
 
-func buildIndex(roots []*Root, keyfunc func(*Root, int) string) *map[rune][]string {
-	m := make(map[rune][]string)
-
-	for i, r := range roots {
-		s := keyfunc(r, i)
-		r := []rune(s)
-		var mapkey rune
-
-		if len(r) > 0 {
-			mapkey = r[0]
-		} else {
-			continue
-		}
-
-		_, exists := m[mapkey]
-		if exists == false {
-			m[mapkey] = make([]string, 0)
-		}
-		m[mapkey] = append(m[mapkey], s)
+// unicodeSearchKey returns the Unicode key indexed and searched for s. Presentation forms and tatweel are normalized
+// and letter variants are written alike, like ي and ی.
+func unicodeSearchKey(s string) string {
+	s = replaceVariantLetters(NormalizeArabic(s))
+	if IgnoreJoiners {
+		return RemoveJoiners(s)
 	}
-	return &m
+	return s
 }
 
 func buildAbjadIndex(roots []*Root) *map[int32][]int {
@@ -97,17 +79,26 @@ func sortByLength(roots []*Root) []*Root {
 	return roots
 }
 
-// InitSearch loads protobuf file and builds Trie and []string indices for turkishLatin, visenc and unicode
+// InitSearch loads protobuf file and builds sorted key indices for turkishLatin, visenc and unicode and their reverses
 func InitSearch(protobuffile string) {
 	rootSet = LoadRootSetProtobuf(protobuffile)
+	unicodeVariantLetters = variantTable.unicodeLetters()
+	if err := CheckVisencVersion(rootSet); err != nil {
+		log.Printf("%s. Rebuild the root set with csv2protobuf if searches in Ottoman fail.", err)
+	}
 
-	turkishLatinTrie = buildTrie(rootSet.Roots, func(r *Root, i int) string { return fmt.Sprintf("%s#%d", r.TurkishLatin, i) })
-	visencTrie = buildTrie(rootSet.Roots, func(r *Root, i int) string { return fmt.Sprintf("%s#%d", r.Ottoman.Visenc, i) })
-	unicodeTrie = buildTrie(rootSet.Roots, func(r *Root, i int) string { return fmt.Sprintf("%s#%d", r.Ottoman.Unicode, i) })
+	turkishLatinIndex = BuildKeyIndex(rootSet.Roots, func(r *Root) string { return r.TurkishLatin })
+	visencIndex = BuildKeyIndex(rootSet.Roots, func(r *Root) string { return visencSearchKey(r.Ottoman.Visenc) })
+	unicodeIndex = BuildKeyIndex(rootSet.Roots, func(r *Root) string { return unicodeSearchKey(r.Ottoman.Unicode) })
 
-	turkishLatinIndex = buildIndex(rootSet.Roots, func(r *Root, i int) string { return fmt.Sprintf("%s#%d", r.TurkishLatin, i) })
-	visencIndex = buildIndex(rootSet.Roots, func(r *Root, i int) string { return fmt.Sprintf("%s#%d", r.Ottoman.Visenc, i) })
-	unicodeIndex = buildIndex(rootSet.Roots, func(r *Root, i int) string { return fmt.Sprintf("%s#%d", r.Ottoman.Unicode, i) })
+	buildReverseIndices(rootSet.Roots)
+	buildStemIndices(rootSet.Roots)
+	buildVariantIndices(rootSet.Roots)
+	buildPatternIndex(rootSet.Roots)
+	buildPhoneticIndex(rootSet.Roots)
+	buildSpellingIndex(rootSet.Roots)
+	buildCompletionIndices()
+	buildArabicRootIndex(rootSet)
 
 	abjadIndex = buildAbjadIndex(rootSet.Roots)
 }
@@ -117,33 +108,18 @@ func GetRootSet() *RootSet {
 	return rootSet
 }
 
-// GetTurkishLatinTrie returns a trie keeping turkishLatin roots
-func GetTurkishLatinTrie() *patricia.Trie {
-	return turkishLatinTrie
-}
-
-// GetVisencTrie returns a trie keeping visenc of roots
-func GetVisencTrie() *patricia.Trie {
-	return visencTrie
-}
-
-// GetUnicodeTrie returns a trie for unicode roots
-func GetUnicodeTrie() *patricia.Trie {
-	return unicodeTrie
-}
-
-// GetTurkishLatinIndex returns turkishLatinIndex
-func GetTurkishLatinIndex() *map[rune][]string {
+// GetTurkishLatinIndex returns the key index of turkishLatin roots
+func GetTurkishLatinIndex() *KeyIndex {
 	return turkishLatinIndex
 }
 
-// GetVisencIndex returns visencIndex
-func GetVisencIndex() *map[rune][]string {
+// GetVisencIndex returns the key index of visenc roots
+func GetVisencIndex() *KeyIndex {
 	return visencIndex
 }
 
-// GetUnicodeIndex returns unicode index
-func GetUnicodeIndex() *map[rune][]string {
+// GetUnicodeIndex returns the key index of unicode roots
+func GetUnicodeIndex() *KeyIndex {
 	return unicodeIndex
 }
 
@@ -152,21 +128,12 @@ func GetAbjadIndex() *map[int32][]int {
 	return abjadIndex
 }
 
-// PrefixSearchTurkishLatin returns list of roots whose TurkishLatin begins with `turkishLatin`
-func PrefixSearchTurkishLatin(turkishLatin string, maxLen int) []*Root {
+// prefixSearch returns at most maxLen roots whose key in index begins with prefix
+func prefixSearch(index *KeyIndex, prefix string, maxLen int) []*Root {
 	results := make([]*Root, 0)
-	visitFunc := func(_ patricia.Prefix, item patricia.Item) error {
-		i, ok := item.(int)
-		if ok {
-			results = append(results, rootSet.Roots[i])
-		} else {
-			log.Printf("Error for %s in SearchTurkishLatin", item)
-			return errors.New("item error")
-		}
-		return nil
-
-	}
-	turkishLatinTrie.VisitSubtree(patricia.Prefix(turkishLatin), visitFunc)
+	index.VisitPrefix(prefix, func(i int32) {
+		results = append(results, rootSet.Roots[i])
+	})
 
 	results = filterResults(results)
 	results = sortByLength(results)
@@ -177,28 +144,11 @@ func PrefixSearchTurkishLatin(turkishLatin string, maxLen int) []*Root {
 	return results
 }
 
-// PrefixSearchTurkishLatinExact returns a single Root where Root.TurkishLatin == turkishLatin
-func PrefixSearchTurkishLatinExact(turkishLatin string) []*Root {
-	return PrefixSearchTurkishLatin(turkishLatin+"#", 1)
-}
-
-// PrefixSearchVisenc returns list of roots whose Visenc starts with `visenc`
-func PrefixSearchVisenc(visenc string, maxLen int) []*Root {
-	results := make([]*Root, 0)
-	visitFunc := func(_ patricia.Prefix, item patricia.Item) error {
-		i, ok := item.(int)
-		if ok {
-			results = append(results, rootSet.Roots[i])
-		} else {
-			log.Printf("Error for %s in SearchVisenc", item)
-			return errors.New("item error")
-		}
-		return nil
-	}
-	visencTrie.VisitSubtree(patricia.Prefix(visenc), visitFunc)
+// exactSearch returns at most maxLen roots whose key in index is exactly key
+func exactSearch(index *KeyIndex, key string, maxLen int) []*Root {
+	results := rootsFromIndices(index.Lookup(key))
 
 	results = filterResults(results)
-	results = sortByLength(results)
 	if maxLen < len(results) {
 		results = results[:maxLen]
 	}
@@ -206,38 +156,34 @@ func PrefixSearchVisenc(visenc string, maxLen int) []*Root {
 	return results
 }
 
+// PrefixSearchTurkishLatin returns list of roots whose TurkishLatin begins with `turkishLatin`
+func PrefixSearchTurkishLatin(turkishLatin string, maxLen int) []*Root {
+	return prefixSearch(turkishLatinIndex, turkishLatin, maxLen)
+}
+
+// PrefixSearchTurkishLatinExact returns a single Root where Root.TurkishLatin == turkishLatin
+func PrefixSearchTurkishLatinExact(turkishLatin string) []*Root {
+	return exactSearch(turkishLatinIndex, turkishLatin, 1)
+}
+
+// PrefixSearchVisenc returns list of roots whose Visenc starts with `visenc`
+func PrefixSearchVisenc(visenc string, maxLen int) []*Root {
+	return prefixSearch(visencIndex, visencSearchKey(visenc), maxLen)
+}
+
 // PrefixSearchVisencExact returns a maximum of 10 Root having Visenc = `visenc`
 func PrefixSearchVisencExact(visenc string) []*Root {
-	return PrefixSearchVisenc(visenc+"#", 10)
+	return exactSearch(visencIndex, visencSearchKey(visenc), 10)
 }
 
 // PrefixSearchUnicode searches roots by unicode string
 func PrefixSearchUnicode(unicode string, maxLen int) []*Root {
-	results := make([]*Root, 0)
-	visitFunc := func(_ patricia.Prefix, item patricia.Item) error {
-		i, ok := item.(int)
-		if ok {
-			results = append(results, rootSet.Roots[i])
-		} else {
-			log.Printf("Error for %s in SearchUnicode", item)
-			return errors.New("item error")
-		}
-		return nil
-	}
-	unicodeTrie.VisitSubtree(patricia.Prefix(unicode), visitFunc)
-
-	results = filterResults(results)
-	results = sortByLength(results)
-	if maxLen < len(results) {
-		results = results[:maxLen]
-	}
-
-	return results
+	return prefixSearch(unicodeIndex, unicodeSearchKey(unicode), maxLen)
 }
 
 //PrefixSearchUnicodeExact returns maximum 10 roots with having a prefix unicode
 func PrefixSearchUnicodeExact(unicode string) []*Root {
-	return PrefixSearchUnicode(unicode+"#", 10)
+	return exactSearch(unicodeIndex, unicodeSearchKey(unicode), 10)
 }
 
 // PrefixSearchAll runs PrefixSearchTurkishLatin, PrefixSearchUnicode, PrefixSearchVisenc, IndexSearchAbjad and combines results.
@@ -261,36 +207,17 @@ func PrefixSearchAll(term string, maxLen int) []*Root {
 	return results
 }
 
-func splitRootKeyFromIndex(k string) (int, error) {
-	elements := strings.Split(k, "#")
-	if len(elements) != 2 {
+// regexSearch returns at most maxLen roots whose key in index matches regex
+func regexSearch(index *KeyIndex, regex *regexp.Regexp, maxLen int) []*Root {
+	results := rootsFromIndices(index.Match(regex.MatchString))
 
-		return -1, fmt.Errorf("Malformed Index String %s", k)
-	}
-
-	i, err := strconv.Atoi(elements[1])
-	if err != nil {
-		return -1, err
+	results = filterResults(results)
+	results = sortByLength(results)
+	if maxLen < len(results) {
+		results = results[:maxLen]
 	}
 
-	return i, nil
-}
-
-func indexRegexSearch(keylist []string, r *regexp.Regexp) []*Root {
-
-	roots := make([]*Root, 0)
-
-	for _, k := range keylist {
-		if r.MatchString(k) {
-			ri, err := splitRootKeyFromIndex(k)
-			if err == nil {
-				roots = append(roots, rootSet.Roots[ri])
-			} else {
-				log.Println(err)
-			}
-		}
-	}
-	return roots
+	return results
 }
 
 // FuzzySearchTurkishLatin searches word in the string index via regexes.
@@ -311,29 +238,13 @@ func FuzzySearchTurkishLatin(word string, maxLen int) []*Root {
 
 // RegexSearchTurkishLatin searches turkishLatinIndex with the supplied regex
 func RegexSearchTurkishLatin(regex *regexp.Regexp, maxLen int) []*Root {
-
-	results := make([]*Root, 0)
-
-	// TODO convert the search to goroutines
-
-	for _, indexList := range *turkishLatinIndex {
-		docResults := indexRegexSearch(indexList, regex)
-		results = append(results, docResults...)
-	}
-
-	results = filterResults(results)
-	results = sortByLength(results)
-	if maxLen < len(results) {
-		results = results[:maxLen]
-	}
-
-	return results
+	return regexSearch(turkishLatinIndex, regex, maxLen)
 }
 
 // FuzzySearchUnicode searches `word` in unicode indices
 func FuzzySearchUnicode(word string, maxLen int) []*Root {
 
-	runes := []rune(word)
+	runes := []rune(unicodeSearchKey(word))
 	var sb strings.Builder
 	sb.WriteString(".*")
 	for _, r := range runes {
@@ -347,29 +258,13 @@ func FuzzySearchUnicode(word string, maxLen int) []*Root {
 
 // RegexSearchUnicode searches unicodeIndex with the supplied regex and returns at most maxLen results
 func RegexSearchUnicode(regex *regexp.Regexp, maxLen int) []*Root {
-
-	results := make([]*Root, 0)
-
-	// TODO convert the search to goroutines
-
-	for _, indexList := range *unicodeIndex {
-		docResults := indexRegexSearch(indexList, regex)
-		results = append(results, docResults...)
-	}
-
-	results = filterResults(results)
-	results = sortByLength(results)
-	if maxLen < len(results) {
-		results = results[:maxLen]
-	}
-
-	return results
+	return regexSearch(unicodeIndex, regex, maxLen)
 }
 
 // FuzzySearchVisenc searches word in visencIndices using fuzzy matching
 func FuzzySearchVisenc(word string, maxLen int) []*Root {
 
-	visencLetters := SplitVisenc(word, false)
+	visencLetters := SplitVisenc(visencSearchKey(word), false)
 
 	var sb strings.Builder
 	sb.WriteString(".*")
@@ -385,64 +280,58 @@ func FuzzySearchVisenc(word string, maxLen int) []*Root {
 
 // RegexSearchVisenc makes a search in visenc field with the supplied regexp
 func RegexSearchVisenc(regex *regexp.Regexp, maxLen int) []*Root {
-
-	results := make([]*Root, 0)
-
-	// TODO convert the search to goroutines
-
-	for _, indexList := range *visencIndex {
-		docResults := indexRegexSearch(indexList, regex)
-		results = append(results, docResults...)
-	}
-
-	results = filterResults(results)
-	results = sortByLength(results)
-	if maxLen < len(results) {
-		results = results[:maxLen]
-	}
-
-	return results
+	return regexSearch(visencIndex, regex, maxLen)
 }
 
 // FuzzySearchAuto searches word in either of FuzzySearchUnicode, FuzzySearchTurkishLatin, FuzzySearchVisenc and IndexSearchAbjad
+// by the field DetectField finds for it
 func FuzzySearchAuto(word string, maxLen int) []*Root {
 
-	if ContainsArabicChars(word) {
+	switch DetectField(word) {
+	case SearchField_ALL:
+		return ResultRoots(SearchAll(word, maxLen))
+	case SearchField_OTTOMAN:
 		return FuzzySearchUnicode(word, maxLen)
-	} else if ContainsDigits(word) {
-		if val, err := strconv.Atoi(word); err == nil {
-			return IndexSearchAbjad(int32(val), maxLen)
-		}
+	case SearchField_ABJAD:
+		val, _ := strconv.Atoi(strings.TrimSpace(word))
+		return IndexSearchAbjad(int32(val), maxLen)
+	case SearchField_VISENC:
 		return FuzzySearchVisenc(word, maxLen)
 	}
 	return FuzzySearchTurkishLatin(word, maxLen)
 }
 
 // RegexSearchAuto searches word in either of RegexSearchUnicode, RegexSearchTurkishLatin, RegexSearchVisenc and IndexSearchAbjad
+// by the field DetectField finds for the literal characters of the regex
 func RegexSearchAuto(regexp *regexp.Regexp, maxLen int) []*Root {
 
-	word := regexp.String()
-
-	if ContainsArabicChars(word) {
+	switch DetectField(RegexLiterals(regexp)) {
+	case SearchField_OTTOMAN:
 		return RegexSearchUnicode(regexp, maxLen)
-	} else if ContainsDigits(word) {
-		if val, err := strconv.Atoi(word); err == nil {
+	case SearchField_ABJAD:
+		if val, err := strconv.Atoi(regexp.String()); err == nil {
 			return IndexSearchAbjad(int32(val), maxLen)
 		}
 		return RegexSearchVisenc(regexp, maxLen)
+	case SearchField_VISENC:
+		return RegexSearchVisenc(regexp, maxLen)
 	}
 	return RegexSearchTurkishLatin(regexp, maxLen)
 }
 
 // PrefixSearchAuto searches word in either of PrefixSearchUnicode, PrefixSearchTurkishLatin, PrefixSearchVisenc and IndexSearchAbjad
+// by the field DetectField finds for it
 func PrefixSearchAuto(word string, maxLen int) []*Root {
 
-	if ContainsArabicChars(word) {
+	switch DetectField(word) {
+	case SearchField_ALL:
+		return PrefixSearchAll(word, maxLen)
+	case SearchField_OTTOMAN:
 		return PrefixSearchUnicode(word, maxLen)
-	} else if ContainsDigits(word) {
-		if val, err := strconv.Atoi(word); err == nil {
-			return IndexSearchAbjad(int32(val), maxLen)
-		}
+	case SearchField_ABJAD:
+		val, _ := strconv.Atoi(strings.TrimSpace(word))
+		return IndexSearchAbjad(int32(val), maxLen)
+	case SearchField_VISENC:
 		return PrefixSearchVisenc(word, maxLen)
 	}
 	return PrefixSearchTurkishLatin(word, maxLen)
@@ -476,7 +365,11 @@ func IndexSearchAbjad(abjad int32, maxLen int) []*Root {
 func PrintRoots(roots []*Root) string {
 	out := ""
 	for i, r := range roots {
-		out += fmt.Sprintf("%d - %s | %s | %s | %d\n", i, r.TurkishLatin, r.Ottoman.Unicode, r.Ottoman.Visenc, r.Ottoman.Abjad)
+		out += fmt.Sprintf("%d - %s | %s | %s | %d", i, r.TurkishLatin, r.Ottoman.Unicode, r.Ottoman.Visenc, r.Ottoman.Abjad)
+		if labels := RelationLabels(r); len(labels) > 0 {
+			out += " | " + strings.Join(labels, ", ")
+		}
+		out += "\n"
 	}
 	return out
 }
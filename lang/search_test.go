@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
-
-	"github.com/tchap/go-patricia/patricia"
 )
 
 /*
@@ -59,18 +57,44 @@ func TestPrefixSearchTurkishLatin(t *testing.T) {
 // func IndexSearchAbjad(abjad int32) []*Root {
 // func PrintRoots(roots []*Root) string {
 
-func TestGetTurkishLatinTrie(t *testing.T) {
+// func PrefixSearchVisencExact(visenc string) []*Root {
+// func PrefixSearchUnicodeExact(unicode string) []*Root {
+func TestIgnoreJoiners(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	// kal'a is written as fo2lah|| in the data
+	testDict := map[string][]*Root{
+		"fo2lah":   PrefixSearchVisencExact("fo2lah"),
+		"fo2lah||": PrefixSearchVisencExact("fo2lah||"),
+		"قلعه":     PrefixSearchUnicodeExact("قلعه"),
+		"ﻗﻠﻌﻪ":     PrefixSearchUnicodeExact("ﻗﻠﻌﻪ"),
+		"قلعه‌":    PrefixSearchUnicodeExact("قلعه\u200C"),
+	}
+
+	for i, roots := range testDict {
+		found := false
+		for _, r := range roots {
+			found = found || r.TurkishLatin == "kal'a"
+		}
+		if !found {
+			t.Log(fmt.Sprintf("%s should find kal'a: %s", i, PrintRoots(roots)))
+			t.Fail()
+		}
+	}
+}
+
+func TestGetTurkishLatinIndex(t *testing.T) {
 	tests := []struct {
 		name string
-		want *patricia.Trie
+		want *KeyIndex
 	}{
-		{"turkishLatinTrie", turkishLatinTrie},
+		{"turkishLatinIndex", turkishLatinIndex},
 	}
 	InitSearch(PROTOBUFFILE)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := GetTurkishLatinTrie(); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GetTurkishLatinTrie() = %v, want %v", got, tt.want)
+			if got := GetTurkishLatinIndex(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetTurkishLatinIndex() = %v, want %v", got, tt.want)
 			}
 		})
 	}
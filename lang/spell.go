@@ -0,0 +1,430 @@
+package lang
+
+import (
+	_ "embed" // default letter confusions are embedded
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+//go:embed data/confusions.csv
+var defaultConfusionsData string
+
+// MAXSUGGESTIONS is the default number of suggestions for a misspelled word
+const MAXSUGGESTIONS = 5
+
+// maxSpellDistance is the largest letter edit distance of a suggestion from the misspelled word
+const maxSpellDistance = 2.0
+
+// Kinds of confusion rows
+const (
+	ConfusionSubstitute = "substitute"
+	ConfusionInsert     = "insert"
+)
+
+// ConfusionTable keeps the costs of letter edits that are cheaper than 1
+type ConfusionTable struct {
+	substitute map[string]float64
+	insert     map[string]float64
+}
+
+var confusionTable = MustParseConfusions(strings.NewReader(defaultConfusionsData))
+
+// spellingIndex keeps roots by the dotless skeleton of their letters, misspellings with wrong dots have the same key
+var spellingIndex *KeyIndex
+
+// skeletonLetters are the letters of the keys in spellingIndex, used to make the keys one edit away from a word
+var skeletonLetters []string
+
+// ParseConfusions reads letter confusions in CSV format. Lines beginning with # are comments.
+func ParseConfusions(reader io.Reader) (*ConfusionTable, error) {
+	csvr := csv.NewReader(reader)
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 4
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	ct := ConfusionTable{substitute: make(map[string]float64), insert: make(map[string]float64)}
+
+	for i, record := range records {
+		if i == 0 && record[0] == "kind" {
+			continue
+		}
+
+		letters := strings.Fields(record[1])
+		for _, l := range letters {
+			if _, exists := VisencToUnicodeMap[l]; !exists {
+				return nil, fmt.Errorf("Confusion %s: %s is not a visenc letter", record[3], l)
+			}
+		}
+		cost, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil || cost <= 0 || cost > 1 {
+			return nil, fmt.Errorf("Confusion %s: cost should be more than 0 and at most 1: %s", record[3], record[2])
+		}
+
+		switch strings.TrimSpace(record[0]) {
+		case ConfusionSubstitute:
+			for _, a := range letters {
+				for _, b := range letters {
+					if c, exists := ct.substitute[a+" "+b]; a != b && (!exists || cost < c) {
+						ct.substitute[a+" "+b] = cost
+					}
+				}
+			}
+		case ConfusionInsert:
+			for _, l := range letters {
+				ct.insert[l] = cost
+			}
+		default:
+			return nil, fmt.Errorf("Confusion %s: unknown kind %s", record[3], record[0])
+		}
+	}
+
+	return &ct, nil
+}
+
+// MustParseConfusions is like ParseConfusions but panics if the confusions cannot be parsed
+func MustParseConfusions(reader io.Reader) *ConfusionTable {
+	ct, err := ParseConfusions(reader)
+	if err != nil {
+		panic(err)
+	}
+	return ct
+}
+
+// LoadConfusions reads a letter confusions file and makes it the table used by the package
+func LoadConfusions(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	ct, err := ParseConfusions(file)
+	if err != nil {
+		return err
+	}
+
+	confusionTable = ct
+	return nil
+}
+
+// substitution returns the cost of writing b for a
+func (ct *ConfusionTable) substitution(a string, b string) float64 {
+	if a == b {
+		return 0
+	}
+	if c, exists := ct.substitute[a+" "+b]; exists {
+		return c
+	}
+	return 1
+}
+
+// insertion returns the cost of adding or removing a
+func (ct *ConfusionTable) insertion(a string) float64 {
+	if c, exists := ct.insert[a]; exists {
+		return c
+	}
+	return 1
+}
+
+// Distance returns the edit distance between two words written as visenc letters. Adding, removing and replacing
+// a letter, or swapping two adjacent letters, costs 1 unless the table has a lower cost for the letters.
+func (ct *ConfusionTable) Distance(a []string, b []string) float64 {
+	d := make([][]float64, len(a)+1)
+	for i := range d {
+		d[i] = make([]float64, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		d[i][0] = d[i-1][0] + ct.insertion(a[i-1])
+	}
+	for j := 1; j <= len(b); j++ {
+		d[0][j] = d[0][j-1] + ct.insertion(b[j-1])
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			d[i][j] = math.Min(math.Min(
+				d[i-1][j]+ct.insertion(a[i-1]),
+				d[i][j-1]+ct.insertion(b[j-1])),
+				d[i-1][j-1]+ct.substitution(a[i-1], b[j-1]))
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = math.Min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
+
+// spellingLetters returns the letters of a visenc word compared by the spell checker, without diacritics and joiners
+func spellingLetters(visenc string) []string {
+	return letterGroups(SearchKey(RemoveJoiners(visenc)))
+}
+
+// skeletonKey returns the key of letters in spellingIndex, letters are written without dots and letter variants are the same
+func skeletonKey(letters []string) string {
+	skeleton := make([]string, len(letters))
+	for i, l := range letters {
+		skeleton[i] = DotlessSearchKey(letterKey(l))
+	}
+	return strings.Join(skeleton, ".")
+}
+
+func buildSpellingIndex(roots []*Root) {
+	seen := make(map[string]bool)
+	skeletonLetters = make([]string, 0)
+	spellingIndex = BuildKeyIndex(roots, func(r *Root) string {
+		letters := spellingLetters(r.Ottoman.Visenc)
+		key := skeletonKey(letters)
+		for _, l := range strings.Split(key, ".") {
+			if l != "" && !seen[l] {
+				seen[l] = true
+				skeletonLetters = append(skeletonLetters, l)
+			}
+		}
+		return key
+	})
+	sort.Strings(skeletonLetters)
+}
+
+// skeletonNeighbors returns the keys of spellingIndex with at most one letter added, removed, replaced or swapped
+func skeletonNeighbors(letters []string) []string {
+	skeleton := strings.Split(skeletonKey(letters), ".")
+	keys := []string{strings.Join(skeleton, ".")}
+	edited := func(i int, j int, insert ...string) {
+		out := make([]string, 0, len(skeleton)+1)
+		out = append(out, skeleton[:i]...)
+		out = append(out, insert...)
+		out = append(out, skeleton[j:]...)
+		keys = append(keys, strings.Join(out, "."))
+	}
+
+	for i := range skeleton {
+		edited(i, i+1)
+		if i+1 < len(skeleton) && skeleton[i] != skeleton[i+1] {
+			edited(i, i+2, skeleton[i+1], skeleton[i])
+		}
+		for _, l := range skeletonLetters {
+			if l != skeleton[i] {
+				edited(i, i+1, l)
+			}
+		}
+	}
+	for i := 0; i <= len(skeleton); i++ {
+		for _, l := range skeletonLetters {
+			edited(i, i, l)
+		}
+	}
+
+	return keys
+}
+
+// isSuffixChain checks whether letters are written as at most depth suffixes
+func isSuffixChain(letters []string, depth int) bool {
+	if depth == 0 || len(letters) == 0 {
+		return false
+	}
+	for i := 1; i <= len(letters); i++ {
+		if _, exists := visencSuffixes[strings.Join(letters[:i], "")]; exists {
+			if i == len(letters) || isSuffixChain(letters[i:], depth-1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// KnownSpelling checks whether a visenc word is written as a root, with letter variants or suffixes.
+// Diacritics and joiners are ignored.
+func KnownSpelling(visenc string) bool {
+	if rootSet == nil {
+		return false
+	}
+	if key, _ := variantTable.VariantKey(visenc, false); len(letterVariantIndex.Lookup(key)) > 0 {
+		return true
+	}
+	if key, _ := variantTable.VariantKey(visenc, true); len(variantIndex.Lookup(key)) > 0 {
+		return true
+	}
+	return len(LemmatizeVisenc(visencSearchKey(SearchKey(visenc)), 1)) > 0
+}
+
+// letterDistance returns the distance of root letters from the letters of a word, or its first letters before suffixes
+type letterDistance func(word []string, root []string) float64
+
+// spellingCandidates returns the roots at most maxDistance away from letters with their distances
+func spellingCandidates(letters []string, distance letterDistance, maxDistance float64) map[*Root]float64 {
+	candidates := make(map[*Root]float64)
+	for _, key := range skeletonNeighbors(letters) {
+		for _, r := range rootsFromIndices(spellingIndex.Lookup(key)) {
+			if _, exists := candidates[r]; exists {
+				continue
+			}
+			if d := distance(letters, spellingLetters(r.Ottoman.Visenc)); d <= maxDistance {
+				candidates[r] = d
+			}
+		}
+	}
+	return candidates
+}
+
+// SuggestSpellings returns at most k dictionary spellings close to a misspelled visenc word, the closest first.
+// When the end of the word is written as suffixes, the roots close to the rest are suggested with the same suffixes.
+func SuggestSpellings(visenc string, k int) []*SpellSuggestion {
+	return suggestSpellings(visenc, k, confusionTable.Distance, maxSpellDistance)
+}
+
+// suggestSpellings returns at most k dictionary spellings at most maxDistance away from a visenc word, except the word itself
+func suggestSpellings(visenc string, k int, distance letterDistance, maxDistance float64) []*SpellSuggestion {
+	if k <= 0 {
+		k = MAXSUGGESTIONS
+	}
+	suggestions := make([]*SpellSuggestion, 0)
+	if spellingIndex == nil {
+		return suggestions
+	}
+
+	letters := spellingLetters(visenc)
+	// words are made for the suggestions returned, as there are many candidates
+	type candidate struct {
+		root     *Root
+		distance float64
+		visenc   string
+		// suggestions with suffixes are checked when they're returned, as most of them are not words
+		suffixed bool
+	}
+	candidates := make([]candidate, 0)
+	add := func(stem []string, suffixes []string) {
+		for r, d := range spellingCandidates(stem, distance, maxDistance) {
+			if d == 0 && len(suffixes) == 0 {
+				continue
+			}
+			candidates = append(candidates, candidate{root: r, distance: d, visenc: SearchKey(RemoveJoiners(r.Ottoman.Visenc)) + strings.Join(suffixes, ""), suffixed: len(suffixes) > 0})
+		}
+	}
+
+	add(letters, nil)
+	// a root has at least two letters
+	for i := 2; i < len(letters); i++ {
+		if isSuffixChain(letters[i:], MAXSUFFIXCHAIN) {
+			add(letters[:i], letters[i:])
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ci, cj := candidates[i], candidates[j]
+		if ci.distance != cj.distance {
+			return ci.distance < cj.distance
+		}
+		if fi, fj := wordFrequency(ci.root.TurkishLatin), wordFrequency(cj.root.TurkishLatin); fi != fj {
+			return fi > fj
+		}
+		if si, sj := len(ci.root.Sources), len(cj.root.Sources); si != sj {
+			return si > sj
+		}
+		if li, lj := len(ci.visenc), len(cj.visenc); li != lj {
+			return li < lj
+		}
+		return ci.visenc < cj.visenc
+	})
+
+	seen := make(map[string]bool)
+	for _, c := range candidates {
+		if len(suggestions) == k {
+			break
+		}
+		if seen[c.visenc] || c.visenc == visencSearchKey(SearchKey(visenc)) {
+			continue
+		}
+		seen[c.visenc] = true
+		if c.suffixed && !KnownSpelling(c.visenc) {
+			continue
+		}
+		if ow, err := MakeOttomanWord(c.visenc, ""); err == nil {
+			suggestions = append(suggestions, &SpellSuggestion{Ottoman: ow, Root: c.root, Distance: c.distance})
+		}
+	}
+	return suggestions
+}
+
+// isWordRune checks whether r is written in an Ottoman word: Arabic letters, marks, tatweel and joiners
+func isWordRune(r rune) bool {
+	return (unicode.In(r, unicode.Arabic) && (unicode.IsLetter(r) || unicode.IsMark(r))) || r == '\u200C' || r == '\u200D'
+}
+
+// ottomanWords returns the byte offsets of the words written in Arabic script in text
+func ottomanWords(text string) [][2]int {
+	words := make([][2]int, 0)
+	start := -1
+	for i, r := range text {
+		switch {
+		case isWordRune(r) && start < 0:
+			start = i
+		case !isWordRune(r) && start >= 0:
+			words = append(words, [2]int{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, [2]int{start, len(text)})
+	}
+	return words
+}
+
+// localizeSuggestions writes suggestions in the script of p
+func (p *ScriptProfile) localizeSuggestions(suggestions []*SpellSuggestion) []*SpellSuggestion {
+	out := make([]*SpellSuggestion, len(suggestions))
+	for i, s := range suggestions {
+		ow, _ := p.MakeOttomanWord(s.Ottoman.Visenc, "")
+		out[i] = &SpellSuggestion{Ottoman: ow, Root: p.Localize(s.Root), Distance: s.Distance}
+	}
+	return out
+}
+
+// CheckSpelling checks the words of a text written in the script of p and returns those not in the dictionary
+// with at most k suggestions each
+func (p *ScriptProfile) CheckSpelling(text string, k int) *SpellCheckResponse {
+	out := SpellCheckResponse{Misspellings: make([]*Misspelling, 0)}
+	// words are checked once, suggestions of a word repeated in the text are shared
+	checked := make(map[string][]*SpellSuggestion)
+
+	for _, w := range ottomanWords(text) {
+		word := text[w[0]:w[1]]
+		visenc := p.UnicodeToVisenc(word)
+		if len(spellingLetters(visenc)) == 0 {
+			continue
+		}
+		out.WordCount++
+
+		suggestions, exists := checked[visenc]
+		if !exists {
+			if KnownSpelling(visenc) {
+				suggestions = nil
+			} else {
+				suggestions = p.localizeSuggestions(SuggestSpellings(visenc, k))
+			}
+			checked[visenc] = suggestions
+		}
+
+		if suggestions != nil {
+			out.Misspellings = append(out.Misspellings, &Misspelling{Word: word, Start: int32(w[0]), End: int32(w[1]), Suggestions: suggestions})
+		}
+	}
+
+	return &out
+}
+
+// CheckSpelling answers a spell check request, the text and the suggestions are written in the requested script
+func CheckSpelling(in *SpellCheckRequest) *SpellCheckResponse {
+	return GetScriptProfile(in.Script).CheckSpelling(in.Text, int(in.SuggestionLimit))
+}
@@ -0,0 +1,101 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// func ParseConfusions(reader io.Reader) (*ConfusionTable, error) {
+func TestParseConfusions(t *testing.T) {
+	badTables := map[string]string{
+		"unknown kind":  "swap,bu1 bu3,0.5,b p\n",
+		"not a letter":  "substitute,bu1 qq,0.5,b q\n",
+		"bad cost":      "substitute,bu1 bu3,cheap,b p\n",
+		"cost above 1":  "substitute,bu1 bu3,1.5,b p\n",
+		"zero cost":     "insert,e,0,elif\n",
+		"missing field": "insert,e,0.5\n",
+	}
+
+	for i, o := range badTables {
+		if _, err := ParseConfusions(strings.NewReader(o)); err == nil {
+			t.Log(fmt.Sprintf("%s should fail ParseConfusions", i))
+			t.Fail()
+		}
+	}
+}
+
+// func (ct *ConfusionTable) Distance(a []string, b []string) float64 {
+func TestDistance(t *testing.T) {
+	testDict := map[[2]string]float64{
+		{"kbo2ebu1", "kbo2ebu1"}: 0,
+		// dots are misplaced more often than letters are mistaken
+		{"kbo2ebu1", "kbo1ebu1"}: 0.5,
+		// te and tı are read the same
+		{"kbo2ebu1", "ktebu1"}: 0.7,
+		// short vowels may be written
+		{"kbo2ebu1", "kbo2eebu1"}: 0.6,
+		{"kbo2ebu1", "klm"}:       2.6,
+	}
+
+	for i, o := range testDict {
+		d := confusionTable.Distance(spellingLetters(i[0]), spellingLetters(i[1]))
+		if d < o-0.001 || d > o+0.001 {
+			t.Log(fmt.Sprintf("Distance between %s and %s should be %.2f: %.2f", i[0], i[1], o, d))
+			t.Fail()
+		}
+	}
+}
+
+// func ottomanWords(text string) [][2]int {
+func TestOttomanWords(t *testing.T) {
+	testDict := map[string][]string{
+		"کتاب، قلم":         {"کتاب", "قلم"},
+		"(شهر) 1923 city":   {"شهر"},
+		"کتاب\u200cلر خانه": {"کتاب\u200cلر", "خانه"},
+		"hiç Ottoman words": {},
+	}
+
+	for i, o := range testDict {
+		words := ottomanWords(i)
+		if len(words) != len(o) {
+			t.Log(fmt.Sprintf("%s should have %d words: %v", i, len(o), words))
+			t.Fail()
+			continue
+		}
+		for j, w := range words {
+			if i[w[0]:w[1]] != o[j] {
+				t.Log(fmt.Sprintf("Word %d of %s should be %s: %s", j, i, o[j], i[w[0]:w[1]]))
+				t.Fail()
+			}
+		}
+	}
+}
+
+// func (p *ScriptProfile) CheckSpelling(text string, k int) *SpellCheckResponse {
+func TestCheckSpelling(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+	InitLemmatizer(SUFFIXSETFILE)
+
+	text := "بو کطاب کتابلرده یوق، کطاب"
+	out := CheckSpelling(&SpellCheckRequest{Text: text, SuggestionLimit: 3})
+
+	if out.WordCount != 5 {
+		t.Log(fmt.Sprintf("%s should have 5 words: %d", text, out.WordCount))
+		t.Fail()
+	}
+	if len(out.Misspellings) != 2 {
+		t.Log(fmt.Sprintf("Only کطاب should be misspelled twice: %v", out.Misspellings))
+		t.FailNow()
+	}
+	for _, m := range out.Misspellings {
+		if text[m.Start:m.End] != "کطاب" || m.Word != "کطاب" {
+			t.Log(fmt.Sprintf("Misspelling should be at the offsets of کطاب: %s %d %d", m.Word, m.Start, m.End))
+			t.Fail()
+		}
+		if len(m.Suggestions) == 0 || len(m.Suggestions) > 3 || m.Suggestions[0].Ottoman.Unicode != "کتاب" || m.Suggestions[0].Root == nil {
+			t.Log(fmt.Sprintf("First suggestion for کطاب should be کتاب: %v", m.Suggestions))
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package lang
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// MAXREADINGS is the maximum number of readings considered for a single word
+const MAXREADINGS = 10
+
+// TurkishLatinReading returns the Turkish Latin form of a translation word with its suffixes
+func TurkishLatinReading(tw *TranslationWord) string {
+	if tw.Root == nil {
+		return tw.Remaining
+	}
+
+	var sb strings.Builder
+	sb.WriteString(TurkishLower(tw.Root.TurkishLatin))
+	for _, s := range tw.Suffixes {
+		sb.WriteString(strings.TrimPrefix(s.TurkishLatin, "'"))
+	}
+	return sb.String()
+}
+
+// ottomanReadings returns roots of an Ottoman word, including those found by stripping suffixes
+func ottomanReadings(word string, maxLen int) []*TranslationWord {
+	readings := make([]*TranslationWord, 0)
+	seen := make(map[*Root]bool)
+
+	for _, r := range PrefixSearchUnicodeExact(word) {
+		if len(readings) < maxLen && !seen[r] {
+			seen[r] = true
+			readings = append(readings, &TranslationWord{Root: r, Direction: TranslationDirection_otm2tr})
+		}
+	}
+
+	// singulars found through plurals are not readings of the word
+	for _, sr := range LemmatizeUnicode(word, maxLen) {
+		if len(readings) < maxLen && !seen[sr.Root] && sr.Relation == nil {
+			seen[sr.Root] = true
+			readings = append(readings, &TranslationWord{Root: sr.Root, Suffixes: sr.Suffixes, Direction: TranslationDirection_otm2tr})
+		}
+	}
+
+	if len(readings) == 0 {
+		ow := OttomanWord{Unicode: word, Visenc: UnicodeToVisenc(word)}
+		readings = append(readings, &TranslationWord{OttomanRemaining: &ow, Direction: TranslationDirection_otm2tr})
+	}
+
+	return readings
+}
+
+// turkishLatinReadings returns roots of a Turkish Latin word, including those found by stripping suffixes
+func turkishLatinReadings(word string, maxLen int) []*TranslationWord {
+	readings := make([]*TranslationWord, 0)
+	seen := make(map[*Root]bool)
+
+	for _, sr := range LemmatizeTurkishLatin(word, maxLen) {
+		if !seen[sr.Root] && sr.Relation == nil {
+			seen[sr.Root] = true
+			readings = append(readings, &TranslationWord{Root: sr.Root, Suffixes: sr.Suffixes, Direction: TranslationDirection_tr2otm})
+		}
+	}
+
+	if len(readings) == 0 {
+		readings = append(readings, &TranslationWord{Remaining: word, Direction: TranslationDirection_tr2otm})
+	}
+
+	return readings
+}
+
+func logSumExp(values []float64) float64 {
+	max := math.Inf(-1)
+	for _, v := range values {
+		if v > max {
+			max = v
+		}
+	}
+	if math.IsInf(max, -1) {
+		return max
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += math.Exp(v - max)
+	}
+	return max + math.Log(sum)
+}
+
+// RankReadings sets the probability of every reading in the sentence using the language model.
+// The most likely sequence found by Viterbi decoding is moved to the front of each variety,
+// other readings follow by their probability. Probabilities are marginals computed by the forward-backward algorithm.
+// Without a language model, readings keep their order and are equally likely.
+func RankReadings(lm *LanguageModel, sentence []*TranslationVariety) {
+	if len(sentence) == 0 {
+		return
+	}
+
+	if lm == nil {
+		for _, tv := range sentence {
+			for _, tw := range tv.Varieties {
+				tw.Probability = 1.0 / float64(len(tv.Varieties))
+			}
+		}
+		return
+	}
+
+	words := make([][]string, len(sentence))
+	for i, tv := range sentence {
+		words[i] = make([]string, len(tv.Varieties))
+		for j, tw := range tv.Varieties {
+			words[i][j] = TurkishLatinReading(tw)
+		}
+	}
+
+	transition := func(prev string, word string) float64 {
+		return math.Log(lm.WordProbability([]string{prev}, word))
+	}
+
+	n := len(sentence)
+	viterbi := make([][]float64, n)
+	backpointer := make([][]int, n)
+	alpha := make([][]float64, n)
+
+	for i := range sentence {
+		viterbi[i] = make([]float64, len(words[i]))
+		backpointer[i] = make([]int, len(words[i]))
+		alpha[i] = make([]float64, len(words[i]))
+
+		for j, w := range words[i] {
+			if i == 0 {
+				viterbi[i][j] = transition(SentenceStart, w)
+				alpha[i][j] = viterbi[i][j]
+				continue
+			}
+			best := math.Inf(-1)
+			sums := make([]float64, len(words[i-1]))
+			for k, prev := range words[i-1] {
+				t := transition(prev, w)
+				if v := viterbi[i-1][k] + t; v > best {
+					best = v
+					backpointer[i][j] = k
+				}
+				sums[k] = alpha[i-1][k] + t
+			}
+			viterbi[i][j] = best
+			alpha[i][j] = logSumExp(sums)
+		}
+	}
+
+	beta := make([][]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		beta[i] = make([]float64, len(words[i]))
+		for j, w := range words[i] {
+			if i == n-1 {
+				beta[i][j] = transition(w, SentenceEnd)
+				continue
+			}
+			sums := make([]float64, len(words[i+1]))
+			for k, next := range words[i+1] {
+				sums[k] = transition(w, next) + beta[i+1][k]
+			}
+			beta[i][j] = logSumExp(sums)
+		}
+	}
+
+	finals := make([]float64, len(words[n-1]))
+	bestLast := 0
+	for j := range words[n-1] {
+		finals[j] = alpha[n-1][j] + beta[n-1][j]
+		if viterbi[n-1][j]+beta[n-1][j] > viterbi[n-1][bestLast]+beta[n-1][bestLast] {
+			bestLast = j
+		}
+	}
+	logZ := logSumExp(finals)
+
+	path := make([]int, n)
+	path[n-1] = bestLast
+	for i := n - 1; i > 0; i-- {
+		path[i-1] = backpointer[i][path[i]]
+	}
+
+	for i, tv := range sentence {
+		for j, tw := range tv.Varieties {
+			tw.Probability = math.Exp(alpha[i][j] + beta[i][j] - logZ)
+		}
+		best := tv.Varieties[path[i]]
+		sort.SliceStable(tv.Varieties, func(a, b int) bool {
+			if tv.Varieties[a] == best || tv.Varieties[b] == best {
+				return tv.Varieties[a] == best
+			}
+			return tv.Varieties[a].Probability > tv.Varieties[b].Probability
+		})
+	}
+}
+
+func translate(text string, direction TranslationDirection, readings func(string, int) []*TranslationWord) []*TranslationSentence {
+	sentences := make([]*TranslationSentence, 0)
+
+	for _, words := range Tokenize(text) {
+		varieties := make([]*TranslationVariety, len(words))
+		for i, w := range words {
+			varieties[i] = &TranslationVariety{Varieties: readings(w, MAXREADINGS), Direction: direction}
+		}
+		RankReadings(languageModel, varieties)
+		sentences = append(sentences, &TranslationSentence{Words: varieties, Direction: direction})
+	}
+
+	return sentences
+}
+
+// TranslateOttoman returns the Turkish Latin readings of every word in an Ottoman text ranked in their context
+func TranslateOttoman(text string) []*TranslationSentence {
+	return translate(text, TranslationDirection_otm2tr, ottomanReadings)
+}
+
+// TranslateTurkishLatin returns the Ottoman spellings of every word in a Turkish Latin text
+func TranslateTurkishLatin(text string) []*TranslationSentence {
+	return translate(text, TranslationDirection_tr2otm, turkishLatinReadings)
+}
@@ -0,0 +1,91 @@
+package lang
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// func TrainNGramModel(corpusdir string, wordOrder int, charOrder int) (*NGramModel, error) {
+func TestTrainNGramModel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dervaze-corpus")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	corpus := "Ben eve gittim. Sen eve geldin.\nO eve geldi. Kapı açık."
+	if err := ioutil.WriteFile(filepath.Join(dir, "corpus.txt"), []byte(corpus), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	model, err := TrainNGramModel(dir, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	countDict := map[string]int64{
+		"eve":        3,
+		"<s> ben":    1,
+		"eve geldi":  1,
+		"açık </s>":  1,
+		"eve gittim": 1,
+	}
+
+	for g, c := range countDict {
+		if model.WordCounts[g] != c {
+			t.Log(fmt.Sprintf("%s count %d != %d", g, model.WordCounts[g], c))
+			t.Fail()
+		}
+	}
+
+	lm := NewLanguageModel(model)
+	if lm.WordProbability([]string{"ben"}, "eve") <= lm.WordProbability([]string{"ben"}, "ava") {
+		t.Log("Seen bigram should be more likely than an unseen word")
+		t.Fail()
+	}
+
+	if lm.CharProbability("eve") <= lm.CharProbability("xqw") {
+		t.Log("Word with seen characters should be more likely")
+		t.Fail()
+	}
+}
+
+// func RankReadings(lm *LanguageModel, sentence []*TranslationVariety) {
+func TestRankReadings(t *testing.T) {
+	model := &NGramModel{WordOrder: 2, CharOrder: 3}
+	for _, s := range Tokenize("ben eve gittim. sen eve geldin. o eve geldi. ava gittim") {
+		model.AddSentence(s)
+	}
+	lm := NewLanguageModel(model)
+
+	variety := func(readings ...string) *TranslationVariety {
+		tv := TranslationVariety{}
+		for _, r := range readings {
+			tv.Varieties = append(tv.Varieties, &TranslationWord{Remaining: r})
+		}
+		return &tv
+	}
+
+	sentence := []*TranslationVariety{variety("ben"), variety("ava", "eve"), variety("geldi", "gildi")}
+	RankReadings(lm, sentence)
+
+	expected := []string{"ben", "eve", "geldi"}
+	for i, tv := range sentence {
+		if got := TurkishLatinReading(tv.Varieties[0]); got != expected[i] {
+			t.Log(fmt.Sprintf("Word %d: %s != %s", i, got, expected[i]))
+			t.Fail()
+		}
+		total := 0.0
+		for _, tw := range tv.Varieties {
+			total += tw.Probability
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Log(fmt.Sprintf("Word %d probabilities sum to %f", i, total))
+			t.Fail()
+		}
+	}
+}
@@ -13,6 +13,19 @@ func CompareStringSlices(slice1, slice2 []string) bool {
 	return true
 }
 
+// CompareInt32Slices compares two int32 slices and returns true if both are identical
+func CompareInt32Slices(slice1, slice2 []int32) bool {
+	if len(slice1) != len(slice2) {
+		return false
+	}
+	for i, v := range slice1 {
+		if slice2[i] != v {
+			return false
+		}
+	}
+	return true
+}
+
 // TFstring returns ifTrue or ifFalse according to condition
 func TFstring(condition bool, ifTrue, ifFalse string) string {
 	if condition {
@@ -28,3 +41,11 @@ func TFint(condition bool, ifTrue, ifFalse int) int {
 	}
 	return ifFalse
 }
+
+// TFrune returns ifTrue or ifFalse according to condition
+func TFrune(condition bool, ifTrue, ifFalse rune) rune {
+	if condition {
+		return ifTrue
+	}
+	return ifFalse
+}
@@ -0,0 +1,322 @@
+package lang
+
+import (
+	_ "embed" // default variant table is embedded
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Kinds of variant rules
+const (
+	VariantLetter  = "letter"
+	VariantPattern = "pattern"
+)
+
+//go:embed data/variants.csv
+var defaultVariantsData string
+
+// VariantRule is a letter equivalence or a spelling alternation between Ottoman spellings
+type VariantRule struct {
+	Kind    string
+	Name    string
+	From    string
+	To      string
+	Pattern *regexp.Regexp
+}
+
+// VariantTable keeps letter equivalences and pattern alternations in file order
+type VariantTable struct {
+	letters  map[string]*VariantRule
+	patterns []*VariantRule
+}
+
+var variantTable = MustParseVariants(strings.NewReader(defaultVariantsData))
+
+// unicodeVariantLetters maps Unicode letters to the letters their variants are written with in Unicode search keys
+var unicodeVariantLetters = variantTable.unicodeLetters()
+
+var variantIndex *KeyIndex
+var letterVariantIndex *KeyIndex
+
+// ParseVariants reads a variant table in CSV format. Lines beginning with # are comments.
+func ParseVariants(reader io.Reader) (*VariantTable, error) {
+	csvr := csv.NewReader(reader)
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 4
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	vt := VariantTable{letters: make(map[string]*VariantRule), patterns: make([]*VariantRule, 0)}
+	names := make(map[string]bool)
+
+	for i, record := range records {
+		if i == 0 && record[0] == "kind" {
+			continue
+		}
+
+		rule := VariantRule{Kind: strings.TrimSpace(record[0]), Name: strings.TrimSpace(record[1]), From: record[2], To: record[3]}
+
+		if names[rule.Name] {
+			return nil, fmt.Errorf("Variant %s is defined more than once", rule.Name)
+		}
+		names[rule.Name] = true
+
+		switch rule.Kind {
+		case VariantLetter:
+			if _, exists := VisencToUnicodeMap[rule.From]; !exists {
+				return nil, fmt.Errorf("Variant %s: %s is not a visenc letter", rule.Name, rule.From)
+			}
+			vt.letters[rule.From] = &rule
+		case VariantPattern:
+			if rule.Pattern, err = regexp.Compile(rule.From); err != nil {
+				return nil, fmt.Errorf("Variant %s: %s", rule.Name, err)
+			}
+			vt.patterns = append(vt.patterns, &rule)
+		default:
+			return nil, fmt.Errorf("Variant %s: unknown kind %s", rule.Name, rule.Kind)
+		}
+	}
+
+	return &vt, nil
+}
+
+// MustParseVariants is like ParseVariants but panics if the table cannot be parsed
+func MustParseVariants(reader io.Reader) *VariantTable {
+	vt, err := ParseVariants(reader)
+	if err != nil {
+		panic(err)
+	}
+	return vt
+}
+
+// LoadVariants reads a variant table file and makes it the table used by the package.
+// It should be called before InitSearch as the table is used to build the variant index.
+func LoadVariants(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	vt, err := ParseVariants(file)
+	if err != nil {
+		return err
+	}
+
+	variantTable = vt
+	return nil
+}
+
+// InitVariants replaces the embedded variant table with a file, if filename is not empty.
+// If the file cannot be loaded, the embedded table is used.
+func InitVariants(filename string) {
+	if filename == "" {
+		return
+	}
+	if err := LoadVariants(filename); err != nil {
+		log.Printf("Variant table is not loaded: %s", err)
+	}
+}
+
+// VariantKey returns the key used to compare Ottoman spellings and the names of rules that changed the word.
+// When wholeWord is false, only letter equivalences are applied so the key can be used for prefix search.
+func (vt *VariantTable) VariantKey(visenc string, wholeWord bool) (string, []string) {
+	fired := make([]string, 0)
+	seen := make(map[string]bool)
+
+	var sb strings.Builder
+	sb.WriteString(".")
+	for _, g := range letterGroups(SearchKey(visenc)) {
+		if rule, exists := vt.letters[g]; exists {
+			g = rule.To
+			if !seen[rule.Name] {
+				seen[rule.Name] = true
+				fired = append(fired, rule.Name)
+			}
+		}
+		sb.WriteString(g)
+		sb.WriteString(".")
+	}
+	key := sb.String()
+
+	if !wholeWord {
+		return key, fired
+	}
+
+	for _, rule := range vt.patterns {
+		// apply until nothing changes, as matches of a pattern may overlap
+		for {
+			replaced := rule.Pattern.ReplaceAllString(key, rule.To)
+			if replaced == key {
+				break
+			}
+			key = replaced
+			if !seen[rule.Name] {
+				seen[rule.Name] = true
+				fired = append(fired, rule.Name)
+			}
+		}
+	}
+
+	return key, fired
+}
+
+// unicodeLetters returns the Unicode letters written as other letters in search keys: letters read as a visenc
+// written with another letter, like ك read as k, and letters of letter rules, like ي and ڭ
+func (vt *VariantTable) unicodeLetters() map[rune]rune {
+	letters := make(map[rune]rune)
+	add := func(from string, visenc string) {
+		f, to := []rune(from), []rune(VisencToUnicodeMap[visenc])
+		if len(f) == 1 && len(to) == 1 && f[0] != to[0] {
+			letters[f[0]] = to[0]
+		}
+	}
+	for u, v := range UnicodeToVisencMap {
+		add(u, v)
+	}
+	for _, rule := range vt.letters {
+		add(VisencToUnicodeMap[rule.From], rule.To)
+	}
+
+	// a letter may be written as a letter that is written as another
+	for from, to := range letters {
+		for i := 0; i < len(letters); i++ {
+			next, exists := letters[to]
+			if !exists || next == from {
+				break
+			}
+			to = next
+		}
+		letters[from] = to
+	}
+	return letters
+}
+
+// replaceVariantLetters writes the letters of s as they are written in Unicode search keys
+func replaceVariantLetters(s string) string {
+	return strings.Map(func(r rune) rune {
+		if to, exists := unicodeVariantLetters[r]; exists {
+			return to
+		}
+		return r
+	}, s)
+}
+
+// letterGroups splits visenc into letter groups, leaving out zero width non-joiners
+func letterGroups(visenc string) []string {
+	groups := make([]string, 0)
+	for _, g := range SplitVisenc(visenc, false) {
+		if g != "||" {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
+
+func buildVariantIndices(roots []*Root) {
+	variantIndex = BuildKeyIndex(roots, func(r *Root) string {
+		key, _ := variantTable.VariantKey(r.Ottoman.GetVisenc(), true)
+		return key
+	})
+	letterVariantIndex = BuildKeyIndex(roots, func(r *Root) string {
+		key, _ := variantTable.VariantKey(r.Ottoman.GetVisenc(), false)
+		return key
+	})
+}
+
+// matchedVariants returns the rules fired for only one of the query and the root
+func matchedVariants(queryRules []string, rootVisenc string, wholeWord bool) []string {
+	_, rootRules := variantTable.VariantKey(rootVisenc, wholeWord)
+
+	inQuery := make(map[string]bool)
+	for _, r := range queryRules {
+		inQuery[r] = true
+	}
+	inRoot := make(map[string]bool)
+	for _, r := range rootRules {
+		inRoot[r] = true
+	}
+
+	variants := make([]string, 0)
+	for _, r := range queryRules {
+		if !inRoot[r] {
+			variants = append(variants, r)
+		}
+	}
+	for _, r := range rootRules {
+		if !inQuery[r] {
+			variants = append(variants, r)
+		}
+	}
+	return variants
+}
+
+func variantResults(visenc string, maxLen int, wholeWord bool) []*SearchResult {
+	key, queryRules := variantTable.VariantKey(visenc, wholeWord)
+	queryGroups := letterGroups(visenc)
+	query := strings.Join(queryGroups, "")
+
+	var indices []int32
+	if wholeWord {
+		indices = variantIndex.Lookup(key)
+	} else {
+		indices = make([]int32, 0)
+		letterVariantIndex.VisitPrefix(key, func(i int32) {
+			if len(indices) < maxLen*10 {
+				indices = append(indices, i)
+			}
+		})
+	}
+
+	exact := make([]*SearchResult, 0)
+	variants := make([]*SearchResult, 0)
+	for _, r := range rootsFromIndices(indices) {
+		groups := letterGroups(r.Ottoman.GetVisenc())
+		// only the part of the root matching the prefix is compared
+		if !wholeWord && len(groups) > len(queryGroups) {
+			groups = groups[:len(queryGroups)]
+		}
+		rootVisenc := strings.Join(groups, "")
+		if rootVisenc == query {
+			exact = append(exact, &SearchResult{Root: r})
+			continue
+		}
+		variants = append(variants, &SearchResult{Root: r, Variants: matchedVariants(queryRules, rootVisenc, wholeWord)})
+	}
+
+	results := append(exact, variants...)
+	if len(results) > maxLen {
+		return results[:maxLen]
+	}
+	return results
+}
+
+// VariantSearchVisenc returns roots whose spelling is visenc or one of its orthographic variants.
+// Exact matches come first, variant matches list the variants that differ. Unicode searches find letter variants
+// too, variant searches report them.
+func VariantSearchVisenc(visenc string, maxLen int) []*SearchResult {
+	return variantResults(visenc, maxLen, true)
+}
+
+// VariantSearchUnicode returns roots whose spelling is an orthographic variant of an Ottoman word
+func VariantSearchUnicode(unicode string, maxLen int) []*SearchResult {
+	return VariantSearchVisenc(UnicodeToVisenc(unicode), maxLen)
+}
+
+// VariantPrefixSearchVisenc returns roots starting with visenc after letter equivalences are applied
+func VariantPrefixSearchVisenc(visenc string, maxLen int) []*SearchResult {
+	return variantResults(visenc, maxLen, false)
+}
+
+// VariantPrefixSearchUnicode returns roots starting with an Ottoman prefix after letter equivalences are applied
+func VariantPrefixSearchUnicode(unicode string, maxLen int) []*SearchResult {
+	return VariantPrefixSearchVisenc(UnicodeToVisenc(unicode), maxLen)
+}
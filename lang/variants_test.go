@@ -0,0 +1,127 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// func (vt *VariantTable) VariantKey(visenc string, wholeWord bool) (string, []string) {
+func TestVariantKey(t *testing.T) {
+	equivalents := map[string]string{
+		"bu2kbu3erh":  "ykbu3erh",
+		"bu2kbu3ere":  "ykbu3erh",
+		"kwrko3":      "krk",
+		"ykbu3erh||":  "yk||bu3erh",
+		"bu2o4ko0bu3": "ykbu3",
+	}
+
+	for i, o := range equivalents {
+		ki, _ := variantTable.VariantKey(i, true)
+		ko, _ := variantTable.VariantKey(o, true)
+		if ki != ko {
+			t.Log(fmt.Sprintf("%s and %s should have the same variant key: %s != %s", i, o, ki, ko))
+			t.Fail()
+		}
+	}
+
+	different := map[string]string{
+		"wzl e": "wzlh",
+		"bu2k":  "bu2kw",
+	}
+
+	for i, o := range different {
+		ki, _ := variantTable.VariantKey(i, true)
+		ko, _ := variantTable.VariantKey(o, true)
+		if ki == ko {
+			t.Log(fmt.Sprintf("%s and %s should have different variant keys: %s", i, o, ki))
+			t.Fail()
+		}
+	}
+
+	// patterns apply only to whole words
+	if _, fired := variantTable.VariantKey("bu2kbu3ere", false); strings.Join(fired, ",") != "arabic-yeh" {
+		t.Log(fmt.Sprintf("Prefix key of bu2kbu3ere should only use arabic-yeh: %v", fired))
+		t.Fail()
+	}
+}
+
+// func ParseVariants(reader io.Reader) (*VariantTable, error) {
+func TestParseVariants(t *testing.T) {
+	badTables := map[string]string{
+		"unknown kind":   "word,x,bu2,y\n",
+		"duplicate name": "letter,x,bu2,y\nletter,x,ko3,k\n",
+		"bad regex":      "pattern,x,(\\.e,.h.\n",
+		"not a letter":   "letter,x,qq,y\n",
+		"missing field":  "letter,x,bu2\n",
+	}
+
+	for i, o := range badTables {
+		if _, err := ParseVariants(strings.NewReader(o)); err == nil {
+			t.Log(fmt.Sprintf("%s should fail ParseVariants", i))
+			t.Fail()
+		}
+	}
+}
+
+// func VariantSearchUnicode(unicode string, maxLen int) []*SearchResult {
+func TestVariantSearchUnicode(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	// yekpâre is written with Arabic yeh in the dictionary, search with Persian yeh
+	query := VisencToUnicode("ykbu3erh")
+	results := VariantSearchUnicode(query, 20)
+	found := false
+	for _, sr := range results {
+		if sr.Root.Ottoman.Visenc == "bu2kbu3erh" {
+			found = true
+			if strings.Join(sr.Variants, ",") != "arabic-yeh" {
+				t.Log(fmt.Sprintf("Variants of %s: %v", sr.Root.Ottoman.Visenc, sr.Variants))
+				t.Fail()
+			}
+		}
+	}
+	if !found {
+		t.Log(fmt.Sprintf("Variant search for %s should find bu2kbu3erh: %s", query, PrintRoots(ResultRoots(results))))
+		t.Fail()
+	}
+
+	// exact spellings come first
+	if len(results) == 0 || len(results[0].Variants) != 0 {
+		t.Log(fmt.Sprintf("First result of %s should be an exact match", query))
+		t.Fail()
+	}
+}
+
+// func unicodeSearchKey(s string) string {
+func TestUnicodeVariantKeys(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	keys := map[string]string{
+		"كتاب":  "کتاب",
+		"ديوان": "دیوان",
+		"كتابى": "کتابی",
+		"ڭ":     "ک",
+	}
+	for w, e := range keys {
+		if unicodeSearchKey(w) != unicodeSearchKey(e) {
+			t.Log(fmt.Sprintf("%s should have the key of %s: %s", w, e, unicodeSearchKey(w)))
+			t.Fail()
+		}
+	}
+
+	testDict := map[string]string{
+		"كتاب":  "kitap",
+		"ديوان": "divan",
+	}
+	for w, e := range testDict {
+		found := false
+		for _, r := range append(PrefixSearchUnicodeExact(w), FuzzySearchUnicode(w, MAXRESULTLEN)...) {
+			found = found || r.TurkishLatin == e
+		}
+		if !found {
+			t.Log(fmt.Sprintf("%s should find %s: %s", w, e, PrintRoots(PrefixSearchUnicodeExact(w))))
+			t.Fail()
+		}
+	}
+}
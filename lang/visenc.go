@@ -1,12 +1,11 @@
 package lang
 
 import (
-	"errors"
 	"regexp"
 	"strings"
 
 	"log"
-	"unicode/utf8"
+	"unicode"
 
 	"golang.org/x/text/unicode/norm"
 )
@@ -40,114 +39,80 @@ func NewRoot(latin string, visenc string, pos PartOfSpeech) *Root {
 		EffectiveTurkishLatin: latin,
 		EffectiveVisenc:       ow.Visenc,
 		HasConsonantSoftening: false,
+		PhonologyRules:        phonologyRules.Trace(latin),
+		ArabicRoot:            ArabicRoot(ow.Unicode),
 	}
 
-	UpdateEffectiveSoftening(&r)
+	UpdateAlternation(&r)
 
 	return &r
 }
 
 // MakeOttomanWord builds an OttomanWord from either visenc or unicode
 func MakeOttomanWord(visenc string, unicode string) (*OttomanWord, error) {
-	if visenc == "" && unicode == "" {
-		return nil, errors.New("Need either visenc or ottoman")
-	}
-
-	var cleanVisenc string
-	if len(visenc) == 0 {
-		cleanVisenc = UnicodeToVisenc(unicode)
-	} else {
-		cleanVisenc = regexp.MustCompile("[^a-z0-9 |||]+").ReplaceAllLiteralString(visenc, "")
-		if cleanVisenc != visenc {
-			log.Printf("Cleaned Visenc %s -> %s", visenc, cleanVisenc)
-		}
-	}
-
-	var normalized string
+	return ottomanProfile.MakeOttomanWord(visenc, unicode)
+}
 
-	if len(unicode) == 0 {
-		normalized = norm.NFKC.String(VisencToUnicode(cleanVisenc))
-	} else {
-		normalized = norm.NFKC.String(unicode)
-	}
+var searchKeyRegex = regexp.MustCompile(`([oui][0456789]+|[ou]e|oc)`)
 
-	if !utf8.ValidString(normalized) {
-		log.Printf("Invalid UTF-8 for Unicode: %s", normalized)
-	}
-
-	if !utf8.ValidString(cleanVisenc) {
-		log.Printf("Invalid UTF-8 for Visenc: %s", cleanVisenc)
-	}
+// SearchKey removes non letter diacritics from visenc string. Hamza above is removed as both oc and o5.
+func SearchKey(s string) string {
+	return searchKeyRegex.ReplaceAllLiteralString(s, "")
+}
 
-	abjad := VisencToAbjad(cleanVisenc)
+var dotlessSearchKeyRegex = regexp.MustCompile(`([oui][0123456789]+|[ou]e|oc)`)
 
-	searchKey := SearchKey(cleanVisenc)
+// DotlessSearchKey removes all dots and signs from visenc string
+func DotlessSearchKey(s string) string {
+	return dotlessSearchKeyRegex.ReplaceAllLiteralString(s, "")
+}
 
-	dotlessSearchKey := DotlessSearchKey(cleanVisenc)
+var joinerRemover = strings.NewReplacer("||", "", "<>", "", "><", "", "&zwj;", "", "\u200C", "", "\u200D", "")
 
-	return &OttomanWord{
-		Visenc:           cleanVisenc,
-		Unicode:          normalized,
-		Abjad:            abjad,
-		SearchKey:        searchKey,
-		DotlessSearchKey: dotlessSearchKey,
-	}, nil
+// RemoveJoiners removes zero width non-joiners and joiners from a visenc or Unicode string
+func RemoveJoiners(s string) string {
+	return joinerRemover.Replace(s)
 }
 
-// SearchKey removes non letter diacritics from visenc string
-func SearchKey(s string) string {
-	sk := regexp.MustCompile(`([oui][0456789]+)`)
-	return sk.ReplaceAllLiteralString(s, "")
+// isPresentationForm checks whether r is in Arabic Presentation Forms-A or B blocks. Byte order mark U+FEFF is not.
+func isPresentationForm(r rune) bool {
+	return (r >= 0xFB50 && r <= 0xFDFF) || (r >= 0xFE70 && r <= 0xFEFE)
 }
 
-// DotlessSearchKey removes all dots and signs from visenc string
-func DotlessSearchKey(s string) string {
-	sk := regexp.MustCompile(`([oui][0123456789]+)`)
-	return sk.ReplaceAllLiteralString(s, "")
+// NormalizeArabic rewrites presentation forms, like ﻻ or ﷲ, with the letters they show and removes tatweel.
+// Other characters are kept as they are, marks written after letters are not composed with them.
+func NormalizeArabic(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\u0640':
+		case isPresentationForm(r):
+			sb.WriteString(norm.NFKC.String(string(r)))
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
 }
 
 // VisencToUnicode converts a visenc string to unicode representation
 func VisencToUnicode(s string) string {
-	visenc := SplitVisenc(s, false)
-
-	out := ""
-	for _, v := range visenc {
-		out += VisencToUnicodeMap[v]
-	}
-	return out
+	return ottomanProfile.VisencToUnicode(s)
 }
 
 // UnicodeToVisenc converts a unicode string to visenc representation
 func UnicodeToVisenc(s string) string {
-	out := ""
-
-	for _, u := range s {
-		v, exists := UnicodeToVisencMap[string(u)]
-		if exists {
-			out += v
-		}
-	}
-
-	return out
+	return ottomanProfile.UnicodeToVisenc(s)
 }
 
 // VisencToAbjad calculates the abjad value for the given word in Visenc
 func VisencToAbjad(s string) int32 {
-	cleaned := SearchKey(s)
-	visencLetters := SplitVisenc(cleaned, false)
-	var out int32 = 0
-	for _, v := range visencLetters {
-		value, exists := VisencToAbjadMap[v]
-		if exists {
-			out += value
-		}
-	}
-	return out
+	return ottomanProfile.VisencToAbjad(s)
 }
 
 // UnicodeToAbjad calculates the abjad value for a word given in Unicode by converting it to Visenc first
 func UnicodeToAbjad(s string) int32 {
-	return VisencToAbjad(UnicodeToVisenc(s))
+	return ottomanProfile.UnicodeToAbjad(s)
 }
 
 // SplitVisenc splits s and returns letter groups according to visencToUnicode keys
@@ -183,10 +148,10 @@ func SplitVisenc(s string, addInvalidLetters bool) []string {
 	return group
 }
 
-// ContainsArabicChars checks whether the string contains unicode runes between 0600 and 06FF
+// ContainsArabicChars checks whether the string contains unicode runes between 0600 and 06FF or Arabic presentation forms
 func ContainsArabicChars(s string) bool {
 	for _, r := range s {
-		if r >= 0x0600 && r <= 0x06FF {
+		if (r >= 0x0600 && r <= 0x06FF) || isPresentationForm(r) {
 			return true
 		}
 	}
@@ -213,9 +178,65 @@ func HasSingleVowel(s string) bool {
 	return hasSingleVowelRegex.MatchString(s)
 }
 
-// LastConsonantHard checks whether a word has a final "fstkçşhp"
+// SyllableCount returns the number of syllables, i.e. vowels, in a Turkish Latin word
+func SyllableCount(s string) int {
+	return len(vowelRegex.FindAllStringIndex(s, -1))
+}
+
+// TurkishLower converts s to lowercase using Turkish rules for I and İ
+func TurkishLower(s string) string {
+	return strings.ToLower(strings.NewReplacer("I", "ı", "İ", "i").Replace(s))
+}
+
+// Syllabify splits a Turkish Latin word into its syllables. A single consonant between vowels
+// begins the next syllable, in longer clusters only the last consonant does.
+// Characters other than letters, like apostrophes, are dropped.
+func Syllabify(s string) []string {
+	runes := make([]rune, 0, len(s))
+	for _, r := range TurkishLower(s) {
+		if unicode.IsLetter(r) {
+			runes = append(runes, r)
+		}
+	}
+
+	isVowel := func(r rune) bool { return strings.ContainsRune(allVowels, r) }
+
+	vowels := make([]int, 0)
+	for i, r := range runes {
+		if isVowel(r) {
+			vowels = append(vowels, i)
+		}
+	}
+
+	if len(vowels) == 0 {
+		if len(runes) == 0 {
+			return []string{}
+		}
+		return []string{string(runes)}
+	}
+
+	syllables := make([]string, 0, len(vowels))
+	start := 0
+	for k := 0; k < len(vowels)-1; k++ {
+		consonants := vowels[k+1] - vowels[k] - 1
+		end := vowels[k+1]
+		if consonants > 0 {
+			end = vowels[k+1] - 1
+		}
+		syllables = append(syllables, string(runes[start:end]))
+		start = end
+	}
+	syllables = append(syllables, string(runes[start:]))
+
+	return syllables
+}
+
+// LastConsonantHard checks whether a word has a final "fstkçşhp" using the last_consonant_hard rules
 func LastConsonantHard(s string) bool {
-	return lastConsonantHardRegex.MatchString(s)
+	if rule := phonologyRules.FirstMatch(RuleLastConsonantHard, s, ""); rule != nil {
+		return rule.LatinResult == "true"
+	}
+	return false
 }
 
 // LastVowelHard checks whether a word ends with one of aıou
@@ -227,12 +248,10 @@ func LastVowelHard(s string) bool {
 	return false
 }
 
-// EffectiveLastVowel checks a word agains effectiveLastVowelRegexes to determine the vowel that governs vowel harmonization rules
+// EffectiveLastVowel checks a word against effective_last_vowel rules to determine the vowel that governs vowel harmonization rules
 func EffectiveLastVowel(s string) string {
-	for r, v := range effectiveLastVowelRegexes {
-		if r.MatchString(s) {
-			return v
-		}
+	if rule := phonologyRules.FirstMatch(RuleEffectiveLastVowel, s, ""); rule != nil {
+		return rule.LatinResult
 	}
 	return LastVowel(s)
 }
@@ -255,39 +274,20 @@ func LastConsonant(s string) string {
 	return ""
 }
 
-// UpdateEffectiveSoftening updates the EffectiveTurkishLatin, EffectiveVisenc and HasConsonantSoftening by checking suffixes for spelling
+// UpdateEffectiveSoftening updates the EffectiveTurkishLatin, EffectiveVisenc and HasConsonantSoftening by applying the first matching softening rule
 func UpdateEffectiveSoftening(r *Root) {
 
-	if strings.HasSuffix(r.TurkishLatin, "k") &&
-		strings.HasSuffix(r.Ottoman.Visenc, "fo2") {
-		tlr := []rune(r.TurkishLatin)
-		tll := len(tlr)
-
-		r.EffectiveTurkishLatin = string(tlr[0:tll-1]) + "ğ"
-		ovl := len(r.Ottoman.Visenc)
-		r.EffectiveVisenc = r.Ottoman.Visenc[0:ovl-3] + "ao1"
-		r.HasConsonantSoftening = true
+	rule := phonologyRules.FirstMatch(RuleSoftening, r.TurkishLatin, r.Ottoman.Visenc)
+	if rule == nil {
+		return
 	}
 
-	if strings.HasSuffix(r.TurkishLatin, "p") && strings.HasSuffix(r.Ottoman.Visenc, "bu1") {
-		tlr := []rune(r.TurkishLatin)
-		tll := len(tlr)
-		r.EffectiveTurkishLatin = string(tlr[0:tll-1]) + "b"
-		r.HasConsonantSoftening = true
+	if rule.Latin != nil {
+		r.EffectiveTurkishLatin = rule.Latin.ReplaceAllString(r.TurkishLatin, rule.LatinResult)
 	}
-
-	if strings.HasSuffix(r.TurkishLatin, "ç") && strings.HasSuffix(r.Ottoman.Visenc, "xu1") {
-		tlr := []rune(r.TurkishLatin)
-		tll := len(tlr)
-		r.EffectiveTurkishLatin = string(tlr[0:tll-1]) + "c"
-		r.HasConsonantSoftening = true
+	if rule.Visenc != nil && rule.VisencResult != "" {
+		r.EffectiveVisenc = rule.Visenc.ReplaceAllString(r.Ottoman.Visenc, rule.VisencResult)
 	}
-
-	if strings.HasSuffix(r.TurkishLatin, "t") && strings.HasSuffix(r.Ottoman.Visenc, "d") {
-		tlr := []rune(r.TurkishLatin)
-		tll := len(tlr)
-		r.EffectiveTurkishLatin = string(tlr[0:tll-1]) + "d"
-		r.HasConsonantSoftening = true
-	}
-
+	r.HasConsonantSoftening = true
+	r.PhonologyRules = append(r.PhonologyRules, rule.Name)
 }
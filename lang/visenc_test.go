@@ -24,7 +24,11 @@ func TestSearchKey(t *testing.T) {
 		"emrh":         "emrh",
 		"eo5mrh":       "emrh",
 		"eo6mro1h":     "emro1h",
-		"eo5so3ro8bu2": "eso3rbu2"}
+		"eo5so3ro8bu2": "eso3rbu2",
+		"eoedo4ebu1":   "edebu1",
+		"xo1ebo1ho5":   "xo1ebo1h",
+		"xo1ebo1hoc":   "xo1ebo1h",
+		"eoc":          "e"}
 
 	for i, o := range testDict {
 		if SearchKey(i) != o {
@@ -41,7 +45,8 @@ func TestDotlessSearchKey(t *testing.T) {
 		"emrh":         "emrh",
 		"eo5mrh":       "emrh",
 		"eo6mro1h":     "emrh",
-		"eo5so3ro8bu2": "esrb"}
+		"eo5so3ro8bu2": "esrb",
+		"xo1ebo1hoc":   "xebh"}
 
 	for i, o := range testDict {
 		if DotlessSearchKey(i) != o {
@@ -99,6 +104,57 @@ func TestMakeOttomanWord(t *testing.T) {
 	}
 
 	t.Log(ow)
+
+	// Arabic marks in visenc are read as their codes
+	testDict := map[string]string{
+		"eٰdo4ebu1": "eoedo4ebu1",
+		"ao4ed۪y":   "ao4edu0y",
+		"wo4ۀu4":    "wo4hoyu4",
+		"mo4ـbo2u4": "mo4bo2u4",
+	}
+
+	for i, o := range testDict {
+		if ow, err := MakeOttomanWord(i, ""); err != nil || ow.Visenc != o {
+			t.Log(fmt.Sprintf("Visenc of %s should be %s, got %v %v", i, o, ow, err))
+			t.Fail()
+		}
+	}
+}
+
+// func NormalizeArabic(s string) string {
+func TestNormalizeArabic(t *testing.T) {
+	testDict := map[string]string{
+		"ﻗﻠﻌﻪ":       "قلعه",
+		"ﻻ":          "لا",
+		"كـتـاب":     "كتاب",
+		"اِکْرَامِ":  "اِکْرَامِ",
+		"\uFEFFکتاب": "\uFEFFکتاب",
+	}
+
+	for i, o := range testDict {
+		if r := NormalizeArabic(i); r != o {
+			t.Log(fmt.Sprintf("NormalizeArabic(%s) should be %s, got %s", i, o, r))
+			t.Fail()
+		}
+	}
+}
+
+// func RemoveJoiners(s string) string {
+func TestRemoveJoiners(t *testing.T) {
+	testDict := map[string]string{
+		"klmh||lr":     "klmhlr",
+		"fo2lah||":     "fo2lah",
+		"e><b<>&zwj;c": "ebc",
+		"کلمه\u200Cلر": "کلمهلر",
+		"بر\u200Dی":    "بری",
+	}
+
+	for i, o := range testDict {
+		if r := RemoveJoiners(i); r != o {
+			t.Log(fmt.Sprintf("RemoveJoiners(%s) should be %s, got %s", i, o, r))
+			t.Fail()
+		}
+	}
 }
 
 // func VisencToAbjad(s string) int32 {
@@ -180,6 +236,7 @@ func TestContainsArabicChars(t *testing.T) {
 		"so3o4ro0fo2u4 so3u4mo4elbu2": false,
 		"شَرْقِ شِمَالي":              true,
 		"bo1o4to1bu2fo1o0نَظيفْ":      true,
+		"ﻗﻠﻌﻪ":                        true,
 		"eu4ko0ro4emu4 4bu2اِکْرَامِ اِلَهِي": true,
 		"klmh||lr  کلمه‌لر":                   true,
 	}
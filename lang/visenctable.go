@@ -0,0 +1,416 @@
+package lang
+
+import (
+	"crypto/sha256"
+	_ "embed" // default visenc table is embedded
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Directions of letters in the visenc table
+const (
+	DirectionBoth   = "both"
+	DirectionEncode = "encode"
+	DirectionDecode = "decode"
+)
+
+// MAXVISENCLEN is the maximum number of characters in a visenc letter code, SplitVisenc matches at most this many
+const MAXVISENCLEN = 5
+
+//go:embed data/visenc.csv
+var defaultVisencData string
+
+// VisencLetter is a letter of the visenc table
+type VisencLetter struct {
+	// AllScripts is true if the letter is used by all scripts, otherwise it's used only by Script
+	AllScripts bool
+	Script     Script
+	Visenc     string
+	Unicode    string
+	Abjad      int32
+	Direction  string
+	Name       string
+}
+
+// VisencTable is the visenc encoding. Conversions, abjad values and searchable characters of every script are derived from its letters.
+type VisencTable struct {
+	Version  string
+	Checksum string
+	Letters  []*VisencLetter
+	profiles map[Script]*ScriptProfile
+}
+
+var visencTable = MustParseVisencTable(strings.NewReader(defaultVisencData))
+
+var ottomanProfile = visencTable.Profile(Script_OTTOMAN_TURKISH)
+
+// VisencToUnicodeMap is the correspondence table for visenc to unicode
+var VisencToUnicodeMap = ottomanProfile.visencToUnicode
+
+// UnicodeToVisencMap shows correspondence between visenc and unicode
+var UnicodeToVisencMap = ottomanProfile.unicodeToVisenc
+
+// VisencToAbjadMap shows abjad numerals for each visenc
+var VisencToAbjadMap = ottomanProfile.visencToAbjad
+
+// AllSearchableArabic defines the characters accepted for search for Arabic strings
+var AllSearchableArabic = ottomanProfile.Searchable
+
+var visencVersionRegex = regexp.MustCompile(`(?m)^#\s*version:\s*(\S+)\s*$`)
+
+// ParseVisencTable reads a visenc table in CSV format and validates it.
+// The version is given in a comment line like # version: 1
+func ParseVisencTable(reader io.Reader) (*VisencTable, error) {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	m := visencVersionRegex.FindStringSubmatch(string(data))
+	if m == nil {
+		return nil, fmt.Errorf("Visenc table has no version")
+	}
+
+	csvr := csv.NewReader(strings.NewReader(string(data)))
+	csvr.Comment = '#'
+	csvr.FieldsPerRecord = 6
+
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	vt := VisencTable{Version: m[1], Letters: make([]*VisencLetter, 0, len(records))}
+	hash := sha256.New()
+
+	for i, record := range records {
+		if i == 0 && record[0] == "script" {
+			continue
+		}
+
+		letter := VisencLetter{Visenc: record[1], Direction: strings.TrimSpace(record[4]), Name: strings.TrimSpace(record[5])}
+
+		if strings.TrimSpace(record[0]) == "" {
+			letter.AllScripts = true
+		} else if letter.Script, err = ParseScript(record[0]); err != nil {
+			return nil, fmt.Errorf("Visenc %s: %s", letter.Visenc, err)
+		}
+
+		for _, cp := range strings.Fields(record[2]) {
+			r, err := strconv.ParseUint(strings.TrimPrefix(cp, "U+"), 16, 32)
+			if err != nil || !strings.HasPrefix(cp, "U+") {
+				return nil, fmt.Errorf("Visenc %s: %s is not a code point like U+0627", letter.Visenc, cp)
+			}
+			letter.Unicode += string(rune(r))
+		}
+
+		if abjad := strings.TrimSpace(record[3]); abjad != "" {
+			a, err := strconv.Atoi(abjad)
+			if err != nil {
+				return nil, fmt.Errorf("Visenc %s: abjad %s is not a number", letter.Visenc, abjad)
+			}
+			letter.Abjad = int32(a)
+		}
+
+		fmt.Fprintf(hash, "%s|%d|%s|%s|%d|%s\n", record[0], letter.Script, letter.Visenc, letter.Unicode, letter.Abjad, letter.Direction)
+		vt.Letters = append(vt.Letters, &letter)
+	}
+
+	vt.Checksum = hex.EncodeToString(hash.Sum(nil))[:8]
+
+	if err := vt.Validate(); err != nil {
+		return nil, err
+	}
+
+	vt.profiles = make(map[Script]*ScriptProfile)
+	for s := range Script_name {
+		vt.profiles[Script(s)] = vt.buildProfile(Script(s))
+	}
+
+	return &vt, nil
+}
+
+// MustParseVisencTable is like ParseVisencTable but panics if the table cannot be parsed
+func MustParseVisencTable(reader io.Reader) *VisencTable {
+	vt, err := ParseVisencTable(reader)
+	if err != nil {
+		panic(err)
+	}
+	return vt
+}
+
+// ID returns the version and the checksum of the table, e.g. 1-a1b2c3d4
+func (vt *VisencTable) ID() string {
+	return vt.Version + "-" + vt.Checksum
+}
+
+// Profile returns the conversions of a script
+func (vt *VisencTable) Profile(script Script) *ScriptProfile {
+	if p, exists := vt.profiles[script]; exists {
+		return p
+	}
+	return vt.profiles[Script_OTTOMAN_TURKISH]
+}
+
+func encodes(l *VisencLetter) bool {
+	return l.Direction == DirectionBoth || l.Direction == DirectionEncode
+}
+
+func decodes(l *VisencLetter) bool {
+	return l.Direction == DirectionBoth || l.Direction == DirectionDecode
+}
+
+// Validate checks that every letter is read and written in a single way.
+// Letter codes must be unique, each Unicode letter must be read as a single code in each script,
+// and no letter code may be read by SplitVisenc in place of other letters written one after another.
+func (vt *VisencTable) Validate() error {
+	problems := make([]string, 0)
+
+	type section struct {
+		all    bool
+		script Script
+	}
+	forward := make(map[section]map[string]bool)
+	reverse := make(map[section]map[string]string)
+	baseCodes := make(map[string]bool)
+	written := make(map[string]bool)
+
+	for _, l := range vt.Letters {
+		s := section{l.AllScripts, l.Script}
+		if forward[s] == nil {
+			forward[s] = make(map[string]bool)
+			reverse[s] = make(map[string]string)
+		}
+
+		switch {
+		case l.Visenc == "":
+			problems = append(problems, fmt.Sprintf("empty visenc for %s", l.Name))
+		case len([]rune(l.Visenc)) > MAXVISENCLEN:
+			problems = append(problems, fmt.Sprintf("%s is longer than %d characters", l.Visenc, MAXVISENCLEN))
+		}
+		if l.Unicode == "" {
+			problems = append(problems, fmt.Sprintf("%s has no code points", l.Visenc))
+		}
+		if !encodes(l) && !decodes(l) {
+			problems = append(problems, fmt.Sprintf("%s has unknown direction %s", l.Visenc, l.Direction))
+		}
+
+		if encodes(l) {
+			if forward[s][l.Visenc] {
+				problems = append(problems, fmt.Sprintf("%s is defined more than once", l.Visenc))
+			}
+			forward[s][l.Visenc] = true
+			if l.AllScripts {
+				baseCodes[l.Visenc] = true
+			}
+		}
+		if decodes(l) {
+			if v, exists := reverse[s][l.Unicode]; exists {
+				problems = append(problems, fmt.Sprintf("%U is read as both %s and %s", []rune(l.Unicode), v, l.Visenc))
+			}
+			reverse[s][l.Unicode] = l.Visenc
+			if l.AllScripts {
+				written[l.Visenc] = true
+			}
+		}
+	}
+
+	for _, l := range vt.Letters {
+		if !baseCodes[l.Visenc] {
+			problems = append(problems, fmt.Sprintf("%s is not a letter of all scripts", l.Visenc))
+		}
+	}
+
+	// codes written by UnicodeToVisenc must be split back into the same letters.
+	// Aliases read from older data, e.g. lo5 for kaf, are split by SplitVisenc too and may not be read in place of
+	// other letters.
+	for _, l := range vt.Letters {
+		if !l.AllScripts || !encodes(l) {
+			continue
+		}
+		for i := 1; i < len(l.Visenc); i++ {
+			head, tail := l.Visenc[:i], l.Visenc[i:]
+			if !written[head] || !startsCodeSequence(tail, written) {
+				continue
+			}
+			// a code equal to the letters it's built of, like eo5 for e and o5, is not a conflict,
+			// unless a longer code beginning with those letters is cut by it
+			if letters, exact := splitCodes(tail, written); exact && !endsInsideCode(tail, written) && vt.sameText(l.Visenc, head, letters) {
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("%s is read in place of %s followed by %s", l.Visenc, head, tail))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("Invalid visenc table: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// startsCodeSequence checks whether s is the beginning of letter codes written one after another
+func startsCodeSequence(s string, codes map[string]bool) bool {
+	if s == "" {
+		return true
+	}
+	for c := range codes {
+		if strings.HasPrefix(c, s) || (strings.HasPrefix(s, c) && startsCodeSequence(s[len(c):], codes)) {
+			return true
+		}
+	}
+	return false
+}
+
+// endsInsideCode checks whether s can end in the middle of a letter code when it's read as codes written one after another
+func endsInsideCode(s string, codes map[string]bool) bool {
+	for c := range codes {
+		if len(c) > len(s) && strings.HasPrefix(c, s) {
+			return true
+		}
+		if len(c) < len(s) && strings.HasPrefix(s, c) && endsInsideCode(s[len(c):], codes) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitCodes splits s into codes, returns false if it's not made of codes only
+func splitCodes(s string, codes map[string]bool) ([]string, bool) {
+	if s == "" {
+		return []string{}, true
+	}
+	for i := len(s); i > 0; i-- {
+		if !codes[s[:i]] {
+			continue
+		}
+		if rest, ok := splitCodes(s[i:], codes); ok {
+			return append([]string{s[:i]}, rest...), true
+		}
+	}
+	return nil, false
+}
+
+// sameText checks whether code is written with the same text as head followed by letters
+func (vt *VisencTable) sameText(code string, head string, letters []string) bool {
+	unicodeOf := func(v string) string {
+		for _, l := range vt.Letters {
+			if l.AllScripts && encodes(l) && l.Visenc == v {
+				return l.Unicode
+			}
+		}
+		return ""
+	}
+	combined := unicodeOf(head)
+	for _, v := range letters {
+		combined += unicodeOf(v)
+	}
+	return norm.NFC.String(unicodeOf(code)) == norm.NFC.String(combined)
+}
+
+// buildProfile collects letters of all scripts and replaces them with the letters of script
+func (vt *VisencTable) buildProfile(script Script) *ScriptProfile {
+	p := ScriptProfile{
+		Script:          script,
+		visencToUnicode: make(map[string]string),
+		unicodeToVisenc: make(map[string]string),
+		visencToAbjad:   make(map[string]int32),
+	}
+
+	searchable := make([]string, 0)
+	add := func(l *VisencLetter) {
+		if encodes(l) {
+			p.visencToUnicode[l.Visenc] = l.Unicode
+			if l.Abjad != 0 {
+				p.visencToAbjad[l.Visenc] = l.Abjad
+			} else {
+				delete(p.visencToAbjad, l.Visenc)
+			}
+		}
+		if decodes(l) {
+			p.unicodeToVisenc[l.Unicode] = l.Visenc
+			// format characters other than zero width non-joiner are not searched
+			r := []rune(l.Unicode)[0]
+			if !unicode.Is(unicode.Cf, r) || r == '\u200C' {
+				searchable = append(searchable, l.Unicode)
+			}
+		}
+	}
+
+	for _, l := range vt.Letters {
+		if l.AllScripts {
+			add(l)
+		}
+	}
+	for _, l := range vt.Letters {
+		if !l.AllScripts && l.Script == script {
+			add(l)
+		}
+	}
+
+	p.Searchable = strings.Join(searchable, "")
+	return &p
+}
+
+// LoadVisencTable reads a visenc table file and makes it the table used by the package.
+// It should be called before roots are built or loaded.
+func LoadVisencTable(filename string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	vt, err := ParseVisencTable(strings.NewReader(string(data)))
+	if err != nil {
+		return err
+	}
+
+	SetVisencTable(vt)
+	return nil
+}
+
+// InitVisencTable replaces the embedded visenc table with a file, if filename is not empty
+func InitVisencTable(filename string) {
+	if filename == "" {
+		return
+	}
+	if err := LoadVisencTable(filename); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// GetVisencTable returns the visenc table used by the package
+func GetVisencTable() *VisencTable {
+	return visencTable
+}
+
+// SetVisencTable sets the visenc table used by the package and the conversion tables derived from it
+func SetVisencTable(vt *VisencTable) {
+	visencTable = vt
+	ottomanProfile = vt.Profile(Script_OTTOMAN_TURKISH)
+	VisencToUnicodeMap = ottomanProfile.visencToUnicode
+	UnicodeToVisencMap = ottomanProfile.unicodeToVisenc
+	VisencToAbjadMap = ottomanProfile.visencToAbjad
+	AllSearchableArabic = ottomanProfile.Searchable
+}
+
+// CheckVisencVersion compares the visenc table a root set is built with to the table in use
+func CheckVisencVersion(rs *RootSet) error {
+	switch rs.VisencVersion {
+	case visencTable.ID():
+		return nil
+	case "":
+		return fmt.Errorf("Root set has no visenc table version, it may be built with another table than %s", visencTable.ID())
+	default:
+		return fmt.Errorf("Root set is built with visenc table %s, but table %s is in use", rs.VisencVersion, visencTable.ID())
+	}
+}
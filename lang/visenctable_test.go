@@ -0,0 +1,113 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const visencTableHeader = `# version: test
+script,visenc,unicode,abjad,direction,name
+`
+
+// func ParseVisencTable(reader io.Reader) (*VisencTable, error) {
+func TestParseVisencTable(t *testing.T) {
+	table := visencTableHeader + `,e,U+0627,1,both,alef
+,oc,U+0654,,both,hamza above
+,o5,U+0654,,encode,hamza above
+,eo5,U+0623,,both,alef with hamza above
+,k,U+06A9,20,both,keheh
+,k,U+0643,,decode,kaf
+,lo5,U+0643,,encode,kaf
+,l,U+0644,30,both,lam
+arabic,k,U+0643,20,encode,kaf
+`
+	vt, err := ParseVisencTable(strings.NewReader(table))
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+
+	if vt.Version != "test" || len(vt.Checksum) != 8 {
+		t.Log(fmt.Sprintf("Table ID: %s", vt.ID()))
+		t.Fail()
+	}
+
+	ottoman := vt.Profile(Script_OTTOMAN_TURKISH)
+	if ottoman.unicodeToVisenc["ك"] != "k" || ottoman.visencToUnicode["lo5"] != "ك" || ottoman.visencToUnicode["k"] != "ک" {
+		t.Log(fmt.Sprintf("Ottoman conversions: %v %v", ottoman.visencToUnicode, ottoman.unicodeToVisenc))
+		t.Fail()
+	}
+	if arabic := vt.Profile(Script_ARABIC); arabic.visencToUnicode["k"] != "ك" || arabic.visencToAbjad["k"] != 20 {
+		t.Log(fmt.Sprintf("Arabic conversions: %v", arabic.visencToUnicode))
+		t.Fail()
+	}
+	if !strings.ContainsRune(ottoman.Searchable, 'ك') {
+		t.Log(fmt.Sprintf("Searchable characters: %s", ottoman.Searchable))
+		t.Fail()
+	}
+
+	badTables := map[string]string{
+		"no version":       "script,visenc,unicode,abjad,direction,name\n,e,U+0627,1,both,alef\n",
+		"long code":        visencTableHeader + ",abcdef,U+0627,1,both,alef\n",
+		"duplicate code":   visencTableHeader + ",e,U+0627,1,both,alef\n,e,U+0623,1,both,alef\n",
+		"ambiguous read":   visencTableHeader + ",e,U+0627,1,both,alef\n,a,U+0627,1,both,alef\n",
+		"bad code point":   visencTableHeader + ",e,0627,1,both,alef\n",
+		"bad direction":    visencTableHeader + ",e,U+0627,1,sideways,alef\n",
+		"unknown script":   visencTableHeader + ",e,U+0627,1,both,alef\nklingon,e,U+0627,1,both,alef\n",
+		"script only code": visencTableHeader + ",e,U+0627,1,both,alef\nurdu,dot,U+0688,4,both,ddal\n",
+		"prefix conflict":  visencTableHeader + ",l,U+0644,30,both,lam\n,o5,U+0654,,both,hamza above\n,lo5,U+0643,20,both,kaf\n",
+		"alias conflict":   visencTableHeader + ",l,U+0644,30,both,lam\n,o5,U+0654,,both,hamza above\n,lo5,U+0643,,encode,kaf\n",
+		"cut code":         visencTableHeader + ",e,U+0627,1,both,alef\n,o6,U+0653,,both,maddah above\n,eo6,U+0622,,both,alef with madda\n,o66,U+0670,,both,superscript alef\n",
+		"prefix of a code": visencTableHeader + ",b,U+0628,2,both,beh\n,bo,U+0646,50,both,noon\n,os,U+0633,60,both,seen\n",
+	}
+
+	for i, o := range badTables {
+		if _, err := ParseVisencTable(strings.NewReader(o)); err == nil {
+			t.Log(fmt.Sprintf("%s should fail ParseVisencTable", i))
+			t.Fail()
+		}
+	}
+}
+
+// func CheckVisencVersion(rs *RootSet) error {
+func TestCheckVisencVersion(t *testing.T) {
+	testDict := map[string]bool{
+		GetVisencTable().ID(): true,
+		"":                    false,
+		"0-00000000":          false,
+	}
+
+	for i, o := range testDict {
+		if err := CheckVisencVersion(&RootSet{VisencVersion: i}); (err == nil) != o {
+			t.Log(fmt.Sprintf("Version %s should be accepted: %t, got %v", i, o, err))
+			t.Fail()
+		}
+	}
+}
+
+// func SetVisencTable(vt *VisencTable) {
+func TestSetVisencTable(t *testing.T) {
+	original := GetVisencTable()
+	defer SetVisencTable(original)
+
+	vt, err := ParseVisencTable(strings.NewReader(visencTableHeader + ",e,U+0627,1,both,alef\n,l,U+0644,30,both,lam\n,lot,U+0762,30,both,lam with bar\n"))
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	SetVisencTable(vt)
+
+	if u := VisencToUnicode("el"); u != "ال" {
+		t.Log(fmt.Sprintf("el should be ال with the new table, got %s", u))
+		t.Fail()
+	}
+	if a := UnicodeToAbjad("ال"); a != 31 {
+		t.Log(fmt.Sprintf("Abjad of ال should be 31 with the new table, got %d", a))
+		t.Fail()
+	}
+	if s := cleanUpSearchString("\u0762ل"); s != "\u0762ل" {
+		t.Log(fmt.Sprintf("Letters of the new table should be searched, got %q", s))
+		t.Fail()
+	}
+}
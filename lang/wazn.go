@@ -0,0 +1,312 @@
+package lang
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// arabicLatinLetters are the Latin spellings of the Arabic root letters
+var arabicLatinLetters = map[rune]string{
+	'ء': "'", 'ب': "b", 'ت': "t", 'ث': "s", 'ج': "c", 'ح': "h", 'خ': "h", 'د': "d", 'ذ': "z", 'ر': "r", 'ز': "z",
+	'س': "s", 'ش': "ş", 'ص': "s", 'ض': "z", 'ط': "t", 'ظ': "z", 'ع': "'", 'غ': "ğ", 'ف': "f", 'ق': "k", 'ک': "k",
+	'ل': "l", 'م': "m", 'ن': "n", 'و': "v", 'ه': "h", 'ی': "y",
+}
+
+// latinArabicLetters are the Arabic letters a Latin root letter may stand for, the most common first
+var latinArabicLetters = map[rune]string{
+	'\'': "عء", 'b': "ب", 'c': "ج", 'd': "دض", 'f': "ف", 'g': "غ", 'ğ': "غ", 'h': "حهخ", 'k': "کق", 'l': "ل",
+	'm': "م", 'n': "ن", 'q': "ق", 'r': "ر", 's': "سصث", 'ş': "ش", 't': "تط", 'v': "و", 'w': "و", 'x': "خ",
+	'y': "ی", 'z': "زذضظ",
+}
+
+// thickArabicLetters are read with the back vowels a and u next to them
+const thickArabicLetters = "حخصضطظعغق"
+
+// latinApostropheReplacer removes apostrophes of doubled and hamza letters not written in Latin
+var latinApostropheReplacer = strings.NewReplacer("''", "'", "â'", "â", "î'", "î", "û'", "û")
+
+// thickVowels are written for the front vowels of Latin patterns next to thick letters
+var thickVowels = map[rune]rune{'e': 'a', 'ü': 'u'}
+
+// pattern returns the pattern named exactly name, or nil if there is none
+func (pt *ArabicPatternTable) pattern(name string) *ArabicPattern {
+	for _, ap := range pt.patterns {
+		if ap.Name == name {
+			return ap
+		}
+	}
+	return nil
+}
+
+// findArabicPatterns returns the patterns named name. Names may be written without apostrophes and circumflexes,
+// like mefail for mefâil and mefâîl.
+func (pt *ArabicPatternTable) findArabicPatterns(name string) []*ArabicPattern {
+	if ap := pt.pattern(name); ap != nil {
+		return []*ArabicPattern{ap}
+	}
+
+	plain := strings.NewReplacer("'", "", "â", "a", "î", "i", "û", "u")
+	found := make([]*ArabicPattern, 0)
+	for _, ap := range pt.patterns {
+		if plain.Replace(ap.Name) == plain.Replace(strings.ToLower(name)) {
+			found = append(found, ap)
+		}
+	}
+	return found
+}
+
+// ArabicPatternNames returns the names of the patterns in file order
+func ArabicPatternNames() []string {
+	names := make([]string, len(arabicPatternTable.patterns))
+	for i, ap := range arabicPatternTable.patterns {
+		names[i] = ap.Name
+	}
+	return names
+}
+
+// parseArabicRoot returns the Arabic roots root may be. Roots in Arabic letters are returned as they are, alef is
+// written as hamza. Latin letters may stand for more than one Arabic letter, like k for ک and ق. Roots found in the
+// dictionary are returned, or the root with the most common letters if none is found. Letters may be separated by
+// - or spaces.
+func parseArabicRoot(root string) ([]string, error) {
+	root = strings.NewReplacer("-", "", " ", "").Replace(root)
+
+	if ContainsArabicChars(root) {
+		letters := arabicLetters(root)
+		if len(letters) < 3 || len(letters) > 4 {
+			return nil, fmt.Errorf("Root %s should have 3 or 4 Arabic letters", root)
+		}
+		return []string{strings.Replace(string(letters), "ا", "ء", -1)}, nil
+	}
+
+	options := make([]string, 0, 4)
+	for _, r := range strings.ToLower(root) {
+		letters, exists := latinArabicLetters[r]
+		if !exists {
+			return nil, fmt.Errorf("Root %s: %c is not a root letter", root, r)
+		}
+		options = append(options, letters)
+	}
+	if len(options) < 3 || len(options) > 4 {
+		return nil, fmt.Errorf("Root %s should have 3 or 4 letters", root)
+	}
+
+	roots := []string{""}
+	for _, letters := range options {
+		next := make([]string, 0, len(roots)*len(letters))
+		for _, prefix := range roots {
+			for _, r := range letters {
+				next = append(next, prefix+string(r))
+			}
+		}
+		roots = next
+	}
+
+	found := make([]string, 0)
+	for _, r := range roots {
+		if arabicRootIndex != nil && len(arabicRootIndex.Lookup(r)) > 0 {
+			found = append(found, r)
+		}
+	}
+	if len(found) == 0 {
+		return roots[:1], nil
+	}
+	return found, nil
+}
+
+// ottoman writes root in the pattern. Hamza is written as alef at the beginning, or alef with madda before alef, on
+// yeh next to i and u and on alef elsewhere, like امر, آمر, سائل and مأمور.
+func (ap *ArabicPattern) ottoman(root []rune) string {
+	letters := []rune(ap.Ottoman)
+	out := make([]rune, 0, len(letters))
+	for i := 0; i < len(letters); i++ {
+		r := letters[i]
+		if ap.radicals[i] >= 0 {
+			r = root[ap.radicals[i]]
+		}
+		switch {
+		case r != 'ء':
+		case i == 0 && len(letters) > 1 && letters[1] == 'ا':
+			r = 'آ'
+			i++
+		case i == 0:
+			r = 'ا'
+		default:
+			r = TFrune(ap.nextToVowel(ap.radicals[i], "iîıuûüö"), 'ئ', 'أ')
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// nextToVowel checks whether root letter radical is next to one of vowels in the Latin spelling
+func (ap *ArabicPattern) nextToVowel(radical int, vowels string) bool {
+	template := []rune(ap.Latin)
+	for i, r := range template {
+		if r != rune('1'+radical) {
+			continue
+		}
+		if (i > 0 && strings.ContainsRune(vowels, template[i-1])) || (i+1 < len(template) && strings.ContainsRune(vowels, template[i+1])) {
+			return true
+		}
+	}
+	return false
+}
+
+// latin writes root in the Latin spelling of the pattern. Front vowels next to thick letters are written back, like
+// mahkeme for حکم in mef'ale. Apostrophes for hamza and ayn are not written at the ends of words and after long
+// vowels, like sâil for سأل in fâil.
+func (ap *ArabicPattern) latin(root []rune) string {
+	template := []rune(ap.Latin)
+	radical := func(i int) rune {
+		if i < 0 || i >= len(template) || !unicode.IsDigit(template[i]) {
+			return 0
+		}
+		return root[template[i]-'1']
+	}
+
+	var sb strings.Builder
+	for i, r := range template {
+		switch {
+		case unicode.IsDigit(r):
+			letter := arabicLatinLetters[radical(i)]
+			if letter == "ğ" && sb.Len() == 0 {
+				letter = "g"
+			}
+			sb.WriteString(letter)
+		case strings.ContainsRune(thickArabicLetters, radical(i-1)) || strings.ContainsRune(thickArabicLetters, radical(i+1)):
+			if back, exists := thickVowels[r]; exists {
+				r = back
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return strings.Trim(latinApostropheReplacer.Replace(sb.String()), "'")
+}
+
+// dictionaryRoots returns the dictionary roots spelled as the Ottoman word ottoman of the Arabic root root.
+// Marks and the seats of hamza are not compared.
+func dictionaryRoots(ottoman string, root string) []*Root {
+	letters := string(arabicLetters(ottoman))
+	candidates := PrefixSearchUnicodeExact(ottoman)
+	if arabicRootIndex != nil {
+		candidates = append(candidates, rootsFromIndices(arabicRootIndex.Lookup(root))...)
+	}
+
+	roots := make([]*Root, 0)
+	for _, r := range filterResults(candidates) {
+		if string(arabicLetters(r.Ottoman.GetUnicode())) == letters {
+			roots = append(roots, r)
+		}
+	}
+	return roots
+}
+
+// waznForm writes root in the pattern ap and finds it in the dictionary
+func waznForm(ap *ArabicPattern, root string) *WaznForm {
+	letters := []rune(root)
+	ottoman := ap.ottoman(letters)
+	return &WaznForm{
+		Pattern:        ap.Name,
+		PatternOttoman: ap.Ottoman,
+		ArabicRoot:     root,
+		TurkishLatin:   ap.latin(letters),
+		Ottoman:        &OttomanWord{Unicode: ottoman, Visenc: UnicodeToVisenc(ottoman)},
+		Roots:          dictionaryRoots(ottoman, root),
+	}
+}
+
+// GenerateWaznForms writes root in the pattern named pattern, or in every pattern with as many root letters as root
+// if pattern is empty. root is written in Arabic or Latin letters like کتب or k-t-b, Latin roots may be written in
+// more than one Arabic root found in the dictionary. Forms found in the dictionary come first.
+func GenerateWaznForms(root string, pattern string) ([]*WaznForm, error) {
+	roots, err := parseArabicRoot(root)
+	if err != nil {
+		return nil, err
+	}
+
+	patterns := arabicPatternTable.patterns
+	if pattern != "" {
+		patterns = arabicPatternTable.findArabicPatterns(pattern)
+		if len(patterns) == 0 {
+			return nil, fmt.Errorf("Unknown pattern %s, patterns are %s", pattern, strings.Join(ArabicPatternNames(), ", "))
+		}
+	}
+
+	forms := make([]*WaznForm, 0)
+	for _, r := range roots {
+		for _, ap := range patterns {
+			if ap.rootLetters == len([]rune(r)) {
+				forms = append(forms, waznForm(ap, r))
+			}
+		}
+	}
+	if len(forms) == 0 {
+		return nil, fmt.Errorf("Pattern %s is not for roots with %d letters", pattern, len([]rune(roots[0])))
+	}
+
+	sort.SliceStable(forms, func(i, j int) bool { return len(forms[i].Roots) > 0 && len(forms[j].Roots) == 0 })
+	return forms, nil
+}
+
+// AnalyzeWaznForms returns the patterns an Ottoman word follows with its root, the best first like in ArabicRoot.
+// Words are matched without the article and endings, forms are the word without them.
+func AnalyzeWaznForms(word string) []*WaznForm {
+	forms := make([]*WaznForm, 0)
+	letters := arabicLetters(word)
+	if len(letters) == 0 {
+		return forms
+	}
+
+	seen := make(map[string]bool)
+	for _, m := range arabicPatternTable.matches(letters) {
+		root := m.root(letters)
+		if seen[m.pattern.Name+root] {
+			continue
+		}
+		seen[m.pattern.Name+root] = true
+		form := waznForm(m.pattern, root)
+		form.Score = int32(m.score)
+		forms = append(forms, form)
+	}
+	return forms
+}
+
+// localizeWaznForms writes the Ottoman spellings of forms in the script of p
+func (p *ScriptProfile) localizeWaznForms(forms []*WaznForm) *WaznResponse {
+	for _, f := range forms {
+		f.PatternOttoman = p.localizeArabic(f.PatternOttoman)
+		f.ArabicRoot = p.localizeArabic(f.ArabicRoot)
+		f.Ottoman, _ = p.MakeOttomanWord(f.Ottoman.Visenc, "")
+		f.Roots = p.LocalizeRoots(f.Roots)
+	}
+	return &WaznResponse{Forms: forms}
+}
+
+// AnalyzeWazn returns the patterns a word written in the script of p follows
+func (p *ScriptProfile) AnalyzeWazn(word string) *WaznResponse {
+	return p.localizeWaznForms(AnalyzeWaznForms(p.OttomanUnicode(word)))
+}
+
+// GenerateWazn writes a root in patterns, the root and the forms are written in the script of p
+func (p *ScriptProfile) GenerateWazn(root string, pattern string) (*WaznResponse, error) {
+	forms, err := GenerateWaznForms(p.OttomanUnicode(root), pattern)
+	if err != nil {
+		return nil, err
+	}
+	return p.localizeWaznForms(forms), nil
+}
+
+// AnalyzeWazn answers a wazn request for the word of in
+func AnalyzeWazn(in *WaznRequest) *WaznResponse {
+	return GetScriptProfile(in.Script).AnalyzeWazn(in.Word)
+}
+
+// GenerateWazn answers a wazn request for the root and the pattern of in
+func GenerateWazn(in *WaznRequest) (*WaznResponse, error) {
+	return GetScriptProfile(in.Script).GenerateWazn(in.Root, in.Pattern)
+}
@@ -0,0 +1,132 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// func GenerateWaznForms(root string, pattern string) ([]*WaznForm, error) {
+func TestGenerateWaznForms(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	// examples are generated from their roots
+	for _, ap := range arabicPatternTable.patterns {
+		fields := strings.Fields(ap.Example)
+		letters := arabicLetters(fields[1])
+		root := (&arabicRootMatch{positions: ap.rootPositions(letters)}).root(letters)
+		forms, err := GenerateWaznForms(root, ap.Name)
+		if err != nil || len(forms) != 1 {
+			t.Log(fmt.Sprintf("%s should be generated for %s: %v", ap.Name, root, err))
+			t.Fail()
+			continue
+		}
+		if forms[0].TurkishLatin != fields[0] || forms[0].Ottoman.Unicode != fields[1] {
+			t.Log(fmt.Sprintf("%s in %s should be %s: %s %s", root, ap.Name, ap.Example, forms[0].TurkishLatin, forms[0].Ottoman.Unicode))
+			t.Fail()
+		}
+	}
+
+	testDict := map[[2]string][2]string{
+		{"k-t-b", "mef'ûl"}: {"mektûb", "مکتوب"},
+		{"ک ت ب", "fâil"}:   {"kâtib", "کاتب"},
+		{"سأل", "fâil"}:     {"sâil", "سائل"},
+		{"ء-م-ر", "mef'ûl"}: {"me'mûr", "مأمور"},
+		{"ء-م-ر", "fâil"}:   {"âmir", "آمر"},
+		{"ا-م-ر", "fi'âl"}:  {"imâr", "امار"},
+		{"حکم", "mef'ale"}:  {"mahkeme", "محکمه"},
+		{"علم", "tef'îl"}:   {"ta'lîm", "تعلیم"},
+	}
+
+	for i, e := range testDict {
+		forms, err := GenerateWaznForms(i[0], i[1])
+		if err != nil || len(forms) == 0 {
+			t.Log(fmt.Sprintf("%v should be generated: %v", i, err))
+			t.Fail()
+			continue
+		}
+		if forms[0].TurkishLatin != e[0] || forms[0].Ottoman.Unicode != e[1] {
+			t.Log(fmt.Sprintf("%v should be %s %s for GenerateWaznForms: %s %s", i, e[0], e[1], forms[0].TurkishLatin, forms[0].Ottoman.Unicode))
+			t.Fail()
+		}
+	}
+
+	forms, _ := GenerateWaznForms("k-t-b", "mef'ûl")
+	found := false
+	for _, f := range forms {
+		for _, r := range f.Roots {
+			if r.TurkishLatin == "mektup" {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Log("mektûb should be found in the dictionary")
+		t.Fail()
+	}
+
+	if forms, _ := GenerateWaznForms("ktb", "mefail"); len(forms) < 2 {
+		t.Log(fmt.Sprintf("mefail should be generated for mefâil and mefâîl: %d forms", len(forms)))
+		t.Fail()
+	}
+	triliteral := 0
+	for _, ap := range arabicPatternTable.patterns {
+		triliteral += TFint(ap.rootLetters == 3, 1, 0)
+	}
+	if forms, _ := GenerateWaznForms("ktb", ""); len(forms) < triliteral {
+		t.Log(fmt.Sprintf("Every triliteral pattern should be generated: %d forms", len(forms)))
+		t.Fail()
+	}
+
+	badRequests := [][2]string{{"kt", "fâil"}, {"k-t-b", "fâlil"}, {"k-t-b-1", "fâil"}, {"ktb", "fa'lel"}, {"کتبتب", ""}}
+	for _, b := range badRequests {
+		if _, err := GenerateWaznForms(b[0], b[1]); err == nil {
+			t.Log(fmt.Sprintf("%v should fail GenerateWaznForms", b))
+			t.Fail()
+		}
+	}
+}
+
+// func AnalyzeWaznForms(word string) []*WaznForm {
+func TestAnalyzeWaznForms(t *testing.T) {
+	InitSearch(PROTOBUFFILE)
+
+	testDict := map[string][]string{
+		"مکتوب":    {"mef'ûl"},
+		"مکتوبات":  {"mef'ûl"},
+		"مکاتب":    {"mefâil", "müfâil"},
+		"استقبال":  {"istif'âl"},
+		"تعلیم":    {"tef'îl"},
+		"خانه":     {},
+		"kitap":    {},
+		"کتابچه":   {},
+		"مُکْتُوب": {"mef'ûl"},
+	}
+
+	for w, e := range testDict {
+		forms := AnalyzeWaznForms(w)
+		for i, name := range e {
+			if i >= len(forms) || forms[i].Pattern != name {
+				t.Log(fmt.Sprintf("%s should follow %s first: %v", w, e, forms))
+				t.Fail()
+				break
+			}
+		}
+		if len(e) == 0 && len(forms) > 0 {
+			t.Log(fmt.Sprintf("%s should follow no pattern: %v", w, forms))
+			t.Fail()
+		}
+	}
+
+	forms := AnalyzeWaznForms("مکتوبات")
+	if len(forms) == 0 || forms[0].ArabicRoot != "کتب" || forms[0].Ottoman.Unicode != "مکتوب" || len(forms[0].Roots) == 0 {
+		t.Log(fmt.Sprintf("مکتوبات should be مکتوب of کتب in the dictionary: %v", forms))
+		t.Fail()
+	}
+
+	response := GetScriptProfile(Script_PERSIAN).AnalyzeWazn("مكتوب")
+	if len(response.Forms) == 0 || response.Forms[0].Pattern != "mef'ûl" {
+		t.Log(fmt.Sprintf("Persian مكتوب should follow mef'ûl: %v", response.Forms))
+		t.Fail()
+	}
+}